@@ -0,0 +1,346 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package app
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
+	epochstypes "github.com/evmos/evmos/v11/x/epochs/types"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	incentivestypes "github.com/evmos/evmos/v11/x/incentives/types"
+	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+	paymastertypes "github.com/evmos/evmos/v11/x/paymaster/types"
+	ratelimittypes "github.com/evmos/evmos/v11/x/ratelimit/types"
+	revenuetypes "github.com/evmos/evmos/v11/x/revenue/types"
+	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+// AnalyticsTable is a columnar export of the state of an Evmos module, with
+// one row per record. Besides the stored fields, the rows contain fields
+// computed at the export height, e.g. the vested amount of vesting accounts.
+type AnalyticsTable struct {
+	Module  string
+	Columns []string
+	Rows    [][]string
+}
+
+// WriteCSV writes the table in CSV format, with the column names as header
+func (t AnalyticsTable) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(t.Columns); err != nil {
+		return err
+	}
+
+	if err := writer.WriteAll(t.Rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+// addRow appends a row to the table. It panics if the number of values
+// doesn't match the number of columns.
+func (t *AnalyticsTable) addRow(values ...string) {
+	if len(values) != len(t.Columns) {
+		panic(fmt.Errorf("%s analytics row has %d values, expected %d", t.Module, len(values), len(t.Columns)))
+	}
+	t.Rows = append(t.Rows, values)
+}
+
+// ExportAnalytics returns the analytics tables of the Evmos modules for the
+// state and block time of the context, sorted by module name
+func (app *Evmos) ExportAnalytics(ctx sdk.Context) []AnalyticsTable {
+	return []AnalyticsTable{
+		app.claimsAnalytics(ctx),
+		app.epochsAnalytics(ctx),
+		app.erc20Analytics(ctx),
+		app.incentivesAnalytics(ctx),
+		app.inflationAnalytics(ctx),
+		app.paymasterAnalytics(ctx),
+		app.rateLimitAnalytics(ctx),
+		app.revenueAnalytics(ctx),
+		app.vestingAnalytics(ctx),
+	}
+}
+
+// claimsAnalytics exports the claims records with the number of completed
+// actions
+func (app *Evmos) claimsAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module: claimstypes.ModuleName,
+		Columns: []string{
+			"address", "campaign_id", "initial_claimable_amount",
+			"actions_completed", "contract_actions_completed",
+		},
+	}
+
+	for _, record := range app.ClaimsKeeper.GetClaimsRecords(ctx) {
+		table.addRow(
+			record.Address,
+			strconv.FormatUint(record.CampaignID, 10),
+			record.InitialClaimableAmount.String(),
+			strconv.Itoa(countTrue(record.ActionsCompleted)),
+			strconv.Itoa(countTrue(record.ContractActionsCompleted)),
+		)
+	}
+
+	return table
+}
+
+// epochsAnalytics exports the epoch infos
+func (app *Evmos) epochsAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module: epochstypes.ModuleName,
+		Columns: []string{
+			"identifier", "start_time", "duration", "current_epoch", "current_epoch_start_time",
+			"current_epoch_start_height", "epoch_counting_started", "catch_up_policy",
+		},
+	}
+
+	for _, info := range app.EpochsKeeper.AllEpochInfos(ctx) {
+		table.addRow(
+			info.Identifier,
+			formatTime(info.StartTime),
+			info.Duration.String(),
+			strconv.FormatInt(info.CurrentEpoch, 10),
+			formatTime(info.CurrentEpochStartTime),
+			strconv.FormatInt(info.CurrentEpochStartHeight, 10),
+			strconv.FormatBool(info.EpochCountingStarted),
+			info.CatchUpPolicy.String(),
+		)
+	}
+
+	return table
+}
+
+// erc20Analytics exports the token pairs with the total supply of their
+// Cosmos coin
+func (app *Evmos) erc20Analytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module:  erc20types.ModuleName,
+		Columns: []string{"erc20_address", "denom", "enabled", "contract_owner", "coin_supply"},
+	}
+
+	for _, pair := range app.Erc20Keeper.GetTokenPairs(ctx) {
+		table.addRow(
+			pair.Erc20Address,
+			pair.Denom,
+			strconv.FormatBool(pair.Enabled),
+			pair.ContractOwner.String(),
+			app.BankKeeper.GetSupply(ctx, pair.Denom).Amount.String(),
+		)
+	}
+
+	return table
+}
+
+// incentivesAnalytics exports the incentives with the number of participants
+// of the current epoch
+func (app *Evmos) incentivesAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module:  incentivestypes.ModuleName,
+		Columns: []string{"contract", "allocations", "epochs", "start_time", "total_gas", "participants"},
+	}
+
+	participants := make(map[string]int)
+	for _, gm := range app.IncentivesKeeper.GetIncentivesGasMeters(ctx) {
+		participants[gm.Contract]++
+	}
+
+	for _, incentive := range app.IncentivesKeeper.GetAllIncentives(ctx) {
+		table.addRow(
+			incentive.Contract,
+			incentive.Allocations.String(),
+			strconv.FormatUint(uint64(incentive.Epochs), 10),
+			formatTime(incentive.StartTime),
+			strconv.FormatUint(incentive.TotalGas, 10),
+			strconv.Itoa(participants[incentive.Contract]),
+		)
+	}
+
+	return table
+}
+
+// inflationAnalytics exports a single row with the inflation state and the
+// inflation rate and circulating supply computed from it
+func (app *Evmos) inflationAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module: inflationtypes.ModuleName,
+		Columns: []string{
+			"period", "epoch_identifier", "epochs_per_period", "skipped_epochs",
+			"epoch_mint_provision", "inflation_rate", "circulating_supply",
+		},
+	}
+
+	mintDenom := app.InflationKeeper.GetParams(ctx).MintDenom
+	table.addRow(
+		strconv.FormatUint(app.InflationKeeper.GetPeriod(ctx), 10),
+		app.InflationKeeper.GetEpochIdentifier(ctx),
+		strconv.FormatInt(app.InflationKeeper.GetEpochsPerPeriod(ctx), 10),
+		strconv.FormatUint(app.InflationKeeper.GetSkippedEpochs(ctx), 10),
+		app.InflationKeeper.GetEpochMintProvision(ctx).String(),
+		app.InflationKeeper.GetInflationRate(ctx, mintDenom).String(),
+		app.InflationKeeper.GetCirculatingSupply(ctx, mintDenom).String(),
+	)
+
+	return table
+}
+
+// paymasterAnalytics exports the paymasters with the number of their targets
+func (app *Evmos) paymasterAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module:  paymastertypes.ModuleName,
+		Columns: []string{"owner", "balance", "user_spend_limit", "targets"},
+	}
+
+	for _, paymaster := range app.PaymasterKeeper.GetPaymasters(ctx) {
+		table.addRow(
+			paymaster.Owner,
+			paymaster.Balance.String(),
+			paymaster.UserSpendLimit.String(),
+			strconv.Itoa(len(paymaster.Targets)),
+		)
+	}
+
+	return table
+}
+
+// rateLimitAnalytics exports the rate limits with the flows of their current
+// window
+func (app *Evmos) rateLimitAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module: ratelimittypes.ModuleName,
+		Columns: []string{
+			"channel_id", "denom", "max_percent", "max_amount", "window",
+			"window_start", "inflow", "outflow", "supply",
+		},
+	}
+
+	flows := make(map[string]ratelimittypes.Flow)
+	for _, flow := range app.RateLimitKeeper.GetFlows(ctx) {
+		flows[flow.ChannelId+"/"+flow.Denom] = flow
+	}
+
+	for _, limit := range app.RateLimitKeeper.GetRateLimits(ctx) {
+		windowStart, inflow, outflow, supply := "", "0", "0", "0"
+		if flow, found := flows[limit.ChannelId+"/"+limit.Denom]; found {
+			windowStart = formatTime(flow.WindowStart)
+			inflow, outflow, supply = flow.Inflow.String(), flow.Outflow.String(), flow.Supply.String()
+		}
+
+		table.addRow(
+			limit.ChannelId,
+			limit.Denom,
+			limit.MaxPercent.String(),
+			limit.MaxAmount.String(),
+			limit.Window.String(),
+			windowStart,
+			inflow,
+			outflow,
+			supply,
+		)
+	}
+
+	return table
+}
+
+// revenueAnalytics exports the registered revenue contracts with the address
+// that effectively receives their fees
+func (app *Evmos) revenueAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module: revenuetypes.ModuleName,
+		Columns: []string{
+			"contract_address", "deployer_address", "withdrawer_address", "effective_withdrawer_address",
+		},
+	}
+
+	for _, revenue := range app.RevenueKeeper.GetRevenues(ctx) {
+		withdrawer := revenue.WithdrawerAddress
+		if withdrawer == "" {
+			withdrawer = revenue.DeployerAddress
+		}
+
+		table.addRow(
+			revenue.ContractAddress,
+			revenue.DeployerAddress,
+			revenue.WithdrawerAddress,
+			withdrawer,
+		)
+	}
+
+	return table
+}
+
+// vestingAnalytics exports the clawback vesting accounts with their vested,
+// unvested, unlocked and locked amounts at the block time of the context
+func (app *Evmos) vestingAnalytics(ctx sdk.Context) AnalyticsTable {
+	table := AnalyticsTable{
+		Module: vestingtypes.ModuleName,
+		Columns: []string{
+			"address", "funder_address", "start_time", "end_time", "original_vesting",
+			"vested", "unvested", "unlocked", "locked", "balance",
+		},
+	}
+
+	blockTime := ctx.BlockTime()
+	app.AccountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		va, ok := account.(*vestingtypes.ClawbackVestingAccount)
+		if !ok {
+			return false
+		}
+
+		table.addRow(
+			va.Address,
+			va.FunderAddress,
+			formatTime(va.StartTime),
+			formatTime(time.Unix(va.EndTime, 0)),
+			va.OriginalVesting.String(),
+			va.GetVestedOnly(blockTime).String(),
+			va.GetUnvestedOnly(blockTime).String(),
+			va.GetUnlockedOnly(blockTime).String(),
+			va.GetLockedOnly(blockTime).String(),
+			app.BankKeeper.GetAllBalances(ctx, va.GetAddress()).String(),
+		)
+		return false
+	})
+
+	return table
+}
+
+// formatTime formats a time in UTC with the RFC 3339 format
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// countTrue returns the number of true values
+func countTrue(values []bool) int {
+	count := 0
+	for _, v := range values {
+		if v {
+			count++
+		}
+	}
+	return count
+}
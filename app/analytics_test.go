@@ -0,0 +1,67 @@
+package app
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/tests"
+
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+func TestExportAnalytics(t *testing.T) {
+	app := Setup(false, nil)
+
+	startTime := time.Unix(1_000_000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: startTime.Add(150 * time.Second)})
+
+	pair := erc20types.NewTokenPair(common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"), "acoin", true, erc20types.OWNER_MODULE)
+	app.Erc20Keeper.SetTokenPair(ctx, pair)
+
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))
+	periods := sdkvesting.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 50))},
+	}
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	va := vestingtypes.NewClawbackVestingAccount(baseAcc, funder, coins, startTime, periods, periods)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, va))
+
+	tables := make(map[string]AnalyticsTable)
+	for _, table := range app.ExportAnalytics(ctx) {
+		for _, row := range table.Rows {
+			require.Len(t, row, len(table.Columns), table.Module)
+		}
+		tables[table.Module] = table
+	}
+
+	require.Len(t, tables[erc20types.ModuleName].Rows, 1)
+	require.Equal(t, []string{pair.Erc20Address, "acoin", "true", "OWNER_MODULE", "0"}, tables[erc20types.ModuleName].Rows[0])
+
+	vesting := tables[vestingtypes.ModuleName]
+	require.Len(t, vesting.Rows, 1)
+	require.Equal(t, []string{
+		addr.String(), funder.String(), "1970-01-12T13:46:40Z", "1970-01-12T13:50:00Z", "100aevmos",
+		"50aevmos", "50aevmos", "50aevmos", "50aevmos", "",
+	}, vesting.Rows[0])
+
+	var buf bytes.Buffer
+	require.NoError(t, vesting.WriteCSV(&buf))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, append([][]string{vesting.Columns}, vesting.Rows...), records)
+}
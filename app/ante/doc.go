@@ -24,5 +24,19 @@ verification in addition to minimum fees being checked. Otherwise, during
 DeliverTx, the transaction is simply passed to the EVM which will also
 perform the same series of checks. The distinction is made in CheckTx to
 prevent spam and DoS attacks.
+
+The decorator chains and the extension options that route a transaction to
+each chain are defined by a Registry. App-chains built on Evmos can set a
+custom Registry on the HandlerOptions to insert, replace or remove named
+decorators, or to register new extension options with their own chains.
+
+Nodes can additionally limit, per block, the transactions that each sender and
+each called contract get admitted to the mempool, with the rate-limit section
+of app.toml. These limits only apply on CheckTx and are local to each node.
+
+Cosmos transactions with an ExtensionOptionsWeb3Tx extension are signed with
+EIP-712 typed data (e.g. by Ledger or MetaMask). Every Evmos message
+implements the legacy Amino JSON encoding, which is normalized into typed
+data by the ethereum/eip712 package before the signature is verified.
 */
 package ante
@@ -0,0 +1,293 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ante
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/evmos/evmos/v11/ethereum/eip712"
+)
+
+// LegacyEip712SigVerificationDecorator verifies the EIP-712 signature of
+// Cosmos transactions that contain an ExtensionOptionsWeb3Tx extension. It
+// replaces the Ethermint decorator so that the typed data of every Evmos
+// message can be encoded (see eip712.WrapTxToTypedData). The decorator is
+// not executed on ReCheckTx.
+//
+// CONTRACT: Pubkeys are set in context for all signers before this decorator runs
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type LegacyEip712SigVerificationDecorator struct {
+	ak  evmtypes.AccountKeeper
+	cdc codectypes.AnyUnpacker
+}
+
+// NewLegacyEip712SigVerificationDecorator creates a new LegacyEip712SigVerificationDecorator
+func NewLegacyEip712SigVerificationDecorator(
+	ak evmtypes.AccountKeeper,
+	cdc codectypes.AnyUnpacker,
+) LegacyEip712SigVerificationDecorator {
+	return LegacyEip712SigVerificationDecorator{
+		ak:  ak,
+		cdc: cdc,
+	}
+}
+
+// AnteHandle handles validation of EIP-712 signed Cosmos txs.
+func (svd LegacyEip712SigVerificationDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement authsigning.SigVerifiableTx", tx)
+	}
+
+	authSignTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement the authsigning.Tx interface", tx)
+	}
+
+	// stdSigs contains the sequence number, account number, and signatures.
+	// When simulating, this would just be a 0-length slice.
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signerAddrs := sigTx.GetSigners()
+
+	// EIP-712 allows just one signature
+	if len(sigs) != 1 {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrTooManySignatures,
+			"invalid number of signers (%d); EIP-712 signatures allows just one signature",
+			len(sigs),
+		)
+	}
+
+	// check that signer length and signature length are the same
+	if len(sigs) != len(signerAddrs) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "invalid number of signers; expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+
+	// EIP-712 has just one signature, avoid looping here and only read index 0
+	sig := sigs[0]
+
+	acc, err := authante.GetSignerAcc(ctx, svd.ak, signerAddrs[0])
+	if err != nil {
+		return ctx, err
+	}
+
+	// retrieve pubkey
+	pubKey := acc.GetPubKey()
+	if !simulate && pubKey == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidPubKey, "pubkey on account is not set")
+	}
+
+	// check account sequence number
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	// retrieve signer data
+	genesis := ctx.BlockHeight() == 0
+	chainID := ctx.ChainID()
+
+	var accNum uint64
+	if !genesis {
+		accNum = acc.GetAccountNumber()
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := VerifyEip712Signature(svd.cdc, pubKey, signerData, sig.Data, authSignTx); err != nil {
+		errMsg := fmt.Errorf("signature verification failed; please verify account number (%d) and chain-id (%s): %w", accNum, chainID, err)
+		return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, errMsg.Error())
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// VerifyEip712Signature verifies the EIP-712 signature of the fee payer of a
+// transaction against the typed data built from its Amino JSON sign bytes.
+func VerifyEip712Signature(
+	cdc codectypes.AnyUnpacker,
+	pubKey cryptotypes.PubKey,
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	tx authsigning.Tx,
+) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrTooManySignatures, "unexpected SignatureData %T", sigData)
+	}
+
+	if data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return errorsmod.Wrapf(errortypes.ErrNotSupported, "unexpected SignatureData %T: wrong SignMode", sigData)
+	}
+
+	// Note: this prevents the user from sending trash data in the signature field
+	if len(data.Signature) != 0 {
+		return errorsmod.Wrap(errortypes.ErrTooManySignatures, "invalid signature value; EIP-712 must have the cosmos transaction signature empty")
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(errortypes.ErrNoSignatures, "tx doesn't contain any msgs to verify signature")
+	}
+
+	// the Amino JSON sign bytes require every message to implement LegacyMsg
+	for _, msg := range msgs {
+		if _, ok := msg.(legacytx.LegacyMsg); !ok {
+			return errorsmod.Wrapf(errortypes.ErrInvalidType, "msg %T doesn't support EIP-712 signing", msg)
+		}
+	}
+
+	txBytes := legacytx.StdSignBytes(
+		signerData.ChainID,
+		signerData.AccountNumber,
+		signerData.Sequence,
+		tx.GetTimeoutHeight(),
+		legacytx.StdFee{
+			Amount: tx.GetFee(),
+			Gas:    tx.GetGas(),
+		},
+		msgs, tx.GetMemo(), tx.GetTip(),
+	)
+
+	signerChainID, err := ethermint.ParseChainID(signerData.ChainID)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to parse chain-id: %s", signerData.ChainID)
+	}
+
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesnt contain any extensions")
+	}
+	opts := txWithExtensions.GetExtensionOptions()
+	if len(opts) != 1 {
+		return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesnt contain expected amount of extension options")
+	}
+
+	extOpt, ok := opts[0].GetCachedValue().(*ethermint.ExtensionOptionsWeb3Tx)
+	if !ok {
+		return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "unknown extension option")
+	}
+
+	if extOpt.TypedDataChainID != signerChainID.Uint64() {
+		return errorsmod.Wrap(errortypes.ErrInvalidChainID, "invalid chain-id")
+	}
+
+	if len(extOpt.FeePayer) == 0 {
+		return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "no feePayer on ExtensionOptionsWeb3Tx")
+	}
+	feePayer, err := sdk.AccAddressFromBech32(extOpt.FeePayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to parse feePayer from ExtensionOptionsWeb3Tx")
+	}
+
+	feeDelegation := &eip712.FeeDelegationOptions{
+		FeePayer: feePayer,
+	}
+
+	typedData, err := eip712.WrapTxToTypedData(cdc, extOpt.TypedDataChainID, msgs[0], txBytes, feeDelegation)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return err
+	}
+
+	feePayerSig := extOpt.FeePayerSig
+	if len(feePayerSig) != ethcrypto.SignatureLength {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "signature length doesn't match typical [R||S||V] signature 65 bytes")
+	}
+
+	// remove the recovery offset if needed (ie. Metamask EIP-712 signature)
+	if feePayerSig[ethcrypto.RecoveryIDOffset] == 27 || feePayerSig[ethcrypto.RecoveryIDOffset] == 28 {
+		feePayerSig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	feePayerPubkey, err := secp256k1.RecoverPubkey(sigHash, feePayerSig)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to recover delegated fee payer from sig")
+	}
+
+	ecPubKey, err := ethcrypto.UnmarshalPubkey(feePayerPubkey)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal recovered fee payer pubkey")
+	}
+
+	pk := &ethsecp256k1.PubKey{
+		Key: ethcrypto.CompressPubkey(ecPubKey),
+	}
+
+	if !pubKey.Equals(pk) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "feePayer pubkey %s is different from transaction pubkey %s", pubKey, pk)
+	}
+
+	recoveredFeePayerAcc := sdk.AccAddress(pk.Address().Bytes())
+
+	if !recoveredFeePayerAcc.Equals(feePayer) {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "failed to verify delegated fee payer %s signature", recoveredFeePayerAcc)
+	}
+
+	// VerifySignature of ethsecp256k1 accepts 64 byte signature [R||S]
+	// WARNING! Under NO CIRCUMSTANCES try to use pubKey.VerifySignature there
+	if !secp256k1.VerifySignature(pubKey.Bytes(), sigHash, feePayerSig[:len(feePayerSig)-1]) {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "unable to verify signer signature of EIP-712 typed data")
+	}
+
+	return nil
+}
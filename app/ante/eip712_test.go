@@ -0,0 +1,281 @@
+package ante_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	client "github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/evmos/evmos/v11/app"
+	"github.com/evmos/evmos/v11/app/ante"
+	"github.com/evmos/evmos/v11/ethereum/eip712"
+	callbackstypes "github.com/evmos/evmos/v11/x/callbacks/types"
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
+	epochstypes "github.com/evmos/evmos/v11/x/epochs/types"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	feeabstypes "github.com/evmos/evmos/v11/x/feeabs/types"
+	forwardtypes "github.com/evmos/evmos/v11/x/forward/types"
+	incentivestypes "github.com/evmos/evmos/v11/x/incentives/types"
+	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+	intertxtypes "github.com/evmos/evmos/v11/x/intertx/types"
+	msgfiltertypes "github.com/evmos/evmos/v11/x/msgfilter/types"
+	paymastertypes "github.com/evmos/evmos/v11/x/paymaster/types"
+	ratelimittypes "github.com/evmos/evmos/v11/x/ratelimit/types"
+	recoverytypes "github.com/evmos/evmos/v11/x/recovery/types"
+	revenuetypes "github.com/evmos/evmos/v11/x/revenue/types"
+	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+const eip712ChainID = "evmos_9001-1"
+
+// eip712TestMsgs returns a populated example of every Evmos message.
+func eip712TestMsgs() []sdk.Msg {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	addr2 := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	authority := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	contract := tests.GenerateAddress().Hex()
+	coin := sdk.NewInt64Coin("aevmos", 1000)
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	periods := sdkvesting.Periods{
+		{Length: 100, Amount: sdk.NewCoins(coin)},
+		{Length: 200, Amount: sdk.NewCoins(coin)},
+	}
+
+	submitTx, err := intertxtypes.NewMsgSubmitTx(
+		sdk.MustAccAddressFromBech32(addr), "connection-0",
+		[]sdk.Msg{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(addr), sdk.MustAccAddressFromBech32(addr2), sdk.NewCoins(coin))},
+	)
+	if err != nil {
+		panic(err)
+	}
+	claimProof, err := claimstypes.NewMsgSubmitClaimProof(
+		sdk.MustAccAddressFromBech32(addr), 1, math.NewInt(10), [][]byte{{1, 2}, {3, 4}},
+		[]sdk.Msg{stakingtypes.NewMsgDelegate(sdk.MustAccAddressFromBech32(addr), sdk.ValAddress(sdk.MustAccAddressFromBech32(addr2)), coin)},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	submitTx.Memo = "stake"
+	submitTx.Timeout = time.Hour
+
+	return []sdk.Msg{
+		// callbacks
+		&callbackstypes.MsgUpdateParams{Authority: authority, Params: callbackstypes.DefaultParams()},
+		// claims
+		&claimstypes.MsgUpdateParams{Authority: authority, Params: claimstypes.DefaultParams()},
+		&claimstypes.MsgCreateCampaign{
+			Creator:            addr,
+			Name:               "campaign",
+			Amount:             coin,
+			StartTime:          startTime,
+			DurationUntilDecay: time.Hour,
+			DurationOfDecay:    time.Hour,
+			Actions:            []claimstypes.Action{claimstypes.ActionVote, claimstypes.ActionEVM},
+			ClawbackAddress:    addr2,
+			ContractActions:    []claimstypes.ContractAction{{Contract: contract, EventSignature: "Transfer(address,address,uint256)"}},
+			MerkleRoot:         []byte{1, 2, 3},
+			MerkleTotal:        math.NewInt(1000),
+		},
+		&claimstypes.MsgAddClaimsRecords{
+			Creator:     addr,
+			CampaignID:  1,
+			Allocations: []claimstypes.ClaimsAllocation{{Address: addr2, Amount: math.NewInt(10)}},
+		},
+		claimProof,
+		&claimstypes.MsgTransferClaimsRecord{Sender: addr, Recipient: addr2, CampaignID: 1},
+		// epochs
+		&epochstypes.MsgCreateEpoch{
+			Authority:     authority,
+			Identifier:    "hour",
+			StartTime:     startTime,
+			Duration:      time.Hour,
+			CatchUpPolicy: epochstypes.CatchUpPolicyAllAtOnce,
+		},
+		&epochstypes.MsgUpdateEpoch{Authority: authority, Identifier: "hour", Duration: time.Minute},
+		&epochstypes.MsgDeleteEpoch{Authority: authority, Identifier: "hour"},
+		// erc20
+		&erc20types.MsgConvertCoin{Coin: coin, Receiver: contract, Sender: addr},
+		&erc20types.MsgConvertERC20{ContractAddress: contract, Amount: math.NewInt(10), Receiver: addr, Sender: contract},
+		&erc20types.MsgUpdateParams{Authority: authority, Params: erc20types.DefaultParams()},
+		// feeabs
+		&feeabstypes.MsgUpdateParams{Authority: authority, Params: feeabstypes.DefaultParams()},
+		// forward
+		&forwardtypes.MsgUpdateParams{Authority: authority, Params: forwardtypes.DefaultParams()},
+		// incentives
+		&incentivestypes.MsgUpdateParams{Authority: authority, Params: incentivestypes.DefaultParams()},
+		// inflation
+		&inflationtypes.MsgUpdateParams{Authority: authority, Params: inflationtypes.DefaultParams()},
+		// intertx
+		intertxtypes.NewMsgRegisterAccount(sdk.MustAccAddressFromBech32(addr), "connection-0", ""),
+		submitTx,
+		&intertxtypes.MsgUpdateParams{Authority: authority, Params: intertxtypes.DefaultParams()},
+		// msgfilter
+		&msgfiltertypes.MsgUpdateParams{Authority: authority, Params: msgfiltertypes.DefaultParams()},
+		// paymaster
+		&paymastertypes.MsgSetPaymaster{
+			Owner:          addr,
+			Targets:        []paymastertypes.Target{{Contract: contract, Selector: "0xa9059cbb"}, {Contract: contract}},
+			UserSpendLimit: math.NewInt(100),
+		},
+		&paymastertypes.MsgSetPaymaster{Owner: addr},
+		&paymastertypes.MsgDeposit{Depositor: addr, Owner: addr2, Amount: coin},
+		&paymastertypes.MsgWithdraw{Owner: addr, Amount: coin},
+		&paymastertypes.MsgUpdateParams{Authority: authority, Params: paymastertypes.DefaultParams()},
+		// ratelimit
+		&ratelimittypes.MsgSetRateLimit{
+			Authority: authority,
+			RateLimit: ratelimittypes.NewRateLimit("channel-0", "aevmos", sdk.NewDecWithPrec(5, 1), sdk.NewInt(1000), time.Hour),
+		},
+		&ratelimittypes.MsgSetRateLimit{
+			Authority: authority,
+			RateLimit: ratelimittypes.NewRateLimit("channel-0", "aevmos", sdk.ZeroDec(), sdk.NewInt(1000), time.Hour),
+		},
+		&ratelimittypes.MsgRemoveRateLimit{Authority: authority, ChannelId: "channel-0", Denom: "aevmos"},
+		&ratelimittypes.MsgResetFlow{Authority: authority, ChannelId: "channel-0", Denom: "aevmos"},
+		&ratelimittypes.MsgUpdateParams{Authority: authority, Params: ratelimittypes.DefaultParams()},
+		// recovery
+		&recoverytypes.MsgUpdateParams{Authority: authority, Params: recoverytypes.DefaultParams()},
+		&recoverytypes.MsgRecover{Sender: addr, Address: addr2, PubKey: []byte{2, 3}, Signature: []byte{4, 5}, Receiver: addr},
+		// revenue
+		&revenuetypes.MsgRegisterRevenue{ContractAddress: contract, DeployerAddress: addr, WithdrawerAddress: addr2, Nonces: []uint64{1, 2}},
+		&revenuetypes.MsgRegisterRevenue{ContractAddress: contract, DeployerAddress: addr, Nonces: []uint64{1}},
+		&revenuetypes.MsgUpdateRevenue{ContractAddress: contract, DeployerAddress: addr, WithdrawerAddress: addr2},
+		&revenuetypes.MsgCancelRevenue{ContractAddress: contract, DeployerAddress: addr},
+		&revenuetypes.MsgUpdateParams{Authority: authority, Params: revenuetypes.DefaultParams()},
+		// vesting
+		&vestingtypes.MsgCreateClawbackVestingAccount{
+			FromAddress:    addr,
+			ToAddress:      addr2,
+			StartTime:      startTime,
+			LockupPeriods:  periods,
+			VestingPeriods: periods,
+			Merge:          true,
+		},
+		&vestingtypes.MsgCreateClawbackVestingAccount{FromAddress: addr, ToAddress: addr2, StartTime: startTime, VestingPeriods: periods},
+		&vestingtypes.MsgClawback{FunderAddress: addr, AccountAddress: addr2, DestAddress: addr},
+		&vestingtypes.MsgUpdateVestingFunder{FunderAddress: addr, NewFunderAddress: addr2, VestingAddress: authority},
+	}
+}
+
+// signEip712Tx builds a transaction with the given message and signs its
+// EIP-712 typed data with the private key.
+func signEip712Tx(
+	t *testing.T,
+	txConfig client.TxConfig,
+	cdc codectypes.AnyUnpacker,
+	priv *ethsecp256k1.PrivKey,
+	signerData authsigning.SignerData,
+	msg sdk.Msg,
+) authsigning.Tx {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 20))
+	gas := uint64(200000)
+	feePayer := sdk.AccAddress(priv.PubKey().Address())
+
+	builder, ok := txConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	require.True(t, ok)
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(gas)
+	// NOTE: the message is set before signing as packing it fills its nil
+	// custom types (e.g. sdk.Int), like decoding it on the chain does
+	require.NoError(t, builder.SetMsgs(msg))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		Sequence: signerData.Sequence,
+	}))
+
+	signBytes := legacytx.StdSignBytes(
+		signerData.ChainID, signerData.AccountNumber, signerData.Sequence, 0,
+		legacytx.StdFee{Amount: fee, Gas: gas}, []sdk.Msg{msg}, "", nil,
+	)
+
+	typedData, err := eip712.WrapTxToTypedData(cdc, 9001, msg, signBytes, &eip712.FeeDelegationOptions{FeePayer: feePayer})
+	require.NoError(t, err)
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	key, err := priv.ToECDSA()
+	require.NoError(t, err)
+	sig, err := ethcrypto.Sign(sigHash, key)
+	require.NoError(t, err)
+	// MetaMask returns the recovery ID with the 27 offset
+	sig[ethcrypto.RecoveryIDOffset] += 27
+
+	option, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionsWeb3Tx{
+		TypedDataChainID: 9001,
+		FeePayer:         feePayer.String(),
+		FeePayerSig:      sig,
+	})
+	require.NoError(t, err)
+	builder.SetExtensionOptions(option)
+
+	return builder.GetTx()
+}
+
+func TestEip712SignatureRoundTrip(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encodingConfig.Codec
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	signerData := authsigning.SignerData{ChainID: eip712ChainID, AccountNumber: 5, Sequence: 3}
+
+	for _, msg := range eip712TestMsgs() {
+		t.Run(sdk.MsgTypeURL(msg), func(t *testing.T) {
+			tx := signEip712Tx(t, encodingConfig.TxConfig, cdc, priv, signerData, msg)
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+
+			err = ante.VerifyEip712Signature(cdc, priv.PubKey(), signerData, sigs[0].Data, tx)
+			require.NoError(t, err)
+
+			// the signature doesn't verify with a different sequence
+			wrongSignerData := signerData
+			wrongSignerData.Sequence++
+			err = ante.VerifyEip712Signature(cdc, priv.PubKey(), wrongSignerData, sigs[0].Data, tx)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEip712MsgCoverage(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+
+	covered := make(map[string]bool)
+	for _, msg := range eip712TestMsgs() {
+		covered[sdk.MsgTypeURL(msg)] = true
+	}
+
+	for _, typeURL := range encodingConfig.InterfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		if !strings.HasPrefix(typeURL, "/evmos.") {
+			continue
+		}
+
+		msg, err := encodingConfig.InterfaceRegistry.Resolve(typeURL)
+		require.NoError(t, err)
+
+		_, ok := msg.(legacytx.LegacyMsg)
+		require.True(t, ok, "%s doesn't implement legacytx.LegacyMsg", typeURL)
+		require.True(t, covered[typeURL], "%s is missing an EIP-712 test case", typeURL)
+	}
+}
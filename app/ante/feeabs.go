@@ -0,0 +1,183 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// FeeAbstractionDecorator wraps a fee decorator (i.e. the min gas price or the
+// fee deduction decorators) so that Cosmos transactions can pay their fees with
+// a whitelisted fee token. The wrapped decorator is run with the fee converted
+// to the EVM denomination. If convert is true, the fee tokens are first
+// swapped for the converted fee, which is then deducted by the wrapped
+// decorator, so that the fee collector only receives the EVM denomination.
+type FeeAbstractionDecorator struct {
+	fak     FeeAbsKeeper
+	inner   sdk.AnteDecorator
+	convert bool
+}
+
+// NewFeeAbstractionDecorator creates a new FeeAbstractionDecorator
+func NewFeeAbstractionDecorator(fak FeeAbsKeeper, inner sdk.AnteDecorator, convert bool) FeeAbstractionDecorator {
+	return FeeAbstractionDecorator{
+		fak:     fak,
+		inner:   inner,
+		convert: convert,
+	}
+}
+
+// AnteHandle runs the wrapped decorator with the transaction as is, unless the
+// fee is a single coin of a whitelisted fee token.
+//
+// This AnteHandler decorator will fail if:
+//   - the fee token is not an enabled token pair
+//   - the fee is paid through a fee grant
+//   - the payer or the module reserve have insufficient funds for the swap
+//   - the wrapped decorator fails with the converted fee
+func (fad FeeAbstractionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return fad.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	fee := feeTx.GetFee()
+	if len(fee) != 1 || !fad.fak.IsFeeToken(ctx, fee[0].Denom) {
+		return fad.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if feeTx.FeeGranter() != nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not supported when paying fees with a fee token")
+	}
+
+	var baseFee sdk.Coin
+	if fad.convert {
+		baseFee, err = fad.fak.ConvertFee(ctx, feeTx.FeePayer(), fee[0])
+	} else {
+		baseFee, err = fad.fak.ConvertedFee(ctx, fee[0])
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	// run the wrapped decorator with the converted fee, and the rest of the
+	// chain with the original transaction
+	convertedTx := convertedFeeTx{FeeTx: feeTx, fee: sdk.Coins{baseFee}}
+	newCtx, err = fad.inner.AnteHandle(ctx, convertedTx, simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(newCtx, tx, simulate)
+}
+
+var (
+	_ sdk.FeeTx                      = convertedFeeTx{}
+	_ authante.HasExtensionOptionsTx = convertedFeeTx{}
+)
+
+// convertedFeeTx overrides the fee of a transaction with the fee converted to
+// the EVM denomination
+type convertedFeeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+}
+
+// GetFee returns the converted fee
+func (tx convertedFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+// GetExtensionOptions returns the extension options of the original
+// transaction (e.g. the dynamic fee extension option)
+func (tx convertedFeeTx) GetExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(authante.HasExtensionOptionsTx); ok {
+		return extTx.GetExtensionOptions()
+	}
+	return nil
+}
+
+// GetNonCriticalExtensionOptions returns the non critical extension options of
+// the original transaction
+func (tx convertedFeeTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(authante.HasExtensionOptionsTx); ok {
+		return extTx.GetNonCriticalExtensionOptions()
+	}
+	return nil
+}
+
+// EthFeeAbstractionDecorator converts the whitelisted fee tokens of the sender
+// of an Ethereum transaction that doesn't hold enough EVM denomination to pay
+// the transaction fee. It must run before the sender balance is checked, and
+// after the paymaster decorator as sponsored transactions are skipped.
+type EthFeeAbstractionDecorator struct {
+	fak FeeAbsKeeper
+	pk  PaymasterKeeper
+}
+
+// NewEthFeeAbstractionDecorator creates a new EthFeeAbstractionDecorator
+func NewEthFeeAbstractionDecorator(fak FeeAbsKeeper, pk PaymasterKeeper) EthFeeAbstractionDecorator {
+	return EthFeeAbstractionDecorator{
+		fak: fak,
+		pk:  pk,
+	}
+}
+
+// AnteHandle converts, for each Ethereum transaction not sponsored by a
+// paymaster, up to the transaction fee of the first whitelisted fee token that
+// the sender holds enough of, when the sender's balance doesn't cover the
+// transaction cost.
+//
+// This AnteHandler decorator will fail if:
+//   - the message is not a MsgEthereumTx
+//   - the transaction data cannot be unpacked
+//   - the module reserve has insufficient funds for the conversion
+func (efad EthFeeAbstractionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest,
+				"invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil),
+			)
+		}
+
+		if _, found := efad.pk.GetSponsorship(ctx, msgEthTx.GetFrom()); found {
+			continue
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		cost := sdkmath.NewIntFromBigInt(txData.Cost())
+		fee := sdkmath.NewIntFromBigInt(txData.Fee())
+		if err := efad.fak.CoverFee(ctx, msgEthTx.GetFrom(), cost, fee); err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to convert fee tokens")
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
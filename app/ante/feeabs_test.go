@@ -0,0 +1,194 @@
+package ante_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethante "github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/app/ante"
+	"github.com/evmos/evmos/v11/testutil"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	feeabstypes "github.com/evmos/evmos/v11/x/feeabs/types"
+	paymastertypes "github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+const feeTokenDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+// setupFeeToken registers the fee token pair, whitelists it with the given
+// rate and funds the fee abstraction reserve
+func (suite *AnteTestSuite) setupFeeToken(rate sdk.Dec, reserve sdk.Int) {
+	pair := erc20types.NewTokenPair(tests.GenerateAddress(), feeTokenDenom, true, erc20types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+
+	params := feeabstypes.NewParams(true, []feeabstypes.FeeToken{feeabstypes.NewFeeToken(feeTokenDenom, rate)})
+	err := suite.app.FeeAbsKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+
+	if reserve.IsPositive() {
+		err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, feeabstypes.ModuleName, sdk.NewCoins(sdk.NewCoin(suite.denom, reserve)))
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *AnteTestSuite) TestFeeAbstractionDecorator() {
+	payer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	// the test tx builder uses a gas limit of 1M: 1M fee tokens for 2e15 aevmos
+	rate := sdk.NewDec(2_000_000_000)
+	baseFee := sdk.NewInt(2_000_000_000_000_000)
+
+	testCases := []struct {
+		name     string
+		malleate func() sdk.Tx
+		reserve  sdk.Int
+		expPass  bool
+	}{
+		{
+			"pass - fee paid with the EVM denomination",
+			func() sdk.Tx {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(sdk.NewCoin(suite.denom, baseFee)))
+				suite.Require().NoError(err)
+				msg := banktypes.NewMsgSend(payer, payer, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1)))
+				return suite.CreateTestTxBuilder(sdk.NewInt(2_000_000_000), suite.denom, msg).GetTx()
+			},
+			sdk.ZeroInt(),
+			true,
+		},
+		{
+			"pass - fee paid with a fee token",
+			func() sdk.Tx {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 1_000_000)))
+				suite.Require().NoError(err)
+				msg := banktypes.NewMsgSend(payer, payer, sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 1)))
+				return suite.CreateTestTxBuilder(sdk.OneInt(), feeTokenDenom, msg).GetTx()
+			},
+			baseFee,
+			true,
+		},
+		{
+			"fail - insufficient reserve",
+			func() sdk.Tx {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 1_000_000)))
+				suite.Require().NoError(err)
+				msg := banktypes.NewMsgSend(payer, payer, sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 1)))
+				return suite.CreateTestTxBuilder(sdk.OneInt(), feeTokenDenom, msg).GetTx()
+			},
+			baseFee.QuoRaw(2),
+			false,
+		},
+		{
+			"fail - insufficient fee token balance",
+			func() sdk.Tx {
+				msg := banktypes.NewMsgSend(payer, payer, sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 1)))
+				return suite.CreateTestTxBuilder(sdk.OneInt(), feeTokenDenom, msg).GetTx()
+			},
+			baseFee,
+			false,
+		},
+		{
+			"fail - fee grant",
+			func() sdk.Tx {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 1_000_000)))
+				suite.Require().NoError(err)
+				msg := banktypes.NewMsgSend(payer, payer, sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 1)))
+				txBuilder := suite.CreateTestTxBuilder(sdk.OneInt(), feeTokenDenom, msg)
+				txBuilder.SetFeeGranter(sdk.AccAddress(tests.GenerateAddress().Bytes()))
+				return txBuilder.GetTx()
+			},
+			baseFee,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setupFeeToken(rate, tc.reserve)
+			tx := tc.malleate()
+
+			dec := ante.NewFeeAbstractionDecorator(
+				suite.app.FeeAbsKeeper,
+				authante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper, ethante.NewDynamicFeeChecker(suite.app.EvmKeeper)),
+				true,
+			)
+			_, err := dec.AnteHandle(suite.ctx, tx, false, nextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(baseFee, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.denom).Amount)
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, payer, suite.denom).IsZero())
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, payer, feeTokenDenom).IsZero())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestEthFeeAbstractionDecorator() {
+	from := tests.GenerateAddress()
+	to := tests.GenerateAddress()
+	rate := sdk.NewDec(2_000_000_000)
+
+	testCases := []struct {
+		name       string
+		sponsored  bool
+		balances   sdk.Coins
+		expBalance sdk.Coins
+	}{
+		{
+			"no-op - balance covers the cost",
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 100_000_000_000_000), sdk.NewInt64Coin(feeTokenDenom, 100_000)),
+			sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 100_000_000_000_000), sdk.NewInt64Coin(feeTokenDenom, 100_000)),
+		},
+		{
+			"no-op - insufficient fee token balance",
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 10)),
+			sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 10)),
+		},
+		{
+			"no-op - sponsored by a paymaster",
+			true,
+			sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 100_000)),
+			sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 100_000)),
+		},
+		{
+			"pass - fee tokens converted",
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 100_000)),
+			sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 100_000_000_000_000), sdk.NewInt64Coin(feeTokenDenom, 50_000)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setupFeeToken(rate, sdk.NewInt(1_000_000_000_000_000))
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, from.Bytes(), tc.balances)
+			suite.Require().NoError(err)
+			if tc.sponsored {
+				suite.app.PaymasterKeeper.SetSponsorship(suite.ctx, from.Bytes(), paymastertypes.Sponsorship{})
+			}
+
+			// gas limit of 100k at 1 gwei
+			msg := suite.BuildTestEthTx(from, to, big.NewInt(1_000_000_000), nil, nil, nil)
+			tx := suite.CreateEthTestTxBuilder(msg).GetTx()
+
+			dec := ante.NewEthFeeAbstractionDecorator(suite.app.FeeAbsKeeper, suite.app.PaymasterKeeper)
+			_, err = dec.AnteHandle(suite.ctx, tx, false, nextFn)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, from.Bytes()))
+		})
+	}
+}
@@ -45,11 +45,20 @@ type HandlerOptions struct {
 	FeeMarketKeeper        ethante.FeeMarketKeeper
 	EvmKeeper              ethante.EVMKeeper
 	FeegrantKeeper         ante.FeegrantKeeper
+	MsgFilterKeeper        MsgFilterKeeper
+	FeeAbsKeeper           FeeAbsKeeper
+	PaymasterKeeper        PaymasterKeeper
 	ExtensionOptionChecker ante.ExtensionOptionChecker
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
+	// RateLimiter limits the transactions admitted to the mempool on CheckTx.
+	// The rate limit decorators are no-ops if it's nil.
+	RateLimiter *RateLimiter
+	// Registry defines the decorator chains of the AnteHandler. The default
+	// Evmos chains are used if it's nil.
+	Registry *Registry
 }
 
 // Validate checks if the keepers are defined
@@ -75,6 +84,15 @@ func (options HandlerOptions) Validate() error {
 	if options.EvmKeeper == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "evm keeper is required for AnteHandler")
 	}
+	if options.MsgFilterKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "msg filter keeper is required for AnteHandler")
+	}
+	if options.FeeAbsKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "fee abstraction keeper is required for AnteHandler")
+	}
+	if options.PaymasterKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "paymaster keeper is required for AnteHandler")
+	}
 	if options.SigGasConsumer == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "signature gas consumer is required for AnteHandler")
 	}
@@ -84,69 +102,254 @@ func (options HandlerOptions) Validate() error {
 	return nil
 }
 
-// newCosmosAnteHandler creates the default ante handler for Ethereum transactions
-func newEthAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		ethante.NewEthSetUpContextDecorator(options.EvmKeeper),                         // outermost AnteDecorator. SetUpContext must be called first
-		ethante.NewEthMempoolFeeDecorator(options.EvmKeeper),                           // Check eth effective gas price against the node's minimal-gas-prices config
-		ethante.NewEthMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper), // Check eth effective gas price against the global MinGasPrice
-		ethante.NewEthValidateBasicDecorator(options.EvmKeeper),
-		ethante.NewEthSigVerificationDecorator(options.EvmKeeper),
-		ethante.NewEthAccountVerificationDecorator(options.AccountKeeper, options.EvmKeeper),
-		ethante.NewCanTransferDecorator(options.EvmKeeper),
-		NewEthVestingTransactionDecorator(options.AccountKeeper),
-		ethante.NewEthGasConsumeDecorator(options.EvmKeeper, options.MaxTxGasWanted),
-		ethante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper),
-		ethante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
-		ethante.NewEthEmitEventDecorator(options.EvmKeeper), // emit eth tx hash and index at the very last ante handler.
-	)
+// Names of the default decorators. Decorators that play the same role on
+// several chains share the same name.
+const (
+	DecoratorEthSetUpContext        = "eth-set-up-context"
+	DecoratorEthMempoolFee          = "eth-mempool-fee"
+	DecoratorEthMinGasPrice         = "eth-min-gas-price"
+	DecoratorEthValidateBasic       = "eth-validate-basic"
+	DecoratorEthMsgFilter           = "eth-msg-filter"
+	DecoratorEthSigVerification     = "eth-sig-verification"
+	DecoratorEthRateLimit           = "eth-rate-limit"
+	DecoratorEthPaymaster           = "eth-paymaster"
+	DecoratorEthFeeAbstraction      = "eth-fee-abstraction"
+	DecoratorEthAccountVerification = "eth-account-verification"
+	DecoratorCanTransfer            = "can-transfer"
+	DecoratorEthVestingTransaction  = "eth-vesting-transaction"
+	DecoratorEthGasConsume          = "eth-gas-consume"
+	DecoratorEthIncrementSequence   = "eth-increment-sequence"
+	DecoratorGasWanted              = "gas-wanted"
+	DecoratorEthEmitEvent           = "eth-emit-event"
+	DecoratorRejectMessages         = "reject-messages"
+	DecoratorSetUpContext           = "set-up-context"
+	DecoratorExtensionOptions       = "extension-options"
+	DecoratorValidateBasic          = "validate-basic"
+	DecoratorMsgFilter              = "msg-filter"
+	DecoratorTxTimeoutHeight        = "tx-timeout-height"
+	DecoratorValidateMemo           = "validate-memo"
+	DecoratorMinGasPrice            = "min-gas-price"
+	DecoratorConsumeGasForTxSize    = "consume-gas-for-tx-size"
+	DecoratorDeductFee              = "deduct-fee"
+	DecoratorVestingDelegation      = "vesting-delegation"
+	DecoratorSetPubKey              = "set-pub-key"
+	DecoratorValidateSigCount       = "validate-sig-count"
+	DecoratorSigGasConsume          = "sig-gas-consume"
+	DecoratorSigVerification        = "sig-verification"
+	DecoratorRateLimit              = "rate-limit"
+	DecoratorIncrementSequence      = "increment-sequence"
+	DecoratorRedundantRelay         = "redundant-relay"
+)
+
+// registry returns the decorator registry of the options or the default one
+// if none was set
+func (options HandlerOptions) registry() *Registry {
+	if options.Registry != nil {
+		return options.Registry
+	}
+	return NewRegistry()
 }
 
-// newCosmosAnteHandler creates the default ante handler for Cosmos transactions
-func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		ethante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
-		ante.NewSetUpContextDecorator(),
-		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
-		ante.NewValidateBasicDecorator(),
-		ante.NewTxTimeoutHeightDecorator(),
-		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		ethante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
-		NewVestingDelegationDecorator(options.AccountKeeper, options.StakingKeeper, options.Cdc),
+// defaultEthDecorators returns the default decorators for Ethereum transactions
+func defaultEthDecorators() []NamedDecorator {
+	return []NamedDecorator{
+		// outermost AnteDecorator. SetUpContext must be called first
+		{DecoratorEthSetUpContext, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthSetUpContextDecorator(options.EvmKeeper)
+		}},
+		// Check eth effective gas price against the node's minimal-gas-prices config
+		{DecoratorEthMempoolFee, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthMempoolFeeDecorator(options.EvmKeeper)
+		}},
+		// Check eth effective gas price against the global MinGasPrice
+		{DecoratorEthMinGasPrice, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper)
+		}},
+		{DecoratorEthValidateBasic, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthValidateBasicDecorator(options.EvmKeeper)
+		}},
+		{DecoratorEthMsgFilter, func(options HandlerOptions) sdk.AnteDecorator {
+			return NewEthMsgFilterDecorator(options.MsgFilterKeeper)
+		}},
+		{DecoratorEthSigVerification, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthSigVerificationDecorator(options.EvmKeeper)
+		}},
+		{DecoratorEthRateLimit, func(options HandlerOptions) sdk.AnteDecorator {
+			return NewEthRateLimitDecorator(options.RateLimiter, options.EvmKeeper)
+		}},
+		// Sponsor the gas of whitelisted calls before the sender balance is
+		// checked, the sponsored fee is checked and deducted by the decorators
+		// using the sponsored EVM keeper
+		{DecoratorEthPaymaster, func(options HandlerOptions) sdk.AnteDecorator {
+			return NewEthPaymasterDecorator(options.AccountKeeper, options.PaymasterKeeper)
+		}},
+		// Convert fee tokens before the sender balance is checked
+		{DecoratorEthFeeAbstraction, func(options HandlerOptions) sdk.AnteDecorator {
+			return NewEthFeeAbstractionDecorator(options.FeeAbsKeeper, options.PaymasterKeeper)
+		}},
+		{DecoratorEthAccountVerification, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthAccountVerificationDecorator(options.AccountKeeper, newSponsoredEVMKeeper(options))
+		}},
+		{DecoratorCanTransfer, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewCanTransferDecorator(options.EvmKeeper)
+		}},
+		{DecoratorEthVestingTransaction, func(options HandlerOptions) sdk.AnteDecorator {
+			return NewEthVestingTransactionDecorator(options.AccountKeeper)
+		}},
+		{DecoratorEthGasConsume, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthGasConsumeDecorator(newSponsoredEVMKeeper(options), options.MaxTxGasWanted)
+		}},
+		{DecoratorEthIncrementSequence, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper)
+		}},
+		{DecoratorGasWanted, newGasWantedDecorator},
+		// emit eth tx hash and index at the very last ante handler.
+		{DecoratorEthEmitEvent, func(options HandlerOptions) sdk.AnteDecorator {
+			return ethante.NewEthEmitEventDecorator(options.EvmKeeper)
+		}},
+	}
+}
+
+// defaultCosmosDecorators returns the default decorators for Cosmos
+// transactions
+func defaultCosmosDecorators() []NamedDecorator {
+	return []NamedDecorator{
+		// reject MsgEthereumTxs
+		{DecoratorRejectMessages, newRejectMessagesDecorator},
+		{DecoratorSetUpContext, newSetUpContextDecorator},
+		{DecoratorExtensionOptions, func(options HandlerOptions) sdk.AnteDecorator {
+			return ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker)
+		}},
+		{DecoratorValidateBasic, newValidateBasicDecorator},
+		{DecoratorMsgFilter, newMsgFilterDecorator},
+		{DecoratorTxTimeoutHeight, newTxTimeoutHeightDecorator},
+		{DecoratorValidateMemo, newValidateMemoDecorator},
+		{DecoratorMinGasPrice, newMinGasPriceDecorator},
+		{DecoratorConsumeGasForTxSize, newConsumeGasForTxSizeDecorator},
+		{DecoratorDeductFee, newDeductFeeDecorator},
+		{DecoratorVestingDelegation, newVestingDelegationDecorator},
 		// SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewSetPubKeyDecorator(options.AccountKeeper),
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
-		ethante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
-	)
+		{DecoratorSetPubKey, newSetPubKeyDecorator},
+		{DecoratorValidateSigCount, newValidateSigCountDecorator},
+		{DecoratorSigGasConsume, newSigGasConsumeDecorator},
+		{DecoratorSigVerification, func(options HandlerOptions) sdk.AnteDecorator {
+			return ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler)
+		}},
+		{DecoratorRateLimit, newRateLimitDecorator},
+		{DecoratorIncrementSequence, newIncrementSequenceDecorator},
+		{DecoratorRedundantRelay, newRedundantRelayDecorator},
+		{DecoratorGasWanted, newGasWantedDecorator},
+	}
 }
 
-// newCosmosAnteHandlerEip712 creates the ante handler for transactions signed with EIP712
-func newLegacyCosmosAnteHandlerEip712(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		ethante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
-		ante.NewSetUpContextDecorator(),
-		ante.NewValidateBasicDecorator(),
-		ante.NewTxTimeoutHeightDecorator(),
-		ethante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
-		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
-		NewVestingDelegationDecorator(options.AccountKeeper, options.StakingKeeper, options.Cdc),
+// defaultLegacyEIP712Decorators returns the default decorators for
+// transactions signed with EIP712
+func defaultLegacyEIP712Decorators() []NamedDecorator {
+	return []NamedDecorator{
+		// reject MsgEthereumTxs
+		{DecoratorRejectMessages, newRejectMessagesDecorator},
+		{DecoratorSetUpContext, newSetUpContextDecorator},
+		{DecoratorValidateBasic, newValidateBasicDecorator},
+		{DecoratorMsgFilter, newMsgFilterDecorator},
+		{DecoratorTxTimeoutHeight, newTxTimeoutHeightDecorator},
+		{DecoratorMinGasPrice, newMinGasPriceDecorator},
+		{DecoratorValidateMemo, newValidateMemoDecorator},
+		{DecoratorConsumeGasForTxSize, newConsumeGasForTxSizeDecorator},
+		{DecoratorDeductFee, newDeductFeeDecorator},
+		{DecoratorVestingDelegation, newVestingDelegationDecorator},
 		// SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewSetPubKeyDecorator(options.AccountKeeper),
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		{DecoratorSetPubKey, newSetPubKeyDecorator},
+		{DecoratorValidateSigCount, newValidateSigCountDecorator},
+		{DecoratorSigGasConsume, newSigGasConsumeDecorator},
 		// Note: signature verification uses EIP instead of the cosmos signature validator
-		//nolint: staticcheck
-		ethante.NewLegacyEip712SigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
-		ethante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
+		{DecoratorSigVerification, newLegacyEip712SigVerificationDecorator},
+		{DecoratorRateLimit, newRateLimitDecorator},
+		{DecoratorIncrementSequence, newIncrementSequenceDecorator},
+		{DecoratorRedundantRelay, newRedundantRelayDecorator},
+		{DecoratorGasWanted, newGasWantedDecorator},
+	}
+}
+
+func newRejectMessagesDecorator(HandlerOptions) sdk.AnteDecorator {
+	return ethante.RejectMessagesDecorator{}
+}
+
+func newSetUpContextDecorator(HandlerOptions) sdk.AnteDecorator {
+	return ante.NewSetUpContextDecorator()
+}
+
+func newValidateBasicDecorator(HandlerOptions) sdk.AnteDecorator {
+	return ante.NewValidateBasicDecorator()
+}
+
+func newMsgFilterDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return NewMsgFilterDecorator(options.MsgFilterKeeper, options.Cdc)
+}
+
+func newLegacyEip712SigVerificationDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return NewLegacyEip712SigVerificationDecorator(options.AccountKeeper, options.Cdc)
+}
+
+func newRateLimitDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return NewRateLimitDecorator(options.RateLimiter)
+}
+
+func newTxTimeoutHeightDecorator(HandlerOptions) sdk.AnteDecorator {
+	return ante.NewTxTimeoutHeightDecorator()
+}
+
+func newValidateMemoDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ante.NewValidateMemoDecorator(options.AccountKeeper)
+}
+
+// newMinGasPriceDecorator checks the fee, converted if it's paid with a fee
+// token, against the global MinGasPrice
+func newMinGasPriceDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return NewFeeAbstractionDecorator(
+		options.FeeAbsKeeper,
+		ethante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+		false,
+	)
+}
+
+func newConsumeGasForTxSizeDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper)
+}
+
+// newDeductFeeDecorator converts the fee if it's paid with a fee token and
+// deducts it
+func newDeductFeeDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return NewFeeAbstractionDecorator(
+		options.FeeAbsKeeper,
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		true,
 	)
 }
+
+func newVestingDelegationDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return NewVestingDelegationDecorator(options.AccountKeeper, options.StakingKeeper, options.Cdc)
+}
+
+func newSetPubKeyDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ante.NewSetPubKeyDecorator(options.AccountKeeper)
+}
+
+func newValidateSigCountDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ante.NewValidateSigCountDecorator(options.AccountKeeper)
+}
+
+func newSigGasConsumeDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer)
+}
+
+func newIncrementSequenceDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ante.NewIncrementSequenceDecorator(options.AccountKeeper)
+}
+
+func newRedundantRelayDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ibcante.NewRedundantRelayDecorator(options.IBCKeeper)
+}
+
+func newGasWantedDecorator(options HandlerOptions) sdk.AnteDecorator {
+	return ethante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper)
+}
@@ -112,6 +112,57 @@ func (suite *AnteTestSuite) TestValidateHandlerOptions() {
 			},
 			false,
 		},
+		{
+			"fail - empty msg filter keeper",
+			ante.HandlerOptions{
+				Cdc:             suite.app.AppCodec(),
+				AccountKeeper:   suite.app.AccountKeeper,
+				BankKeeper:      suite.app.BankKeeper,
+				IBCKeeper:       suite.app.IBCKeeper,
+				StakingKeeper:   suite.app.StakingKeeper,
+				FeeMarketKeeper: suite.app.FeeMarketKeeper,
+				EvmKeeper:       suite.app.EvmKeeper,
+				SigGasConsumer:  app.SigVerificationGasConsumer,
+				SignModeHandler: encoding.MakeConfig(app.ModuleBasics).TxConfig.SignModeHandler(),
+				MsgFilterKeeper: nil,
+			},
+			false,
+		},
+		{
+			"fail - empty fee abstraction keeper",
+			ante.HandlerOptions{
+				Cdc:             suite.app.AppCodec(),
+				AccountKeeper:   suite.app.AccountKeeper,
+				BankKeeper:      suite.app.BankKeeper,
+				IBCKeeper:       suite.app.IBCKeeper,
+				StakingKeeper:   suite.app.StakingKeeper,
+				FeeMarketKeeper: suite.app.FeeMarketKeeper,
+				EvmKeeper:       suite.app.EvmKeeper,
+				SigGasConsumer:  app.SigVerificationGasConsumer,
+				SignModeHandler: encoding.MakeConfig(app.ModuleBasics).TxConfig.SignModeHandler(),
+				MsgFilterKeeper: suite.app.MsgFilterKeeper,
+				FeeAbsKeeper:    nil,
+			},
+			false,
+		},
+		{
+			"fail - empty paymaster keeper",
+			ante.HandlerOptions{
+				Cdc:             suite.app.AppCodec(),
+				AccountKeeper:   suite.app.AccountKeeper,
+				BankKeeper:      suite.app.BankKeeper,
+				IBCKeeper:       suite.app.IBCKeeper,
+				StakingKeeper:   suite.app.StakingKeeper,
+				FeeMarketKeeper: suite.app.FeeMarketKeeper,
+				EvmKeeper:       suite.app.EvmKeeper,
+				SigGasConsumer:  app.SigVerificationGasConsumer,
+				SignModeHandler: encoding.MakeConfig(app.ModuleBasics).TxConfig.SignModeHandler(),
+				MsgFilterKeeper: suite.app.MsgFilterKeeper,
+				FeeAbsKeeper:    suite.app.FeeAbsKeeper,
+				PaymasterKeeper: nil,
+			},
+			false,
+		},
 		{
 			"success - default app options",
 			ante.HandlerOptions{
@@ -124,6 +175,9 @@ func (suite *AnteTestSuite) TestValidateHandlerOptions() {
 				FeegrantKeeper:         suite.app.FeeGrantKeeper,
 				IBCKeeper:              suite.app.IBCKeeper,
 				FeeMarketKeeper:        suite.app.FeeMarketKeeper,
+				MsgFilterKeeper:        suite.app.MsgFilterKeeper,
+				FeeAbsKeeper:           suite.app.FeeAbsKeeper,
+				PaymasterKeeper:        suite.app.PaymasterKeeper,
 				SignModeHandler:        encoding.MakeConfig(app.ModuleBasics).TxConfig.SignModeHandler(),
 				SigGasConsumer:         app.SigVerificationGasConsumer,
 				MaxTxGasWanted:         40000000,
@@ -19,9 +19,14 @@ package ante
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	msgfiltertypes "github.com/evmos/evmos/v11/x/msgfilter/types"
+	paymastertypes "github.com/evmos/evmos/v11/x/paymaster/types"
 )
 
 // EvmKeeper defines the expected keeper interface used on the AnteHandler
@@ -30,3 +35,28 @@ type EvmKeeper interface {
 	ChainID() *big.Int
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
 }
+
+// MsgFilterKeeper defines the expected keeper interface used on the
+// message filter decorators
+type MsgFilterKeeper interface {
+	GetParams(ctx sdk.Context) (params msgfiltertypes.Params)
+}
+
+// FeeAbsKeeper defines the expected keeper interface used on the fee
+// abstraction decorators
+type FeeAbsKeeper interface {
+	IsFeeToken(ctx sdk.Context, denom string) bool
+	ConvertedFee(ctx sdk.Context, fee sdk.Coin) (sdk.Coin, error)
+	ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error)
+	CoverFee(ctx sdk.Context, payer sdk.AccAddress, required, fee sdkmath.Int) error
+}
+
+// PaymasterKeeper defines the expected keeper interface used on the paymaster
+// decorators
+type PaymasterKeeper interface {
+	Sponsor(ctx sdk.Context, user sdk.AccAddress, to common.Address, data []byte, gasLimit uint64, feeCap sdkmath.Int) bool
+	GetSponsorship(ctx sdk.Context, user sdk.AccAddress) (paymastertypes.Sponsorship, bool)
+	DeleteSponsorship(ctx sdk.Context, user sdk.AccAddress)
+	ChargeSponsoredFee(ctx sdk.Context, user sdk.AccAddress, fees sdk.Coins) error
+	RefundSponsoredGas(ctx sdk.Context, user sdk.AccAddress, gasUsed uint64) error
+}
@@ -0,0 +1,160 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ante
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
+	msgfiltertypes "github.com/evmos/evmos/v11/x/msgfilter/types"
+)
+
+// MsgFilterDecorator rejects Cosmos transactions that contain a message type
+// disabled by governance on the msgfilter module parameters.
+type MsgFilterDecorator struct {
+	mfk MsgFilterKeeper
+	cdc codec.BinaryCodec
+}
+
+// NewMsgFilterDecorator creates a new MsgFilterDecorator
+func NewMsgFilterDecorator(mfk MsgFilterKeeper, cdc codec.BinaryCodec) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		mfk: mfk,
+		cdc: cdc,
+	}
+}
+
+// AnteHandle checks the type URL of every message of the transaction,
+// including the messages nested in authz MsgExec and claims
+// MsgSubmitClaimProof messages.
+//
+// This AnteHandler decorator will fail if:
+//   - a message type, or the type of a nested message, is disabled
+//   - a nested message cannot be unpacked
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params := mfd.mfk.GetParams(ctx)
+	if len(params.DisabledMsgTypes) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := mfd.validateMsg(params, msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMsg checks that the message type is not disabled and recursively
+// validates the messages of an authz MsgExec or a claims MsgSubmitClaimProof
+func (mfd MsgFilterDecorator) validateMsg(params msgfiltertypes.Params, msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if params.IsMsgTypeDisabled(typeURL) {
+		evmostelemetry.IncrCounter(msgfiltertypes.MetricKeyRejectedMsg, evmostelemetry.NewActionLabel(typeURL))
+		return errorsmod.Wrapf(msgfiltertypes.ErrMsgDisabled, "message type %s is disabled by governance", typeURL)
+	}
+
+	var nestedMsgs []*codectypes.Any
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		nestedMsgs = msg.Msgs
+	case *claimstypes.MsgSubmitClaimProof:
+		nestedMsgs = msg.Msgs
+	default:
+		return nil
+	}
+
+	// Check for bypassing the filter with an authorization or a claim proof
+	for _, v := range nestedMsgs {
+		var innerMsg sdk.Msg
+		if err := mfd.cdc.UnpackAny(v, &innerMsg); err != nil {
+			return errorsmod.Wrap(err, "cannot unmarshal nested msgs")
+		}
+
+		if err := mfd.validateMsg(params, innerMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EthMsgFilterDecorator rejects Ethereum transactions that are disabled by
+// governance on the msgfilter module parameters, either by message type or by
+// contract address and method selector.
+type EthMsgFilterDecorator struct {
+	mfk MsgFilterKeeper
+}
+
+// NewEthMsgFilterDecorator creates a new EthMsgFilterDecorator
+func NewEthMsgFilterDecorator(mfk MsgFilterKeeper) EthMsgFilterDecorator {
+	return EthMsgFilterDecorator{
+		mfk: mfk,
+	}
+}
+
+// AnteHandle checks the recipient and input data of every Ethereum transaction.
+//
+// This AnteHandler decorator will fail if:
+//   - the message is not a MsgEthereumTx
+//   - the MsgEthereumTx type is disabled
+//   - the transaction calls a disabled contract or method selector
+func (emfd EthMsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params := emfd.mfk.GetParams(ctx)
+	if len(params.DisabledMsgTypes) == 0 && len(params.DisabledContractCalls) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest,
+				"invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil),
+			)
+		}
+
+		typeURL := sdk.MsgTypeURL(msgEthTx)
+		if params.IsMsgTypeDisabled(typeURL) {
+			evmostelemetry.IncrCounter(msgfiltertypes.MetricKeyRejectedMsg, evmostelemetry.NewActionLabel(typeURL))
+			return ctx, errorsmod.Wrapf(msgfiltertypes.ErrMsgDisabled, "message type %s is disabled by governance", typeURL)
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		// contract creations are not filtered
+		if ethTx.To() == nil {
+			continue
+		}
+
+		if filter, disabled := params.DisabledContractCall(*ethTx.To(), ethTx.Data()); disabled {
+			evmostelemetry.IncrCounter(msgfiltertypes.MetricKeyRejectedContractCall, evmostelemetry.NewContractLabel(ethTx.To().Hex()))
+			return ctx, errorsmod.Wrapf(msgfiltertypes.ErrContractCallDisabled,
+				"call to %s is disabled by governance (contract: %q, selector: %q)",
+				ethTx.To(), filter.Contract, filter.Selector,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
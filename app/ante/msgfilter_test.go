@@ -0,0 +1,225 @@
+package ante_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/evmos/evmos/v11/app/ante"
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
+	msgfiltertypes "github.com/evmos/evmos/v11/x/msgfilter/types"
+)
+
+func (suite *AnteTestSuite) TestMsgFilterDecorator() {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1)))
+	exec := authz.NewMsgExec(addr, []sdk.Msg{send})
+	nestedExec := authz.NewMsgExec(addr, []sdk.Msg{&exec})
+	delegate := stakingtypes.NewMsgDelegate(addr, sdk.ValAddress(addr), sdk.NewInt64Coin(suite.denom, 1))
+	claimProof, err := claimstypes.NewMsgSubmitClaimProof(addr, 1, sdk.NewInt(1), nil, []sdk.Msg{delegate})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		disabled []string
+		msgs     []sdk.Msg
+		expPass  bool
+	}{
+		{
+			"pass - no disabled message",
+			[]string{},
+			[]sdk.Msg{send},
+			true,
+		},
+		{
+			"pass - message type not disabled",
+			[]string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})},
+			[]sdk.Msg{send, &exec},
+			true,
+		},
+		{
+			"fail - disabled message type",
+			[]string{sdk.MsgTypeURL(send)},
+			[]sdk.Msg{send},
+			false,
+		},
+		{
+			"fail - disabled message type in authz exec",
+			[]string{sdk.MsgTypeURL(send)},
+			[]sdk.Msg{&exec},
+			false,
+		},
+		{
+			"fail - disabled message type in nested authz exec",
+			[]string{sdk.MsgTypeURL(send)},
+			[]sdk.Msg{&nestedExec},
+			false,
+		},
+		{
+			"fail - disabled message type in claim proof",
+			[]string{sdk.MsgTypeURL(delegate)},
+			[]sdk.Msg{claimProof},
+			false,
+		},
+		{
+			"fail - disabled authz exec",
+			[]string{sdk.MsgTypeURL(&exec)},
+			[]sdk.Msg{&exec},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := msgfiltertypes.DefaultParams()
+			params.DisabledMsgTypes = tc.disabled
+			err := suite.app.MsgFilterKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			dec := ante.NewMsgFilterDecorator(suite.app.MsgFilterKeeper, suite.app.AppCodec())
+			tx := suite.CreateTestTxBuilder(sdk.ZeroInt(), suite.denom, tc.msgs...).GetTx()
+
+			_, err = dec.AnteHandle(suite.ctx, tx, false, nextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, msgfiltertypes.ErrMsgDisabled)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestEthMsgFilterDecorator() {
+	from := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	selector := "0xa9059cbb"
+
+	testCases := []struct {
+		name     string
+		params   msgfiltertypes.Params
+		malleate func(*evmtypes.MsgEthereumTx)
+		expErr   error
+	}{
+		{
+			"pass - no filter",
+			msgfiltertypes.DefaultParams(),
+			func(*evmtypes.MsgEthereumTx) {},
+			nil,
+		},
+		{
+			"pass - call to another contract",
+			msgfiltertypes.NewParams(nil, []msgfiltertypes.ContractCallFilter{
+				{Contract: tests.GenerateAddress().Hex()},
+			}),
+			func(*evmtypes.MsgEthereumTx) {},
+			nil,
+		},
+		{
+			"pass - call to another method",
+			msgfiltertypes.NewParams(nil, []msgfiltertypes.ContractCallFilter{
+				{Contract: contract.Hex(), Selector: selector},
+			}),
+			func(msg *evmtypes.MsgEthereumTx) {
+				*msg = *suite.buildEthTxWithData(from, &contract, common.FromHex("0x095ea7b3"))
+			},
+			nil,
+		},
+		{
+			"pass - contract creation",
+			msgfiltertypes.NewParams(nil, []msgfiltertypes.ContractCallFilter{
+				{Selector: selector},
+			}),
+			func(msg *evmtypes.MsgEthereumTx) {
+				*msg = *suite.buildEthTxWithData(from, nil, common.FromHex(selector))
+			},
+			nil,
+		},
+		{
+			"fail - disabled MsgEthereumTx",
+			msgfiltertypes.NewParams([]string{sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})}, nil),
+			func(*evmtypes.MsgEthereumTx) {},
+			msgfiltertypes.ErrMsgDisabled,
+		},
+		{
+			"fail - disabled contract",
+			msgfiltertypes.NewParams(nil, []msgfiltertypes.ContractCallFilter{
+				{Contract: contract.Hex()},
+			}),
+			func(*evmtypes.MsgEthereumTx) {},
+			msgfiltertypes.ErrContractCallDisabled,
+		},
+		{
+			"fail - disabled selector on any contract",
+			msgfiltertypes.NewParams(nil, []msgfiltertypes.ContractCallFilter{
+				{Selector: selector},
+			}),
+			func(msg *evmtypes.MsgEthereumTx) {
+				*msg = *suite.buildEthTxWithData(from, &contract, common.FromHex(selector+"00"))
+			},
+			msgfiltertypes.ErrContractCallDisabled,
+		},
+		{
+			"fail - disabled contract method",
+			msgfiltertypes.NewParams(nil, []msgfiltertypes.ContractCallFilter{
+				{Contract: contract.Hex(), Selector: selector},
+			}),
+			func(msg *evmtypes.MsgEthereumTx) {
+				*msg = *suite.buildEthTxWithData(from, &contract, common.FromHex(selector))
+			},
+			msgfiltertypes.ErrContractCallDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			err := suite.app.MsgFilterKeeper.SetParams(suite.ctx, tc.params)
+			suite.Require().NoError(err)
+
+			msg := suite.BuildTestEthTx(from, contract, big.NewInt(1), nil, nil, nil)
+			tc.malleate(msg)
+
+			dec := ante.NewEthMsgFilterDecorator(suite.app.MsgFilterKeeper)
+			tx := suite.CreateEthTestTxBuilder(msg).GetTx()
+
+			_, err = dec.AnteHandle(suite.ctx, tx, false, nextFn)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// buildEthTxWithData builds a legacy Ethereum tx to the given recipient, or a
+// contract creation if it's nil, with the given input data
+func (suite *AnteTestSuite) buildEthTxWithData(from common.Address, to *common.Address, data []byte) *evmtypes.MsgEthereumTx {
+	msg := evmtypes.NewTx(
+		suite.app.EvmKeeper.ChainID(),
+		suite.app.EvmKeeper.GetNonce(suite.ctx, from),
+		to,
+		nil,
+		100000,
+		big.NewInt(1),
+		nil,
+		nil,
+		data,
+		nil,
+	)
+	msg.From = from.String()
+	return msg
+}
+
+func nextFn(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ante
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethante "github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// EthPaymasterDecorator lets registered paymasters sponsor the gas of the
+// Ethereum transactions that call their whitelisted contracts and methods. It
+// must run before the sender balance is checked and the fees are deducted.
+type EthPaymasterDecorator struct {
+	ak evmtypes.AccountKeeper
+	pk PaymasterKeeper
+}
+
+// NewEthPaymasterDecorator creates a new EthPaymasterDecorator
+func NewEthPaymasterDecorator(ak evmtypes.AccountKeeper, pk PaymasterKeeper) EthPaymasterDecorator {
+	return EthPaymasterDecorator{
+		ak: ak,
+		pk: pk,
+	}
+}
+
+// AnteHandle selects, for an Ethereum transaction with a single message
+// calling a contract, a matching paymaster whose balance and spend limit cover
+// the maximum fee (i.e. gas limit * gas fee cap). The sender account is
+// created if it doesn't exist yet. The sponsored fee is then checked against
+// and deducted from the paymaster balance by the decorators wrapping the EVM
+// keeper with newSponsoredEVMKeeper, and the refund of the unused gas is
+// returned to the paymaster by the EthPaymasterRefundDecorator.
+//
+// This AnteHandler decorator will fail if:
+//   - the message is not a MsgEthereumTx
+//   - the transaction data cannot be unpacked
+func (epd EthPaymasterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest,
+				"invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil),
+			)
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		// contract creations can't be sponsored, nor transactions with several
+		// messages as the refund is computed from the gas used by the whole
+		// transaction
+		from := msgEthTx.GetFrom()
+		to := txData.GetTo()
+		if to == nil || len(msgs) > 1 {
+			epd.pk.DeleteSponsorship(ctx, from)
+			continue
+		}
+
+		fee := sdkmath.NewIntFromBigInt(txData.Fee())
+		if !epd.pk.Sponsor(ctx, from, *to, txData.GetData(), txData.GetGas(), fee) {
+			continue
+		}
+
+		if epd.ak.GetAccount(ctx, from) == nil {
+			epd.ak.SetAccount(ctx, epd.ak.NewAccountWithAddress(ctx, from))
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthPaymasterRefundDecorator returns to the paymasters the refund of the gas
+// left unused by the Ethereum transactions they sponsored, which the EVM module
+// sends to the sender. It must be part of the PostHandler.
+type EthPaymasterRefundDecorator struct {
+	pk PaymasterKeeper
+}
+
+// NewEthPaymasterRefundDecorator creates a new EthPaymasterRefundDecorator
+func NewEthPaymasterRefundDecorator(pk PaymasterKeeper) EthPaymasterRefundDecorator {
+	return EthPaymasterRefundDecorator{
+		pk: pk,
+	}
+}
+
+// AnteHandle transfers the refund of the unused gas of a sponsored Ethereum
+// transaction from the sender back to the paymaster. The transaction is
+// not executed on CheckTx, so no refund is made.
+//
+// This PostHandler decorator will fail if:
+//   - the refund cannot be transferred from the sender to the paymaster
+func (eprd EthPaymasterRefundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+	if ctx.IsCheckTx() || len(msgs) != 1 {
+		return next(ctx, tx, simulate)
+	}
+
+	msgEthTx, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	// the EVM module resets the gas meter to the gas used by the transaction,
+	// and the refund itself is not charged to the transaction
+	gasUsed := ctx.GasMeter().GasConsumed()
+	refundCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if err := eprd.pk.RefundSponsoredGas(refundCtx, msgEthTx.GetFrom(), gasUsed); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to refund sponsored gas")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// sponsoredEVMKeeper wraps the EVM keeper so that the fee of the transactions
+// sponsored by a paymaster is checked against and deducted from the paymaster
+// balance instead of the sender balance
+type sponsoredEVMKeeper struct {
+	ethante.EVMKeeper
+	pk PaymasterKeeper
+}
+
+// newSponsoredEVMKeeper wraps the EVM keeper of the options with the paymaster
+// keeper
+func newSponsoredEVMKeeper(options HandlerOptions) ethante.EVMKeeper {
+	return sponsoredEVMKeeper{
+		EVMKeeper: options.EvmKeeper,
+		pk:        options.PaymasterKeeper,
+	}
+}
+
+// GetAccount adds the sponsored maximum fee to the balance of the sender, so
+// that the sender balance only has to cover the transferred value
+func (k sponsoredEVMKeeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	acct := k.EVMKeeper.GetAccount(ctx, addr)
+	if acct == nil {
+		return nil
+	}
+
+	if sponsorship, found := k.pk.GetSponsorship(ctx, addr.Bytes()); found {
+		acct.Balance = new(big.Int).Add(acct.Balance, sponsorship.FeeCap.BigInt())
+	}
+	return acct
+}
+
+// DeductTxCostsFromUserBalance deducts the fees of a sponsored transaction from
+// the paymaster balance, and from the sender balance otherwise
+func (k sponsoredEVMKeeper) DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error {
+	if _, found := k.pk.GetSponsorship(ctx, from.Bytes()); !found {
+		return k.EVMKeeper.DeductTxCostsFromUserBalance(ctx, fees, from)
+	}
+	return k.pk.ChargeSponsoredFee(ctx, from.Bytes(), fees)
+}
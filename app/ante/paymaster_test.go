@@ -0,0 +1,196 @@
+package ante_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/app/ante"
+	"github.com/evmos/evmos/v11/testutil"
+	paymastertypes "github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+func (suite *AnteTestSuite) TestEthPaymasterDecorator() {
+	to := tests.GenerateAddress()
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	// gas limit of 100k at 1 gwei
+	fee := sdk.NewInt(100_000_000_000_000)
+
+	testCases := []struct {
+		name       string
+		targets    []paymastertypes.Target
+		deposit    sdk.Int
+		expSponsor bool
+	}{
+		{
+			"no-op - contract not sponsored",
+			[]paymastertypes.Target{{Contract: tests.GenerateAddress().Hex()}},
+			fee,
+			false,
+		},
+		{
+			"no-op - method not sponsored",
+			[]paymastertypes.Target{{Contract: to.Hex(), Selector: "0xa9059cbb"}},
+			fee,
+			false,
+		},
+		{
+			"no-op - insufficient paymaster balance",
+			[]paymastertypes.Target{{Contract: to.Hex()}},
+			fee.SubRaw(1),
+			false,
+		},
+		{
+			"pass - fee sponsored",
+			[]paymastertypes.Target{{Contract: to.Hex()}},
+			fee,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			from := tests.GenerateAddress()
+
+			paymaster := paymastertypes.NewPaymaster(owner, tc.targets, fee)
+			paymaster.Balance = tc.deposit
+			suite.app.PaymasterKeeper.StorePaymaster(suite.ctx, paymaster)
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, paymastertypes.ModuleName, sdk.NewCoins(sdk.NewCoin(suite.denom, tc.deposit)))
+			suite.Require().NoError(err)
+
+			msg := suite.BuildTestEthTx(from, to, big.NewInt(1_000_000_000), nil, nil, nil)
+			tx := suite.CreateEthTestTxBuilder(msg).GetTx()
+
+			dec := ante.NewEthPaymasterDecorator(suite.app.AccountKeeper, suite.app.PaymasterKeeper)
+			_, err = dec.AnteHandle(suite.ctx, tx, false, nextFn)
+			suite.Require().NoError(err)
+
+			// the fee is not charged yet
+			paymaster, _ = suite.app.PaymasterKeeper.GetPaymaster(suite.ctx, owner)
+			suite.Require().Equal(tc.deposit, paymaster.Balance)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, from.Bytes(), suite.denom).IsZero())
+
+			sponsorship, found := suite.app.PaymasterKeeper.GetSponsorship(suite.ctx, from.Bytes())
+			suite.Require().Equal(tc.expSponsor, found)
+			suite.Require().Equal(tc.expSponsor, suite.app.AccountKeeper.HasAccount(suite.ctx, from.Bytes()))
+			if tc.expSponsor {
+				suite.Require().Equal(fee, sponsorship.FeeCap)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestSponsoredEthTransaction() {
+	to := tests.GenerateAddress()
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	// gas limit of 100k at 1 gwei
+	fee := sdk.NewInt(100_000_000_000_000)
+	deposit := fee.MulRaw(2)
+	gasUsed := uint64(60_000)
+	refund := sdk.NewInt(40_000_000_000_000)
+
+	testCases := []struct {
+		name     string
+		targets  []paymastertypes.Target
+		checkTx  bool
+		expError bool
+	}{
+		{
+			"fail - not sponsored, sender has no balance",
+			[]paymastertypes.Target{{Contract: tests.GenerateAddress().Hex()}},
+			false,
+			true,
+		},
+		{
+			"fail - not sponsored, sender has no balance on CheckTx",
+			[]paymastertypes.Target{{Contract: tests.GenerateAddress().Hex()}},
+			true,
+			true,
+		},
+		{
+			"pass - fee charged to the paymaster on CheckTx",
+			[]paymastertypes.Target{{Contract: to.Hex()}},
+			true,
+			false,
+		},
+		{
+			"pass - fee charged to the paymaster and unused gas refunded",
+			[]paymastertypes.Target{{Contract: to.Hex()}},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			from, priv := tests.NewAddrKey()
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx).WithBlockGasMeter(sdk.NewGasMeter(10_000_000))
+			suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, from.Bytes()))
+
+			paymaster := paymastertypes.NewPaymaster(owner, tc.targets, deposit)
+			paymaster.Balance = deposit
+			suite.app.PaymasterKeeper.StorePaymaster(ctx, paymaster)
+			err := testutil.FundModuleAccount(ctx, suite.app.BankKeeper, paymastertypes.ModuleName, sdk.NewCoins(sdk.NewCoin(suite.denom, deposit)))
+			suite.Require().NoError(err)
+
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			collected := suite.app.BankKeeper.GetBalance(ctx, feeCollector, suite.denom)
+
+			msg := suite.BuildTestEthTx(from, to, big.NewInt(1_000_000_000), nil, nil, nil)
+			err = msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), tests.NewSigner(priv))
+			suite.Require().NoError(err)
+			tx := suite.CreateEthTestTxBuilder(msg).GetTx()
+
+			// run the Ethereum chain with the sender set on the message
+			registry := ante.NewRegistry()
+			suite.Require().NoError(registry.Remove(ante.ChainEthereum, ante.DecoratorEthValidateBasic))
+			anteHandler := ante.NewAnteHandler(ante.HandlerOptions{
+				AccountKeeper:   suite.app.AccountKeeper,
+				BankKeeper:      suite.app.BankKeeper,
+				EvmKeeper:       suite.app.EvmKeeper,
+				FeeMarketKeeper: suite.app.FeeMarketKeeper,
+				MsgFilterKeeper: suite.app.MsgFilterKeeper,
+				FeeAbsKeeper:    suite.app.FeeAbsKeeper,
+				PaymasterKeeper: suite.app.PaymasterKeeper,
+				Registry:        registry,
+			})
+
+			ctx, err = anteHandler(ctx, tx, false)
+			if tc.expError {
+				suite.Require().ErrorIs(err, errortypes.ErrInsufficientFunds)
+				return
+			}
+			suite.Require().NoError(err)
+
+			paymaster, _ = suite.app.PaymasterKeeper.GetPaymaster(ctx, owner)
+			suite.Require().Equal(deposit.Sub(fee), paymaster.Balance)
+			suite.Require().Equal(fee, suite.app.PaymasterKeeper.GetUserSpend(ctx, owner, from.Bytes()))
+			suite.Require().Equal(collected.AddAmount(fee), suite.app.BankKeeper.GetBalance(ctx, feeCollector, suite.denom))
+			suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, from.Bytes(), suite.denom).IsZero())
+
+			// the EVM module refunds the unused gas to the sender
+			ctx.GasMeter().ConsumeGas(gasUsed, "evm transaction")
+			expBalance := deposit.Sub(fee)
+			if !tc.checkTx {
+				err = testutil.FundAccount(ctx, suite.app.BankKeeper, from.Bytes(), sdk.NewCoins(sdk.NewCoin(suite.denom, refund)))
+				suite.Require().NoError(err)
+				expBalance = expBalance.Add(refund)
+			}
+
+			dec := ante.NewEthPaymasterRefundDecorator(suite.app.PaymasterKeeper)
+			_, err = dec.AnteHandle(ctx, tx, false, nextFn)
+			suite.Require().NoError(err)
+
+			paymaster, _ = suite.app.PaymasterKeeper.GetPaymaster(ctx, owner)
+			suite.Require().Equal(expBalance, paymaster.Balance)
+			suite.Require().Equal(deposit.Sub(expBalance), suite.app.PaymasterKeeper.GetUserSpend(ctx, owner, from.Bytes()))
+			suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, from.Bytes(), suite.denom).IsZero())
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ante
+
+import (
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+	ethante "github.com/evmos/ethermint/app/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/evmos/evmos/v11/server/config"
+)
+
+// RateLimiter keeps track, in memory, of the transactions admitted to the
+// mempool for the current block, per sender and per called contract. It is
+// local to the node and its state is reset on every new block height.
+type RateLimiter struct {
+	mu  sync.Mutex
+	cfg config.RateLimitConfig
+
+	height    int64
+	senders   map[string]rateUsage
+	contracts map[common.Address]rateUsage
+}
+
+// rateUsage is the number of txs and the gas admitted in the current block
+type rateUsage struct {
+	txs uint64
+	gas uint64
+}
+
+// exceeds returns true if a tx with the given gas can't be admitted without
+// exceeding the limits. A limit of 0 is unlimited.
+func (u rateUsage) exceeds(maxTxs, maxGas, gas uint64) bool {
+	if maxTxs != 0 && u.txs+1 > maxTxs {
+		return true
+	}
+	// gas additions are compared with a subtraction to prevent overflows
+	return maxGas != 0 && (gas > maxGas || u.gas > maxGas-gas)
+}
+
+// add returns the usage with an additional tx with the given gas
+func (u rateUsage) add(gas uint64) rateUsage {
+	return rateUsage{txs: u.txs + 1, gas: u.gas + gas}
+}
+
+// NewRateLimiter creates a new RateLimiter from the app.toml configuration
+func NewRateLimiter(cfg config.RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		cfg:       cfg,
+		senders:   make(map[string]rateUsage),
+		contracts: make(map[common.Address]rateUsage),
+	}
+}
+
+// Enabled returns true if the rate limits are enforced
+func (rl *RateLimiter) Enabled() bool {
+	return rl != nil && rl.cfg.Enable
+}
+
+// RateRequest is a transaction of the senders, calling the given contract (if
+// not nil) with the given gas limit
+type RateRequest struct {
+	Senders  []string
+	Contract *common.Address
+	Gas      uint64
+}
+
+// Check returns an error if the requests can't be admitted for the given
+// block height without exceeding any of the limits. Nothing is recorded.
+func (rl *RateLimiter) Check(height int64, reqs ...RateRequest) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	_, _, err := rl.apply(height, reqs)
+	return err
+}
+
+// Admit records the requests for the given block height. It fails without
+// recording any of them if any of the limits is exceeded.
+func (rl *RateLimiter) Admit(height int64, reqs ...RateRequest) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	senders, contracts, err := rl.apply(height, reqs)
+	if err != nil {
+		return err
+	}
+
+	if height != rl.height {
+		rl.height = height
+		rl.senders = make(map[string]rateUsage)
+		rl.contracts = make(map[common.Address]rateUsage)
+	}
+
+	for sender, usage := range senders {
+		rl.senders[sender] = usage
+	}
+	for contract, usage := range contracts {
+		rl.contracts[contract] = usage
+	}
+
+	return nil
+}
+
+// apply returns the usage of the senders and contracts of the requests once
+// they are added, one after the other, to the usage of the given block height.
+// It fails if any of the limits is exceeded. The caller must hold the lock.
+func (rl *RateLimiter) apply(height int64, reqs []RateRequest) (map[string]rateUsage, map[common.Address]rateUsage, error) {
+	senders := make(map[string]rateUsage)
+	contracts := make(map[common.Address]rateUsage)
+
+	for _, req := range reqs {
+		for _, sender := range req.Senders {
+			usage, ok := senders[sender]
+			if !ok && height == rl.height {
+				usage = rl.senders[sender]
+			}
+			if usage.exceeds(rl.cfg.MaxTxsPerSender, rl.cfg.MaxGasPerSender, req.Gas) {
+				return nil, nil, errorsmod.Wrapf(errortypes.ErrMempoolIsFull, "sender %s exceeded the rate limit of block %d", sender, height)
+			}
+			senders[sender] = usage.add(req.Gas)
+		}
+
+		if req.Contract == nil {
+			continue
+		}
+
+		usage, ok := contracts[*req.Contract]
+		if !ok && height == rl.height {
+			usage = rl.contracts[*req.Contract]
+		}
+		if usage.exceeds(rl.cfg.MaxTxsPerContract, rl.cfg.MaxGasPerContract, req.Gas) {
+			return nil, nil, errorsmod.Wrapf(errortypes.ErrMempoolIsFull, "contract %s exceeded the rate limit of block %d", req.Contract, height)
+		}
+		contracts[*req.Contract] = usage.add(req.Gas)
+	}
+
+	return senders, contracts, nil
+}
+
+// RateLimitDecorator limits the Cosmos transactions that the signers can get
+// admitted to the mempool per block. It only runs on CheckTx, so that it never
+// affects the execution of blocks.
+type RateLimitDecorator struct {
+	rl *RateLimiter
+}
+
+// NewRateLimitDecorator creates a new RateLimitDecorator. The decorator is a
+// no-op if the rate limiter is nil or disabled.
+func NewRateLimitDecorator(rl *RateLimiter) RateLimitDecorator {
+	return RateLimitDecorator{
+		rl: rl,
+	}
+}
+
+// AnteHandle records the transaction for each of its signers, once the rest of
+// the AnteHandler chain succeeded.
+//
+// This AnteHandler decorator will fail if:
+//   - the transaction is not a FeeTx nor a SigVerifiableTx
+//   - any of the signers exceeded its limits
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !rld.rl.Enabled() || !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "transaction is not a FeeTx")
+	}
+
+	signers := sigTx.GetSigners()
+	senders := make([]string, len(signers))
+	for i, signer := range signers {
+		senders[i] = signer.String()
+	}
+
+	req := RateRequest{Senders: senders, Gas: feeTx.GetGas()}
+	return admitAfter(ctx, rld.rl, []RateRequest{req}, func() (sdk.Context, error) {
+		return next(ctx, tx, simulate)
+	})
+}
+
+// EthRateLimitDecorator limits the Ethereum transactions that a sender can get
+// admitted to the mempool per block, and the ones calling a contract. It only
+// runs on CheckTx, so that it never affects the execution of blocks.
+type EthRateLimitDecorator struct {
+	rl        *RateLimiter
+	evmKeeper ethante.EVMKeeper
+}
+
+// NewEthRateLimitDecorator creates a new EthRateLimitDecorator. The decorator
+// is a no-op if the rate limiter is nil or disabled.
+func NewEthRateLimitDecorator(rl *RateLimiter, ek ethante.EVMKeeper) EthRateLimitDecorator {
+	return EthRateLimitDecorator{
+		rl:        rl,
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle records each Ethereum transaction for its sender and, if the
+// recipient is a contract, for the called contract, once the rest of the
+// AnteHandler chain succeeded.
+//
+// This AnteHandler decorator will fail if:
+//   - the message is not a MsgEthereumTx
+//   - the transaction data cannot be unpacked
+//   - the sender or the called contract exceeded its limits
+func (erld EthRateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !erld.rl.Enabled() || !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	msgs := tx.GetMsgs()
+	reqs := make([]RateRequest, 0, len(msgs))
+	for _, msg := range msgs {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest,
+				"invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil),
+			)
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		var contract *common.Address
+		if to := txData.GetTo(); to != nil {
+			if acc := erld.evmKeeper.GetAccount(ctx, *to); acc != nil && acc.IsContract() {
+				contract = to
+			}
+		}
+
+		reqs = append(reqs, RateRequest{
+			Senders:  []string{msgEthTx.GetFrom().String()},
+			Contract: contract,
+			Gas:      txData.GetGas(),
+		})
+	}
+
+	return admitAfter(ctx, erld.rl, reqs, func() (sdk.Context, error) {
+		return next(ctx, tx, simulate)
+	})
+}
+
+// admitAfter rejects the requests early if they exceed the limits, and only
+// records them once the rest of the AnteHandler chain succeeded, so that the
+// transactions rejected by a later decorator don't count towards the limits.
+// The limits are checked again when recording, as concurrent transactions may
+// have been admitted in the meantime.
+func admitAfter(ctx sdk.Context, rl *RateLimiter, reqs []RateRequest, next func() (sdk.Context, error)) (sdk.Context, error) {
+	if err := rl.Check(ctx.BlockHeight(), reqs...); err != nil {
+		return ctx, err
+	}
+
+	newCtx, err := next()
+	if err != nil {
+		return newCtx, err
+	}
+
+	if err := rl.Admit(ctx.BlockHeight(), reqs...); err != nil {
+		return ctx, err
+	}
+
+	return newCtx, nil
+}
@@ -0,0 +1,145 @@
+package ante_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/app/ante"
+	"github.com/evmos/evmos/v11/server/config"
+)
+
+func TestRateLimiterAdmit(t *testing.T) {
+	sender := []string{"sender"}
+	other := []string{"other"}
+	contract := tests.GenerateAddress()
+
+	rl := ante.NewRateLimiter(config.RateLimitConfig{
+		Enable:            true,
+		MaxTxsPerSender:   2,
+		MaxGasPerSender:   100,
+		MaxTxsPerContract: 3,
+	})
+
+	require.NoError(t, rl.Admit(1, ante.RateRequest{Senders: sender, Gas: 60}))
+	// gas limit exceeded
+	require.Error(t, rl.Admit(1, ante.RateRequest{Senders: sender, Gas: 50}))
+	require.NoError(t, rl.Admit(1, ante.RateRequest{Senders: sender, Contract: &contract, Gas: 40}))
+	// tx limit exceeded
+	require.Error(t, rl.Admit(1, ante.RateRequest{Senders: sender}))
+	// other senders and heights are not affected
+	require.NoError(t, rl.Admit(1, ante.RateRequest{Senders: other, Contract: &contract, Gas: 10}))
+	require.NoError(t, rl.Admit(2, ante.RateRequest{Senders: sender, Gas: 100}))
+	require.Error(t, rl.Admit(2, ante.RateRequest{Senders: sender, Gas: 1}))
+
+	// the contract limit is reset on a new height
+	require.NoError(t, rl.Admit(3, ante.RateRequest{Senders: other, Contract: &contract}))
+	require.NoError(t, rl.Admit(3, ante.RateRequest{Senders: sender, Contract: &contract}))
+	require.NoError(t, rl.Admit(3, ante.RateRequest{Senders: []string{"third"}, Contract: &contract}))
+	require.Error(t, rl.Admit(3, ante.RateRequest{Senders: []string{"fourth"}, Contract: &contract}))
+	// rejected txs are not recorded for the sender
+	require.NoError(t, rl.Admit(3, ante.RateRequest{Senders: []string{"fourth"}}))
+
+	// checks don't record the txs
+	require.NoError(t, rl.Check(4, ante.RateRequest{Senders: sender, Gas: 100}))
+	require.NoError(t, rl.Check(4, ante.RateRequest{Senders: sender, Gas: 100}))
+	// the requests of a tx are cumulated and recorded together
+	require.Error(t, rl.Check(4, ante.RateRequest{Senders: sender, Gas: 60}, ante.RateRequest{Senders: sender, Gas: 50}))
+	require.Error(t, rl.Admit(4, ante.RateRequest{Senders: other, Gas: 10}, ante.RateRequest{Senders: sender, Gas: 60}, ante.RateRequest{Senders: sender, Gas: 50}))
+	require.NoError(t, rl.Admit(4, ante.RateRequest{Senders: other, Gas: 100}))
+}
+
+func (suite *AnteTestSuite) TestEthRateLimitDecorator() {
+	from := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	account := tests.GenerateAddress()
+
+	testCases := []struct {
+		name      string
+		to        common.Address
+		checkTx   bool
+		reCheckTx bool
+		enable    bool
+		expPass   bool
+	}{
+		{"pass - disabled", contract, true, false, false, true},
+		{"pass - DeliverTx", contract, false, false, true, true},
+		{"pass - ReCheckTx", contract, true, true, true, true},
+		{"pass - recipient is not a contract", account, true, false, true, true},
+		{"fail - contract limit exceeded", contract, true, false, true, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			err := suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{
+				Balance:  big.NewInt(0),
+				CodeHash: crypto.Keccak256([]byte("code")),
+			})
+			suite.Require().NoError(err)
+
+			rl := ante.NewRateLimiter(config.RateLimitConfig{Enable: tc.enable, MaxTxsPerContract: 1})
+			dec := ante.NewEthRateLimitDecorator(rl, suite.app.EvmKeeper)
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx).WithIsReCheckTx(tc.reCheckTx)
+
+			for i := 0; i < 2; i++ {
+				msg := suite.BuildTestEthTx(from, tc.to, big.NewInt(1), nil, nil, nil)
+				tx := suite.CreateEthTestTxBuilder(msg).GetTx()
+				_, err = dec.AnteHandle(ctx, tx, false, nextFn)
+			}
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestRateLimitDecorator() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	rl := ante.NewRateLimiter(config.RateLimitConfig{Enable: true, MaxTxsPerSender: 1})
+	dec := ante.NewRateLimitDecorator(rl)
+
+	msg := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1)))
+	tx := suite.CreateTestTxBuilder(sdk.NewInt(1), suite.denom, msg).GetTx()
+
+	// DeliverTx is never limited
+	ctx := suite.ctx.WithIsCheckTx(false)
+	for i := 0; i < 2; i++ {
+		_, err := dec.AnteHandle(ctx, tx, false, nextFn)
+		suite.Require().NoError(err)
+	}
+
+	ctx = suite.ctx.WithIsCheckTx(true)
+	_, err := dec.AnteHandle(ctx, tx, false, nextFn)
+	suite.Require().NoError(err)
+	_, err = dec.AnteHandle(ctx, tx, false, nextFn)
+	suite.Require().Error(err)
+	// simulations are not limited
+	_, err = dec.AnteHandle(ctx, tx, true, nextFn)
+	suite.Require().NoError(err)
+	// the limit is reset on the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = dec.AnteHandle(ctx, tx, false, nextFn)
+	suite.Require().NoError(err)
+
+	// txs rejected by the rest of the chain are not recorded
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = dec.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, errortypes.ErrInsufficientFee
+	})
+	suite.Require().ErrorIs(err, errortypes.ErrInsufficientFee)
+	_, err = dec.AnteHandle(ctx, tx, false, nextFn)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,277 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Names of the default decorator chains
+const (
+	// ChainEthereum is the chain for Ethereum transactions (i.e MsgEthereumTx)
+	ChainEthereum = "ethereum"
+	// ChainCosmos is the chain for Cosmos SDK transactions
+	ChainCosmos = "cosmos"
+	// ChainLegacyEIP712 is the chain for Cosmos SDK transactions signed with
+	// the legacy EIP-712 extension option
+	ChainLegacyEIP712 = "legacy-eip712"
+)
+
+// Type URLs of the extension options routed by the default registry
+const (
+	ExtensionOptionsEthereumTxTypeURL  = "/ethermint.evm.v1.ExtensionOptionsEthereumTx"
+	ExtensionOptionsWeb3TxTypeURL      = "/ethermint.types.v1.ExtensionOptionsWeb3Tx"
+	ExtensionOptionDynamicFeeTxTypeURL = "/ethermint.types.v1.ExtensionOptionDynamicFeeTx"
+)
+
+// DecoratorFactory creates an AnteDecorator from the handler options
+type DecoratorFactory func(options HandlerOptions) sdk.AnteDecorator
+
+// NamedDecorator is an AnteDecorator identified by a name that is unique
+// within its chain
+type NamedDecorator struct {
+	Name string
+	New  DecoratorFactory
+}
+
+// Registry defines the decorator chains of the AnteHandler and the extension
+// options that route a transaction to each chain. It allows app-chains built
+// on Evmos to insert, replace or remove decorators without copying the
+// default chains.
+type Registry struct {
+	chains           map[string][]NamedDecorator
+	extensionOptions map[string]string
+	defaultChain     string
+}
+
+// NewRegistry returns a Registry with the default Evmos decorator chains
+func NewRegistry() *Registry {
+	r := &Registry{
+		chains:           make(map[string][]NamedDecorator),
+		extensionOptions: make(map[string]string),
+		defaultChain:     ChainCosmos,
+	}
+
+	r.chains[ChainEthereum] = defaultEthDecorators()
+	r.chains[ChainCosmos] = defaultCosmosDecorators()
+	r.chains[ChainLegacyEIP712] = defaultLegacyEIP712Decorators()
+
+	// handle as *evmtypes.MsgEthereumTx
+	r.extensionOptions[ExtensionOptionsEthereumTxTypeURL] = ChainEthereum
+	// handle as normal Cosmos SDK tx, except signature is checked for EIP712 representation
+	r.extensionOptions[ExtensionOptionsWeb3TxTypeURL] = ChainLegacyEIP712
+	// cosmos-sdk tx with dynamic fee extension
+	r.extensionOptions[ExtensionOptionDynamicFeeTxTypeURL] = ChainCosmos
+
+	return r
+}
+
+// Decorators returns the names of the decorators of a chain in execution
+// order
+func (r *Registry) Decorators(chain string) ([]string, error) {
+	decorators, err := r.getChain(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(decorators))
+	for i, decorator := range decorators {
+		names[i] = decorator.Name
+	}
+	return names, nil
+}
+
+// RegisterChain adds a new decorator chain
+func (r *Registry) RegisterChain(chain string, decorators ...NamedDecorator) error {
+	if _, found := r.chains[chain]; found {
+		return fmt.Errorf("decorator chain %s already registered", chain)
+	}
+
+	seen := make(map[string]bool, len(decorators))
+	for _, decorator := range decorators {
+		if err := decorator.validate(); err != nil {
+			return err
+		}
+		if seen[decorator.Name] {
+			return fmt.Errorf("duplicate decorator %s on chain %s", decorator.Name, chain)
+		}
+		seen[decorator.Name] = true
+	}
+
+	r.chains[chain] = append([]NamedDecorator{}, decorators...)
+	return nil
+}
+
+// RegisterExtensionOption routes the transactions whose first extension
+// option has the given type URL to a registered chain
+func (r *Registry) RegisterExtensionOption(typeURL, chain string) error {
+	if _, found := r.extensionOptions[typeURL]; found {
+		return fmt.Errorf("extension option %s already registered", typeURL)
+	}
+	if _, err := r.getChain(chain); err != nil {
+		return err
+	}
+
+	r.extensionOptions[typeURL] = chain
+	return nil
+}
+
+// SetDefaultChain sets the chain for the transactions without extension
+// options
+func (r *Registry) SetDefaultChain(chain string) error {
+	if _, err := r.getChain(chain); err != nil {
+		return err
+	}
+
+	r.defaultChain = chain
+	return nil
+}
+
+// InsertBefore inserts a decorator before the named decorator of a chain
+func (r *Registry) InsertBefore(chain, before string, decorator NamedDecorator) error {
+	return r.insert(chain, before, 0, decorator)
+}
+
+// InsertAfter inserts a decorator after the named decorator of a chain
+func (r *Registry) InsertAfter(chain, after string, decorator NamedDecorator) error {
+	return r.insert(chain, after, 1, decorator)
+}
+
+// Append adds a decorator at the end of a chain
+func (r *Registry) Append(chain string, decorator NamedDecorator) error {
+	decorators, err := r.getChain(chain)
+	if err != nil {
+		return err
+	}
+	if err := r.checkInsert(chain, decorators, decorator); err != nil {
+		return err
+	}
+
+	r.chains[chain] = append(decorators, decorator)
+	return nil
+}
+
+// Replace replaces the factory of the named decorator of a chain, keeping
+// its position
+func (r *Registry) Replace(chain, name string, factory DecoratorFactory) error {
+	decorators, index, err := r.find(chain, name)
+	if err != nil {
+		return err
+	}
+	if factory == nil {
+		return fmt.Errorf("decorator %s factory cannot be nil", name)
+	}
+
+	decorators[index].New = factory
+	return nil
+}
+
+// Remove removes the named decorator of a chain
+func (r *Registry) Remove(chain, name string) error {
+	decorators, index, err := r.find(chain, name)
+	if err != nil {
+		return err
+	}
+
+	r.chains[chain] = append(decorators[:index:index], decorators[index+1:]...)
+	return nil
+}
+
+// anteHandlers builds the AnteHandler of every chain, the extension options
+// routes and the default AnteHandler for transactions without extension
+// options
+func (r *Registry) anteHandlers(options HandlerOptions) (routes map[string]sdk.AnteHandler, defaultHandler sdk.AnteHandler) {
+	handlers := make(map[string]sdk.AnteHandler, len(r.chains))
+	for chain, decorators := range r.chains {
+		anteDecorators := make([]sdk.AnteDecorator, len(decorators))
+		for i, decorator := range decorators {
+			anteDecorators[i] = decorator.New(options)
+		}
+		handlers[chain] = sdk.ChainAnteDecorators(anteDecorators...)
+	}
+
+	routes = make(map[string]sdk.AnteHandler, len(r.extensionOptions))
+	for typeURL, chain := range r.extensionOptions {
+		routes[typeURL] = handlers[chain]
+	}
+
+	return routes, handlers[r.defaultChain]
+}
+
+func (r *Registry) getChain(chain string) ([]NamedDecorator, error) {
+	decorators, found := r.chains[chain]
+	if !found {
+		return nil, fmt.Errorf("decorator chain %s not found", chain)
+	}
+	return decorators, nil
+}
+
+func (r *Registry) find(chain, name string) ([]NamedDecorator, int, error) {
+	decorators, err := r.getChain(chain)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i, decorator := range decorators {
+		if decorator.Name == name {
+			return decorators, i, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("decorator %s not found on chain %s", name, chain)
+}
+
+func (r *Registry) insert(chain, name string, offset int, decorator NamedDecorator) error {
+	decorators, index, err := r.find(chain, name)
+	if err != nil {
+		return err
+	}
+	if err := r.checkInsert(chain, decorators, decorator); err != nil {
+		return err
+	}
+
+	index += offset
+	updated := make([]NamedDecorator, 0, len(decorators)+1)
+	updated = append(updated, decorators[:index]...)
+	updated = append(updated, decorator)
+	updated = append(updated, decorators[index:]...)
+	r.chains[chain] = updated
+	return nil
+}
+
+func (r *Registry) checkInsert(chain string, decorators []NamedDecorator, decorator NamedDecorator) error {
+	if err := decorator.validate(); err != nil {
+		return err
+	}
+	for _, d := range decorators {
+		if d.Name == decorator.Name {
+			return fmt.Errorf("decorator %s already registered on chain %s", decorator.Name, chain)
+		}
+	}
+	return nil
+}
+
+func (d NamedDecorator) validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("decorator name cannot be empty")
+	}
+	if d.New == nil {
+		return fmt.Errorf("decorator %s factory cannot be nil", d.Name)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package ante_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/evmos/ethermint/encoding"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/app"
+	"github.com/evmos/evmos/v11/app/ante"
+)
+
+// recordDecorator appends its name to the list of called decorators
+type recordDecorator struct {
+	name  string
+	calls *[]string
+}
+
+func (rd recordDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*rd.calls = append(*rd.calls, rd.name)
+	return next(ctx, tx, simulate)
+}
+
+func newRecordDecorator(name string, calls *[]string) ante.NamedDecorator {
+	return ante.NamedDecorator{
+		Name: name,
+		New: func(ante.HandlerOptions) sdk.AnteDecorator {
+			return recordDecorator{name: name, calls: calls}
+		},
+	}
+}
+
+func TestRegistryDefaultChains(t *testing.T) {
+	r := ante.NewRegistry()
+
+	names, err := r.Decorators(ante.ChainCosmos)
+	require.NoError(t, err)
+	require.Equal(t, ante.DecoratorRejectMessages, names[0])
+	require.Equal(t, ante.DecoratorGasWanted, names[len(names)-1])
+
+	names, err = r.Decorators(ante.ChainEthereum)
+	require.NoError(t, err)
+	require.Equal(t, ante.DecoratorEthSetUpContext, names[0])
+	require.Equal(t, ante.DecoratorEthEmitEvent, names[len(names)-1])
+
+	_, err = r.Decorators(ante.ChainLegacyEIP712)
+	require.NoError(t, err)
+
+	_, err = r.Decorators("unknown")
+	require.Error(t, err)
+}
+
+func TestRegistryEditChain(t *testing.T) {
+	var calls []string
+	r := ante.NewRegistry()
+
+	require.NoError(t, r.InsertAfter(ante.ChainCosmos, ante.DecoratorDeductFee, newRecordDecorator("after-fee", &calls)))
+	require.NoError(t, r.InsertBefore(ante.ChainCosmos, ante.DecoratorRejectMessages, newRecordDecorator("first", &calls)))
+	require.NoError(t, r.Append(ante.ChainCosmos, newRecordDecorator("last", &calls)))
+	require.NoError(t, r.Remove(ante.ChainCosmos, ante.DecoratorRedundantRelay))
+	require.NoError(t, r.Replace(ante.ChainCosmos, ante.DecoratorVestingDelegation, newRecordDecorator("vesting", &calls).New))
+
+	names, err := r.Decorators(ante.ChainCosmos)
+	require.NoError(t, err)
+	require.Equal(t, "first", names[0])
+	require.Equal(t, "last", names[len(names)-1])
+	require.NotContains(t, names, ante.DecoratorRedundantRelay)
+
+	for i, name := range names {
+		if name == ante.DecoratorDeductFee {
+			require.Equal(t, "after-fee", names[i+1])
+			require.Equal(t, ante.DecoratorVestingDelegation, names[i+2])
+		}
+	}
+
+	// the other chains are not modified
+	names, err = r.Decorators(ante.ChainLegacyEIP712)
+	require.NoError(t, err)
+	require.Contains(t, names, ante.DecoratorRedundantRelay)
+
+	// invalid edits
+	require.Error(t, r.InsertAfter(ante.ChainCosmos, "unknown", newRecordDecorator("x", &calls)))
+	require.Error(t, r.InsertAfter("unknown", ante.DecoratorDeductFee, newRecordDecorator("x", &calls)))
+	require.Error(t, r.Append(ante.ChainCosmos, newRecordDecorator("first", &calls)))
+	require.Error(t, r.Append(ante.ChainCosmos, ante.NamedDecorator{Name: "x"}))
+	require.Error(t, r.Remove(ante.ChainCosmos, ante.DecoratorRedundantRelay))
+	require.Error(t, r.Replace(ante.ChainCosmos, ante.DecoratorDeductFee, nil))
+}
+
+func TestRegistryExtensionOption(t *testing.T) {
+	var calls []string
+	typeURL := "/evmos.test.v1.ExtensionOption"
+
+	r := ante.NewRegistry()
+	require.Error(t, r.RegisterExtensionOption(typeURL, "custom"))
+	require.NoError(t, r.RegisterChain("custom", newRecordDecorator("a", &calls), newRecordDecorator("b", &calls)))
+	require.Error(t, r.RegisterChain("custom"))
+	require.Error(t, r.RegisterChain("duplicate", newRecordDecorator("a", &calls), newRecordDecorator("a", &calls)))
+	require.NoError(t, r.RegisterExtensionOption(typeURL, "custom"))
+	require.Error(t, r.RegisterExtensionOption(ante.ExtensionOptionsEthereumTxTypeURL, "custom"))
+
+	txBuilder := encoding.MakeConfig(app.ModuleBasics).TxConfig.NewTxBuilder()
+	extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	require.True(t, ok)
+	extBuilder.SetExtensionOptions(&codectypes.Any{TypeUrl: typeURL})
+
+	anteHandler := ante.NewAnteHandler(ante.HandlerOptions{Registry: r})
+	_, err := anteHandler(sdk.Context{}, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, calls)
+
+	// unknown extension options are rejected
+	extBuilder.SetExtensionOptions(&codectypes.Any{TypeUrl: "/evmos.test.v1.Unknown"})
+	_, err = anteHandler(sdk.Context{}, txBuilder.GetTx(), false)
+	require.Error(t, err)
+}
@@ -18,6 +18,7 @@ package ante
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -25,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
 	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
 )
 
@@ -118,7 +120,12 @@ func (vdd VestingDelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		switch msg := msg.(type) {
 		case *authz.MsgExec:
 			// Check for bypassing authorization
-			if err := vdd.validateAuthz(ctx, msg); err != nil {
+			if err := vdd.validateNestedMsgs(ctx, msg.Msgs); err != nil {
+				return ctx, err
+			}
+		case *claimstypes.MsgSubmitClaimProof:
+			// Check for bypassing with the claiming actions of a claim proof
+			if err := vdd.validateNestedMsgs(ctx, msg.Msgs); err != nil {
 				return ctx, err
 			}
 		default:
@@ -131,12 +138,13 @@ func (vdd VestingDelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	return next(ctx, tx, simulate)
 }
 
-// validateAuthz validates the authorization internal message
-func (vdd VestingDelegationDecorator) validateAuthz(ctx sdk.Context, execMsg *authz.MsgExec) error {
-	for _, v := range execMsg.Msgs {
+// validateNestedMsgs validates the internal messages of an authorization or
+// a claim proof
+func (vdd VestingDelegationDecorator) validateNestedMsgs(ctx sdk.Context, msgs []*codectypes.Any) error {
+	for _, v := range msgs {
 		var innerMsg sdk.Msg
 		if err := vdd.cdc.UnpackAny(v, &innerMsg); err != nil {
-			return errorsmod.Wrap(err, "cannot unmarshal authz exec msgs")
+			return errorsmod.Wrap(err, "cannot unmarshal nested msgs")
 		}
 
 		if err := vdd.validateMsg(ctx, innerMsg); err != nil {
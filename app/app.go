@@ -54,8 +54,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
-	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -118,6 +116,9 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 
 	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
@@ -142,11 +143,13 @@ import (
 	"github.com/evmos/evmos/v11/app/ante"
 	v10 "github.com/evmos/evmos/v11/app/upgrades/v10"
 	v11 "github.com/evmos/evmos/v11/app/upgrades/v11"
+	v12 "github.com/evmos/evmos/v11/app/upgrades/v12"
 	v8 "github.com/evmos/evmos/v11/app/upgrades/v8"
 	v81 "github.com/evmos/evmos/v11/app/upgrades/v8_1"
 	v82 "github.com/evmos/evmos/v11/app/upgrades/v8_2"
 	v9 "github.com/evmos/evmos/v11/app/upgrades/v9"
 	v91 "github.com/evmos/evmos/v11/app/upgrades/v9_1"
+	evmosconfig "github.com/evmos/evmos/v11/server/config"
 	"github.com/evmos/evmos/v11/x/claims"
 	claimskeeper "github.com/evmos/evmos/v11/x/claims/keeper"
 	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
@@ -157,6 +160,12 @@ import (
 	erc20client "github.com/evmos/evmos/v11/x/erc20/client"
 	erc20keeper "github.com/evmos/evmos/v11/x/erc20/keeper"
 	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	"github.com/evmos/evmos/v11/x/feeabs"
+	feeabskeeper "github.com/evmos/evmos/v11/x/feeabs/keeper"
+	feeabstypes "github.com/evmos/evmos/v11/x/feeabs/types"
+	"github.com/evmos/evmos/v11/x/forward"
+	forwardkeeper "github.com/evmos/evmos/v11/x/forward/keeper"
+	forwardtypes "github.com/evmos/evmos/v11/x/forward/types"
 	"github.com/evmos/evmos/v11/x/incentives"
 	incentivesclient "github.com/evmos/evmos/v11/x/incentives/client"
 	incentiveskeeper "github.com/evmos/evmos/v11/x/incentives/keeper"
@@ -164,6 +173,22 @@ import (
 	"github.com/evmos/evmos/v11/x/inflation"
 	inflationkeeper "github.com/evmos/evmos/v11/x/inflation/keeper"
 	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+	"github.com/evmos/evmos/v11/x/intertx"
+	intertxkeeper "github.com/evmos/evmos/v11/x/intertx/keeper"
+	intertxtypes "github.com/evmos/evmos/v11/x/intertx/types"
+
+	"github.com/evmos/evmos/v11/x/callbacks"
+	callbackskeeper "github.com/evmos/evmos/v11/x/callbacks/keeper"
+	callbackstypes "github.com/evmos/evmos/v11/x/callbacks/types"
+	"github.com/evmos/evmos/v11/x/msgfilter"
+	msgfilterkeeper "github.com/evmos/evmos/v11/x/msgfilter/keeper"
+	msgfiltertypes "github.com/evmos/evmos/v11/x/msgfilter/types"
+	"github.com/evmos/evmos/v11/x/paymaster"
+	paymasterkeeper "github.com/evmos/evmos/v11/x/paymaster/keeper"
+	paymastertypes "github.com/evmos/evmos/v11/x/paymaster/types"
+	"github.com/evmos/evmos/v11/x/ratelimit"
+	ratelimitkeeper "github.com/evmos/evmos/v11/x/ratelimit/keeper"
+	ratelimittypes "github.com/evmos/evmos/v11/x/ratelimit/types"
 	"github.com/evmos/evmos/v11/x/recovery"
 	recoverykeeper "github.com/evmos/evmos/v11/x/recovery/keeper"
 	recoverytypes "github.com/evmos/evmos/v11/x/recovery/types"
@@ -242,6 +267,13 @@ var (
 		claims.AppModuleBasic{},
 		recovery.AppModuleBasic{},
 		revenue.AppModuleBasic{},
+		msgfilter.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
+		paymaster.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		forward.AppModuleBasic{},
+		intertx.AppModuleBasic{},
+		callbacks.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -258,12 +290,21 @@ var (
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		claimstypes.ModuleName:         nil,
 		incentivestypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		feeabstypes.ModuleName:         nil,
+		paymastertypes.ModuleName:      nil,
+		forwardtypes.ModuleName:        {authtypes.Burner},
+		intertxtypes.ModuleName:        nil,
+		callbackstypes.ModuleName:      nil,
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
 		distrtypes.ModuleName:      true,
 		incentivestypes.ModuleName: true,
+		// holds the reserve used to convert the fees paid with fee tokens
+		feeabstypes.ModuleName: true,
+		// receives the IBC transfers forwarded to another chain
+		forwardtypes.ModuleName: true,
 	}
 )
 
@@ -285,28 +326,32 @@ type Evmos struct {
 
 	invCheckPeriod uint
 
+	// telemetryEnabled records the module account balance gauges every block
+	telemetryEnabled bool
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
 	memKeys map[string]*storetypes.MemoryStoreKey
 
 	// keepers
-	AccountKeeper    authkeeper.AccountKeeper
-	BankKeeper       bankkeeper.Keeper
-	CapabilityKeeper *capabilitykeeper.Keeper
-	StakingKeeper    stakingkeeper.Keeper
-	SlashingKeeper   slashingkeeper.Keeper
-	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
-	CrisisKeeper     crisiskeeper.Keeper
-	UpgradeKeeper    upgradekeeper.Keeper
-	ParamsKeeper     paramskeeper.Keeper
-	FeeGrantKeeper   feegrantkeeper.Keeper
-	AuthzKeeper      authzkeeper.Keeper
-	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	ICAHostKeeper    icahostkeeper.Keeper
-	EvidenceKeeper   evidencekeeper.Keeper
-	TransferKeeper   transferkeeper.Keeper
+	AccountKeeper       authkeeper.AccountKeeper
+	BankKeeper          bankkeeper.Keeper
+	CapabilityKeeper    *capabilitykeeper.Keeper
+	StakingKeeper       stakingkeeper.Keeper
+	SlashingKeeper      slashingkeeper.Keeper
+	DistrKeeper         distrkeeper.Keeper
+	GovKeeper           govkeeper.Keeper
+	CrisisKeeper        crisiskeeper.Keeper
+	UpgradeKeeper       upgradekeeper.Keeper
+	ParamsKeeper        paramskeeper.Keeper
+	FeeGrantKeeper      feegrantkeeper.Keeper
+	AuthzKeeper         authzkeeper.Keeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	ICAHostKeeper       icahostkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	EvidenceKeeper      evidencekeeper.Keeper
+	TransferKeeper      transferkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -325,10 +370,20 @@ type Evmos struct {
 	VestingKeeper    vestingkeeper.Keeper
 	RecoveryKeeper   *recoverykeeper.Keeper
 	RevenueKeeper    revenuekeeper.Keeper
+	MsgFilterKeeper  msgfilterkeeper.Keeper
+	FeeAbsKeeper     feeabskeeper.Keeper
+	PaymasterKeeper  paymasterkeeper.Keeper
+	RateLimitKeeper  *ratelimitkeeper.Keeper
+	ForwardKeeper    *forwardkeeper.Keeper
+	InterTxKeeper    intertxkeeper.Keeper
+	CallbacksKeeper  *callbackskeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
 
+	// simulation manager
+	sm *module.SimulationManager
+
 	// the configurator
 	configurator module.Configurator
 
@@ -376,17 +431,19 @@ func NewEvmos(
 		// ibc keys
 		ibchost.StoreKey, ibctransfertypes.StoreKey,
 		// ica keys
-		icahosttypes.StoreKey,
+		icahosttypes.StoreKey, icacontrollertypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 		// evmos keys
 		inflationtypes.StoreKey, erc20types.StoreKey, incentivestypes.StoreKey,
 		epochstypes.StoreKey, claimstypes.StoreKey, vestingtypes.StoreKey,
-		revenuetypes.StoreKey, recoverytypes.StoreKey,
+		revenuetypes.StoreKey, recoverytypes.StoreKey, msgfiltertypes.StoreKey,
+		feeabstypes.StoreKey, paymastertypes.StoreKey, ratelimittypes.StoreKey,
+		forwardtypes.StoreKey, intertxtypes.StoreKey, callbackstypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey, paymastertypes.TransientKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	// load state streaming if enabled
@@ -401,6 +458,7 @@ func NewEvmos(
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
+		telemetryEnabled:  cast.ToBool(appOpts.Get(FlagTelemetryEnabled)),
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
@@ -417,6 +475,7 @@ func NewEvmos(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -468,6 +527,17 @@ func NewEvmos(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), &stakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// Create the app.ICAControllerKeeper
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper: no middleware
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		bApp.MsgServiceRouter(),
+	)
+
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
@@ -497,7 +567,7 @@ func NewEvmos(
 
 	app.ClaimsKeeper = claimskeeper.NewKeeper(
 		appCodec, keys[claimstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.DistrKeeper,
+		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.DistrKeeper, app.MsgServiceRouter(),
 	)
 
 	// register the staking hooks
@@ -532,7 +602,9 @@ func NewEvmos(
 		authtypes.FeeCollectorName,
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	epochsKeeper := epochskeeper.NewKeeper(
+		appCodec, keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
@@ -547,18 +619,38 @@ func NewEvmos(
 		),
 	)
 
+	app.InterTxKeeper = intertxkeeper.NewKeeper(
+		keys[intertxtypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.ICAControllerKeeper,
+		app.EvmKeeper,
+		app.Erc20Keeper,
+	)
+
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			app.Erc20Keeper.Hooks(),
 			app.IncentivesKeeper.Hooks(),
 			app.RevenueKeeper.Hooks(),
 			app.ClaimsKeeper.Hooks(),
+			app.InterTxKeeper.Hooks(),
 		),
 	)
 
+	app.CallbacksKeeper = callbackskeeper.NewKeeper(
+		keys[callbackstypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.EvmKeeper,
+		app.Erc20Keeper,
+	)
+
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.ClaimsKeeper, // ICS4 Wrapper: claims IBC middleware
+		app.CallbacksKeeper, // ICS4 Wrapper: callbacks IBC middleware
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
@@ -575,11 +667,57 @@ func NewEvmos(
 		app.ClaimsKeeper,
 	)
 
+	app.MsgFilterKeeper = msgfilterkeeper.NewKeeper(
+		keys[msgfiltertypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+
+	app.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		keys[feeabstypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.Erc20Keeper,
+		app.EvmKeeper,
+	)
+
+	app.PaymasterKeeper = paymasterkeeper.NewKeeper(
+		keys[paymastertypes.StoreKey],
+		tkeys[paymastertypes.TransientKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.EvmKeeper,
+	)
+
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper,
+	)
+
+	app.ForwardKeeper = forwardkeeper.NewKeeper(
+		keys[forwardtypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+	)
+
 	// NOTE: app.Erc20Keeper is already initialized elsewhere
 
 	// Set the ICS4 wrappers for custom module middlewares
-	app.RecoveryKeeper.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
+	app.RateLimitKeeper.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
+	app.RecoveryKeeper.SetICS4Wrapper(app.RateLimitKeeper)
+	app.ForwardKeeper.SetICS4Wrapper(app.RateLimitKeeper)
 	app.ClaimsKeeper.SetICS4Wrapper(app.RecoveryKeeper)
+	app.CallbacksKeeper.SetICS4Wrapper(app.ClaimsKeeper)
 
 	// Override the ICS20 app module
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
@@ -599,20 +737,28 @@ func NewEvmos(
 	// create host IBC module
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
 
+	// create controller IBC stack, authenticated by the intertx module
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = intertx.NewIBCModule(app.InterTxKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- Rate Limit Middleware
+			- Contract Callbacks Middleware
+			- Packet Forward Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 		 	- Airdrop Claims Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> callbacks.SendPacket -> claim.SendPacket -> recovery.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> ratelimit.OnRecvPacket -> callbacks.OnRecvPacket -> forward.OnRecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -622,11 +768,15 @@ func NewEvmos(
 	transferStack = claims.NewIBCMiddleware(*app.ClaimsKeeper, transferStack)
 	transferStack = recovery.NewIBCMiddleware(*app.RecoveryKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = forward.NewIBCMiddleware(*app.ForwardKeeper, transferStack)
+	transferStack = callbacks.NewIBCMiddleware(*app.CallbacksKeeper, transferStack)
+	transferStack = ratelimit.NewIBCMiddleware(*app.RateLimitKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack)
 
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -652,7 +802,7 @@ func NewEvmos(
 			app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx,
 			encodingConfig.TxConfig,
 		),
-		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		auth.NewAppModule(appCodec, app.AccountKeeper, RandomGenesisAccounts),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -668,7 +818,7 @@ func NewEvmos(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		ica.NewAppModule(nil, &app.ICAHostKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		transferModule,
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
@@ -676,18 +826,25 @@ func NewEvmos(
 		// Evmos app modules
 		inflation.NewAppModule(app.InflationKeeper, app.AccountKeeper, app.StakingKeeper,
 			app.GetSubspace(inflationtypes.ModuleName)),
-		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper,
+		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper, app.BankKeeper,
 			app.GetSubspace(erc20types.ModuleName)),
-		incentives.NewAppModule(app.IncentivesKeeper, app.AccountKeeper,
+		incentives.NewAppModule(app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper,
 			app.GetSubspace(incentivestypes.ModuleName)),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
-		claims.NewAppModule(appCodec, *app.ClaimsKeeper,
+		claims.NewAppModule(appCodec, *app.ClaimsKeeper, app.AccountKeeper, app.BankKeeper,
 			app.GetSubspace(claimstypes.ModuleName)),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		recovery.NewAppModule(*app.RecoveryKeeper,
 			app.GetSubspace(recoverytypes.ModuleName)),
-		revenue.NewAppModule(app.RevenueKeeper, app.AccountKeeper,
+		revenue.NewAppModule(app.RevenueKeeper, app.AccountKeeper, app.BankKeeper,
 			app.GetSubspace(revenuetypes.ModuleName)),
+		msgfilter.NewAppModule(app.MsgFilterKeeper),
+		feeabs.NewAppModule(app.FeeAbsKeeper),
+		paymaster.NewAppModule(app.PaymasterKeeper),
+		ratelimit.NewAppModule(*app.RateLimitKeeper),
+		forward.NewAppModule(*app.ForwardKeeper),
+		intertx.NewAppModule(app.InterTxKeeper),
+		callbacks.NewAppModule(*app.CallbacksKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -726,6 +883,13 @@ func NewEvmos(
 		incentivestypes.ModuleName,
 		recoverytypes.ModuleName,
 		revenuetypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		feeabstypes.ModuleName,
+		paymastertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		forwardtypes.ModuleName,
+		intertxtypes.ModuleName,
+		callbackstypes.ModuleName,
 	)
 
 	// NOTE: fee market module must go last in order to retrieve the block gas used.
@@ -760,6 +924,13 @@ func NewEvmos(
 		incentivestypes.ModuleName,
 		recoverytypes.ModuleName,
 		revenuetypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		feeabstypes.ModuleName,
+		paymastertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		forwardtypes.ModuleName,
+		intertxtypes.ModuleName,
+		callbackstypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -785,6 +956,9 @@ func NewEvmos(
 		// NOTE: feemarket module needs to be initialized before genutil module:
 		// gentx transactions use MinGasPriceDecorator.AnteHandle
 		feemarkettypes.ModuleName,
+		// NOTE: msgfilter module needs to be initialized before genutil module:
+		// gentx transactions use MsgFilterDecorator.AnteHandle
+		msgfiltertypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -801,6 +975,12 @@ func NewEvmos(
 		epochstypes.ModuleName,
 		recoverytypes.ModuleName,
 		revenuetypes.ModuleName,
+		feeabstypes.ModuleName,
+		paymastertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		forwardtypes.ModuleName,
+		intertxtypes.ModuleName,
+		callbackstypes.ModuleName,
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
 	)
@@ -810,6 +990,41 @@ func NewEvmos(
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
+	// create the simulation manager and define the order of the modules for deterministic simulations
+	//
+	// NOTE: this is not required for apps that don't use the simulator for fuzz testing
+	// transactions
+	// NOTE: the IBC, EVM and fee market modules are not simulated and use their
+	// default genesis state
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(appCodec, app.AccountKeeper, RandomGenesisAccounts),
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
+		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		params.NewAppModule(app.ParamsKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		// Evmos app modules
+		inflation.NewAppModule(app.InflationKeeper, app.AccountKeeper, app.StakingKeeper,
+			app.GetSubspace(inflationtypes.ModuleName)),
+		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper, app.BankKeeper,
+			app.GetSubspace(erc20types.ModuleName)),
+		incentives.NewAppModule(app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper,
+			app.GetSubspace(incentivestypes.ModuleName)),
+		epochs.NewAppModule(appCodec, app.EpochsKeeper),
+		claims.NewAppModule(appCodec, *app.ClaimsKeeper, app.AccountKeeper, app.BankKeeper,
+			app.GetSubspace(claimstypes.ModuleName)),
+		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		revenue.NewAppModule(app.RevenueKeeper, app.AccountKeeper, app.BankKeeper,
+			app.GetSubspace(revenuetypes.ModuleName)),
+	)
+
+	app.sm.RegisterStoreDecoders()
+
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
 
@@ -823,8 +1038,9 @@ func NewEvmos(
 	app.SetBeginBlocker(app.BeginBlocker)
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
+	rateLimiter := ante.NewRateLimiter(evmosconfig.GetRateLimitConfig(appOpts))
 
-	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, rateLimiter)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
 	app.setupUpgradeHandlers()
@@ -852,7 +1068,7 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, rateLimiter *ante.RateLimiter) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -863,9 +1079,13 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		FeegrantKeeper:         app.FeeGrantKeeper,
 		IBCKeeper:              app.IBCKeeper,
 		FeeMarketKeeper:        app.FeeMarketKeeper,
+		MsgFilterKeeper:        app.MsgFilterKeeper,
+		FeeAbsKeeper:           app.FeeAbsKeeper,
+		PaymasterKeeper:        app.PaymasterKeeper,
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
+		RateLimiter:            rateLimiter,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
 	}
 
@@ -877,12 +1097,10 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 }
 
 func (app *Evmos) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	// refund the unused gas of the sponsored transactions to the paymasters
+	postHandler := sdk.ChainAnteDecorators(
+		ante.NewEthPaymasterRefundDecorator(app.PaymasterKeeper),
 	)
-	if err != nil {
-		panic(err)
-	}
 
 	app.SetPostHandler(postHandler)
 }
@@ -898,7 +1116,11 @@ func (app *Evmos) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci
 
 // EndBlocker updates every end block
 func (app *Evmos) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	if app.telemetryEnabled {
+		app.setModuleAccountBalanceGauges(ctx)
+	}
+	return res
 }
 
 // The DeliverTx method is intentionally decomposed to calculate the transactions per second.
@@ -1060,6 +1282,11 @@ func (app *Evmos) RegisterNodeService(clientCtx client.Context) {
 
 // IBC Go TestingApp functions
 
+// SimulationManager implements the SimulationApp interface.
+func (app *Evmos) SimulationManager() *module.SimulationManager {
+	return app.sm
+}
+
 // GetBaseApp implements the TestingApp interface.
 func (app *Evmos) GetBaseApp() *baseapp.BaseApp {
 	return app.BaseApp
@@ -1129,6 +1356,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName).WithKeyTable(evmtypes.ParamKeyTable()) //nolint: staticcheck
 	paramsKeeper.Subspace(feemarkettypes.ModuleName).WithKeyTable(feemarkettypes.ParamKeyTable())
@@ -1206,6 +1434,15 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v12 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v12.UpgradeName,
+		v12.CreateUpgradeHandler(
+			app.mm, app.configurator,
+			app.ICAControllerKeeper,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1246,6 +1483,17 @@ func (app *Evmos) setupUpgradeHandlers() {
 		storeUpgrades = &storetypes.StoreUpgrades{
 			Added: []string{icahosttypes.SubModuleName, recoverytypes.StoreKey},
 		}
+	case v12.UpgradeName:
+		// initialize msgfilter, feeabs, paymaster, ratelimit, forward, ica
+		// controller, intertx and callbacks stores
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{
+				msgfiltertypes.StoreKey, feeabstypes.StoreKey, paymastertypes.StoreKey,
+				ratelimittypes.StoreKey, forwardtypes.StoreKey,
+				icacontrollertypes.StoreKey, intertxtypes.StoreKey,
+				callbackstypes.StoreKey,
+			},
+		}
 	}
 
 	if storeUpgrades != nil {
@@ -0,0 +1,50 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+)
+
+// FlagTelemetryEnabled is the app.toml key that enables the application
+// telemetry
+const FlagTelemetryEnabled = "telemetry.enabled"
+
+// MetricKeyModuleAccountBalance is the key of the module account balance
+// gauges, labeled with the module and the denomination. Module accounts are
+// owned by the auth module.
+var MetricKeyModuleAccountBalance = []string{authtypes.ModuleName, "module_account", evmostelemetry.KeyBalance}
+
+// setModuleAccountBalanceGauges sets the balance gauges of every module
+// account of the app
+func (app *Evmos) setModuleAccountBalanceGauges(ctx sdk.Context) {
+	names := make([]string, 0, len(maccPerms))
+	for name := range maccPerms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		balances := app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(name))
+		evmostelemetry.SetCoinGauges(MetricKeyModuleAccountBalance, balances, evmostelemetry.NewModuleLabel(name))
+	}
+}
@@ -31,6 +31,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -84,6 +85,18 @@ func init() {
 func Setup(
 	isCheckTx bool,
 	feemarketGenesis *feemarkettypes.GenesisState,
+) *Evmos {
+	return SetupWithGenesis(isCheckTx, feemarketGenesis, nil)
+}
+
+// SetupWithGenesis initializes a new Evmos like Setup, with a genesis state
+// extended by the buildGenesis function, e.g. the Build method of a
+// testutil.GenesisBuilder. The function is called once the genesis account
+// and validator are set.
+func SetupWithGenesis(
+	isCheckTx bool,
+	feemarketGenesis *feemarkettypes.GenesisState,
+	buildGenesis func(codec.JSONCodec, simapp.GenesisState) simapp.GenesisState,
 ) *Evmos {
 	privVal := mock.NewPV()
 	pubKey, _ := privVal.GetPubKey()
@@ -108,6 +121,9 @@ func Setup(
 		genesisState := NewDefaultGenesisState()
 
 		genesisState = GenesisStateWithValSet(app, genesisState, valSet, []authtypes.GenesisAccount{acc}, balance)
+		if buildGenesis != nil {
+			genesisState = buildGenesis(app.AppCodec(), genesisState)
+		}
 
 		// Verify feeMarket genesis
 		if feemarketGenesis != nil {
@@ -26,6 +26,11 @@ import (
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
+
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	incentivestypes "github.com/evmos/evmos/v11/x/incentives/types"
+	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+	revenuetypes "github.com/evmos/evmos/v11/x/revenue/types"
 )
 
 var (
@@ -40,8 +45,14 @@ var (
 	}
 )
 
+// ObservabilityViews returns the OpenCensus views of the node and the Evmos
+// modules
 func ObservabilityViews() (views []*view.View) {
 	views = append(views, viewTransactions)
+	views = append(views, erc20types.ObservabilityViews()...)
+	views = append(views, revenuetypes.ObservabilityViews()...)
+	views = append(views, incentivestypes.ObservabilityViews()...)
+	views = append(views, inflationtypes.ObservabilityViews()...)
 	return views
 }
 
@@ -16,39 +16,74 @@
 
 package config
 
-func EnableObservability() error {
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"contrib.go.opencensus.io/exporter/prometheus"
+	"github.com/tendermint/tendermint/libs/log"
+	"go.opencensus.io/stats/view"
+
+	evmosconfig "github.com/evmos/evmos/v11/server/config"
+)
+
+// observabilityOnce makes sure that the observability endpoint is served once
+// per process, as several commands create more than one app
+var observabilityOnce sync.Once
+
+// EnableObservability registers the given OpenCensus views and serves them on
+// the Prometheus endpoint of the observability config. It is a no-op if the
+// observability is disabled or was already enabled.
+func EnableObservability(cfg evmosconfig.ObservabilityConfig, logger log.Logger, views ...*view.View) (err error) {
+	if !cfg.Enable {
+		return nil
+	}
+
+	observabilityOnce.Do(func() {
+		err = serveObservability(cfg, logger, views)
+	})
+	return err
+}
+
+func serveObservability(cfg evmosconfig.ObservabilityConfig, logger log.Logger, views []*view.View) error {
+	pe, err := prometheus.NewExporter(prometheus.Options{
+		Namespace: cfg.Namespace,
+		OnError: func(err error) {
+			logger.Error("failed to export the OpenCensus metrics", "error", err.Error())
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cmd/config: failed to create the OpenCensus Prometheus exporter: %w", err)
+	}
+
+	if err := view.Register(views...); err != nil {
+		return fmt.Errorf("cmd/config: failed to register OpenCensus views: %w", err)
+	}
+
+	// listen before serving so that the node fails on start if the address is
+	// already in use
+	listener, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		return fmt.Errorf("cmd/config: failed to listen on the observability address %s: %w", cfg.Address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", pe)
+
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		logger.Info("serving the Prometheus observability exporter", "address", listener.Addr().String())
+		if err := srv.Serve(listener); err != nil {
+			logger.Error("failed to serve the Prometheus observability exporter", "error", err.Error())
+		}
+	}()
+
 	return nil
-	// if true {
-	// Temporarily disabling this until we can configure out port reuse
-	// fast enough or enabling observability through the config.
-	// Please see https://github.com/evmos/evmos/v9/issues/84
-	// return nil
-	// }
-
-	// pe, err := prometheus.NewExporter(prometheus.Options{
-	// 	Namespace: "evmosd",
-	// })
-	// if err != nil {
-	// 	return fmt.Errorf("cmd/config: failed to create the OpenCensus Prometheus exporter: %w", err)
-	// }
-
-	// views := app.ObservabilityViews()
-	// if err := view.Register(views...); err != nil {
-	// 	return fmt.Errorf("cmd/config: failed to register OpenCensus views: %w", err)
-	// }
-	// view.RegisterExporter(pe)
-
-	// mux := http.NewServeMux()
-	// mux.Handle("/metrics", pe)
-
-	// // TODO: Derive the Prometheus observability exporter from the Evmos config.
-	// addr := ":8877"
-	// go func() {
-	// 	println("Serving the Prometheus observability exporter at", addr)
-	// 	if err := http.ListenAndServe(addr, mux); err != nil {
-	// 		panic(err)
-	// 	}
-	// }()
-
-	// return nil
 }
@@ -0,0 +1,135 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+
+	"github.com/evmos/evmos/v11/app"
+)
+
+// ExportAnalyticsCmd returns the export-analytics cobra Command. It reads the
+// application state and the block store of a stopped node and writes one CSV
+// file per Evmos module.
+func ExportAnalyticsCmd(encodingConfig params.EncodingConfig, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-analytics",
+		Short: "Export the state of the Evmos modules to CSV files for analytics",
+		Long: `Export the state of the Evmos modules at a given height to one CSV file per
+module, e.g. erc20.csv for the token pairs and vesting.csv for the vesting
+accounts. Besides the stored fields, the files contain fields computed at the
+block time of the export height, such as the vested and unvested amounts of
+vesting accounts. The command reads the data directory of the node, which must
+not be running.`,
+		Example: "evmosd export-analytics --height 1000 --output-dir ./analytics",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			evmosApp := app.NewEvmos(
+				serverCtx.Logger, db, nil, height == -1, map[int64]bool{}, homeDir, 0, encodingConfig, serverCtx.Viper,
+			)
+			if height != -1 {
+				if err := evmosApp.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			height = evmosApp.LastBlockHeight()
+			if height == 0 {
+				return fmt.Errorf("no committed state in %s", config.DBDir())
+			}
+
+			// the block time isn't part of the application state
+			blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(config.DBBackend), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			meta := tmstore.NewBlockStore(blockStoreDB).LoadBlockMeta(height)
+			if meta == nil {
+				return fmt.Errorf("block %d not found in the block store", height)
+			}
+
+			ctx := evmosApp.NewContext(true, tmproto.Header{
+				ChainID: meta.Header.ChainID,
+				Height:  height,
+				Time:    meta.Header.Time,
+			})
+
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return err
+			}
+
+			tables := evmosApp.ExportAnalytics(ctx)
+			for _, table := range tables {
+				if err := writeAnalyticsTable(filepath.Join(outputDir, table.Module+".csv"), table); err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("Exported %d modules at height %d to %s\n", len(tables), height, outputDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(flagOutputDir, "analytics", "The directory of the exported CSV files")
+
+	return cmd
+}
+
+// writeAnalyticsTable writes an analytics table to a CSV file
+func writeAnalyticsTable(path string, table app.AnalyticsTable) (err error) {
+	file, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	return table.WriteCSV(file)
+}
@@ -56,12 +56,12 @@ import (
 	"github.com/evmos/ethermint/encoding"
 	"github.com/evmos/ethermint/ethereum/eip712"
 	ethermintserver "github.com/evmos/ethermint/server"
-	servercfg "github.com/evmos/ethermint/server/config"
 	srvflags "github.com/evmos/ethermint/server/flags"
 
 	"github.com/evmos/evmos/v11/app"
 	cmdcfg "github.com/evmos/evmos/v11/cmd/config"
 	evmoskr "github.com/evmos/evmos/v11/crypto/keyring"
+	evmosconfig "github.com/evmos/evmos/v11/server/config"
 )
 
 const (
@@ -143,6 +143,7 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 		a.appExport,
 		addModuleInitFlags,
 	)
+	rootCmd.AddCommand(ExportAnalyticsCmd(encodingConfig, app.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -220,9 +221,9 @@ func txCommand() *cobra.Command {
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
-	customAppTemplate, customAppConfig := servercfg.AppConfig(cmdcfg.BaseDenom)
+	customAppTemplate, customAppConfig := evmosconfig.AppConfig(cmdcfg.BaseDenom)
 
-	srvCfg, ok := customAppConfig.(servercfg.Config)
+	srvCfg, ok := customAppConfig.(evmosconfig.Config)
 	if !ok {
 		panic(fmt.Errorf("unknown app config type %T", customAppConfig))
 	}
@@ -267,6 +268,11 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
+	observabilityCfg := evmosconfig.GetObservabilityConfig(appOpts)
+	if err := cmdcfg.EnableObservability(observabilityCfg, logger, app.ObservabilityViews()...); err != nil {
+		panic(err)
+	}
+
 	snapshotOptions := snapshottypes.NewSnapshotOptions(
 		cast.ToUint64(appOpts.Get(sdkserver.FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(sdkserver.FlagStateSyncSnapshotKeepRecent)),
@@ -0,0 +1,191 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package eip712
+
+import (
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/ethermint/ethereum/eip712"
+)
+
+// FeeDelegationOptions is an alias of the Ethermint fee delegation options
+// used when wrapping a transaction into EIP-712 typed data.
+type FeeDelegationOptions = eip712.FeeDelegationOptions
+
+// WrapTxToTypedData wraps the Amino JSON sign bytes of a Cosmos transaction
+// into an EIP-712 TypedData request.
+//
+// The message types are derived by Ethermint from the non-empty fields of the
+// Go message, which doesn't always match its Amino JSON encoding: empty values
+// are encoded (e.g. "vesting_periods":null), omitted fields of repeated
+// structs are missing and byte slices are encoded as base64 strings. The
+// typed data message is normalized against the generated types so that it
+// can be encoded for every Evmos message.
+func WrapTxToTypedData(
+	cdc codectypes.AnyUnpacker,
+	chainID uint64,
+	msg sdk.Msg,
+	data []byte,
+	feeDelegation *FeeDelegationOptions,
+) (apitypes.TypedData, error) {
+	typedData, err := eip712.WrapTxToTypedData(cdc, chainID, msg, data, feeDelegation)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	normalizeFields(typedData.Types, typedData.PrimaryType, typedData.Message)
+	return typedData, nil
+}
+
+// normalizeFields recursively normalizes the given object against its type
+// definition:
+//   - base64 encoded bytes declared as uint8 arrays are declared as strings
+//   - declared fields that are missing are set to their zero value
+//   - empty values that are not declared are removed
+func normalizeFields(types apitypes.Types, typeName string, object map[string]interface{}) {
+	declared := make(map[string]bool, len(types[typeName]))
+
+	for i, field := range types[typeName] {
+		declared[field.Name] = true
+
+		value, ok := object[field.Name]
+		if !ok {
+			object[field.Name] = zeroValue(types, field.Type)
+			continue
+		}
+
+		if field.Type == "uint8[]" {
+			switch value := value.(type) {
+			case string:
+				types[typeName][i].Type = "string"
+			case []interface{}:
+				if len(value) > 0 {
+					if _, ok := value[0].(string); ok {
+						types[typeName][i].Type = "string[]"
+					}
+				}
+			}
+			continue
+		}
+
+		fieldType := strings.TrimSuffix(field.Type, "[]")
+		if isPrimitiveType(fieldType) {
+			continue
+		}
+
+		// structs without non-empty fields are referenced without a definition
+		if _, ok := types[fieldType]; !ok {
+			types[fieldType] = []apitypes.Type{}
+		}
+
+		switch value := value.(type) {
+		case map[string]interface{}:
+			normalizeFields(types, fieldType, value)
+		case []interface{}:
+			for _, elem := range value {
+				if elem, ok := elem.(map[string]interface{}); ok {
+					normalizeFields(types, fieldType, elem)
+				}
+			}
+		}
+	}
+
+	for key, value := range object {
+		if !declared[key] && isEmptyValue(value) {
+			delete(object, key)
+		}
+	}
+}
+
+// zeroValue returns the typed data zero value of the given type.
+func zeroValue(types apitypes.Types, typ string) interface{} {
+	if strings.HasSuffix(typ, "[]") {
+		return []interface{}{}
+	}
+
+	switch {
+	case typ == "string":
+		return ""
+	case typ == "bool":
+		return false
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"):
+		return "0"
+	case isPrimitiveType(typ):
+		return nil
+	}
+
+	object := make(map[string]interface{}, len(types[typ]))
+	for _, field := range types[typ] {
+		object[field.Name] = zeroValue(types, field.Type)
+	}
+	return object
+}
+
+// isPrimitiveType returns true if the type is a Solidity elementary type.
+func isPrimitiveType(typ string) bool {
+	switch {
+	case typ == "string", typ == "bool", typ == "address":
+		return true
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "bytes"):
+		return true
+	default:
+		return false
+	}
+}
+
+// Amino JSON encodings of the zero time.Time and sdk.Dec values.
+const (
+	zeroTime = "0001-01-01T00:00:00Z"
+	zeroDec  = "0.000000000000000000"
+)
+
+// isEmptyValue returns true if the JSON decoded value is null or the
+// canonical Amino JSON encoding of a zero value. Other encodings of zero (e.g.
+// "000" or "0.0") are not empty, as they can't come from the Go message.
+// Objects and arrays are empty if all of their values are empty.
+func isEmptyValue(value interface{}) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case float64:
+		return value == 0
+	case string:
+		// integers, decimals, durations and timestamps are encoded as strings
+		return value == "" || value == "0" || value == zeroDec || value == zeroTime
+	case []interface{}:
+		for _, elem := range value {
+			if !isEmptyValue(elem) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		for _, elem := range value {
+			if !isEmptyValue(elem) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
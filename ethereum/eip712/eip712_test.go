@@ -0,0 +1,125 @@
+package eip712_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/ethermint/encoding"
+	ethermint "github.com/evmos/ethermint/ethereum/eip712"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/app"
+	"github.com/evmos/evmos/v11/ethereum/eip712"
+	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+func TestWrapTxToTypedData(t *testing.T) {
+	cdc := encoding.MakeConfig(app.ModuleBasics).Codec
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{
+			"empty lockup periods",
+			&vestingtypes.MsgCreateClawbackVestingAccount{
+				FromAddress: addr.String(),
+				ToAddress:   sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+				StartTime:   time.Unix(1672531200, 0).UTC(),
+				VestingPeriods: sdkvesting.Periods{
+					{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))},
+				},
+			},
+		},
+		{
+			"period with an omitted field",
+			&vestingtypes.MsgCreateClawbackVestingAccount{
+				FromAddress: addr.String(),
+				ToAddress:   sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+				StartTime:   time.Unix(1672531200, 0).UTC(),
+				LockupPeriods: sdkvesting.Periods{
+					{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))},
+					{Length: 100},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := legacytx.StdSignBytes(
+				"evmos_9001-1", 1, 0, 0,
+				legacytx.StdFee{Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 20)), Gas: 200000},
+				[]sdk.Msg{tc.msg}, "", nil,
+			)
+			feeDelegation := &eip712.FeeDelegationOptions{FeePayer: addr}
+
+			// the Ethermint typed data doesn't match the Amino JSON message
+			typedData, err := ethermint.WrapTxToTypedData(cdc, 9001, tc.msg, data, feeDelegation)
+			require.NoError(t, err)
+			_, _, err = apitypes.TypedDataAndHash(typedData)
+			require.Error(t, err)
+
+			typedData, err = eip712.WrapTxToTypedData(cdc, 9001, tc.msg, data, feeDelegation)
+			require.NoError(t, err)
+			_, _, err = apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWrapTxToTypedDataUndeclaredValues(t *testing.T) {
+	cdc := encoding.MakeConfig(app.ModuleBasics).Codec
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1)))
+
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expError bool
+	}{
+		{"null removed", nil, false},
+		{"empty string removed", "", false},
+		{"zero integer removed", "0", false},
+		{"zero decimal removed", "0.000000000000000000", false},
+		{"empty object removed", map[string]interface{}{"amount": "0", "denom": ""}, false},
+		{"padded zero kept", "000", true},
+		{"short zero decimal kept", "0.0", true},
+		{"non-zero value kept", "1", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signBytes := legacytx.StdSignBytes(
+				"evmos_9001-1", 1, 0, 0,
+				legacytx.StdFee{Amount: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 20)), Gas: 200000},
+				[]sdk.Msg{msg}, "", nil,
+			)
+
+			// add an undeclared field to the message
+			var doc map[string]interface{}
+			require.NoError(t, json.Unmarshal(signBytes, &doc))
+			value := doc["msgs"].([]interface{})[0].(map[string]interface{})["value"].(map[string]interface{})
+			value["undeclared"] = tc.value
+			data, err := json.Marshal(doc)
+			require.NoError(t, err)
+
+			typedData, err := eip712.WrapTxToTypedData(cdc, 9001, msg, data, &eip712.FeeDelegationOptions{FeePayer: addr})
+			require.NoError(t, err)
+			_, _, err = apitypes.TypedDataAndHash(typedData)
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package config
+
+import (
+	"errors"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	servercfg "github.com/evmos/ethermint/server/config"
+)
+
+const (
+	// DefaultRateLimitEnable is the default value for the rate limit toggle
+	DefaultRateLimitEnable = false
+	// DefaultMaxTxsPerSender is the default maximum number of txs a sender can
+	// submit to the mempool per block
+	DefaultMaxTxsPerSender = 100
+	// DefaultMaxGasPerSender is the default maximum gas a sender can submit to
+	// the mempool per block
+	DefaultMaxGasPerSender = 100_000_000
+
+	// DefaultObservabilityEnable is the default value for the observability
+	// endpoint toggle
+	DefaultObservabilityEnable = false
+	// DefaultObservabilityAddress is the default address of the observability
+	// endpoint
+	DefaultObservabilityAddress = "0.0.0.0:8877"
+	// DefaultObservabilityNamespace is the default namespace of the
+	// observability metrics
+	DefaultObservabilityNamespace = "evmosd"
+)
+
+// app.toml keys of the rate limit configuration
+const (
+	RateLimitEnable            = "rate-limit.enable"
+	RateLimitMaxTxsPerSender   = "rate-limit.max-txs-per-sender"
+	RateLimitMaxGasPerSender   = "rate-limit.max-gas-per-sender"
+	RateLimitMaxTxsPerContract = "rate-limit.max-txs-per-contract"
+	RateLimitMaxGasPerContract = "rate-limit.max-gas-per-contract"
+
+	ObservabilityEnable    = "observability.enable"
+	ObservabilityAddress   = "observability.address"
+	ObservabilityNamespace = "observability.namespace"
+)
+
+// Config defines the server's top level configuration. It includes the
+// Ethermint configuration and the Evmos specific sections.
+type Config struct {
+	servercfg.Config `mapstructure:",squash"`
+
+	RateLimit     RateLimitConfig     `mapstructure:"rate-limit"`
+	Observability ObservabilityConfig `mapstructure:"observability"`
+}
+
+// RateLimitConfig defines the limits on the transactions a node admits to its
+// mempool on CheckTx. A limit of 0 disables the corresponding check.
+type RateLimitConfig struct {
+	// Enable defines if the rate limits are enforced
+	Enable bool `mapstructure:"enable"`
+	// MaxTxsPerSender is the maximum number of txs per sender and block
+	MaxTxsPerSender uint64 `mapstructure:"max-txs-per-sender"`
+	// MaxGasPerSender is the maximum gas limit per sender and block
+	MaxGasPerSender uint64 `mapstructure:"max-gas-per-sender"`
+	// MaxTxsPerContract is the maximum number of EVM txs calling a contract per block
+	MaxTxsPerContract uint64 `mapstructure:"max-txs-per-contract"`
+	// MaxGasPerContract is the maximum gas limit of the EVM txs calling a
+	// contract per block
+	MaxGasPerContract uint64 `mapstructure:"max-gas-per-contract"`
+}
+
+type ObservabilityConfig struct {
+	// Enable defines if the OpenCensus metrics are served
+	Enable bool `mapstructure:"enable"`
+	// Address is the address the Prometheus metrics endpoint listens on
+	Address string `mapstructure:"address"`
+	// Namespace is the prefix of the metric names
+	Namespace string `mapstructure:"namespace"`
+}
+
+// AppConfig returns the Evmos app.toml template and default configuration,
+// extending the Ethermint ones.
+func AppConfig(denom string) (string, interface{}) {
+	ethermintTemplate, ethermintConfig := servercfg.AppConfig(denom)
+
+	srvCfg, ok := ethermintConfig.(servercfg.Config)
+	if !ok {
+		panic(errors.New("unknown ethermint app config type"))
+	}
+
+	customAppConfig := Config{
+		Config:        srvCfg,
+		RateLimit:     *DefaultRateLimitConfig(),
+		Observability: *DefaultObservabilityConfig(),
+	}
+
+	customAppTemplate := ethermintTemplate + DefaultConfigTemplate
+
+	return customAppTemplate, customAppConfig
+}
+
+// DefaultConfig returns server's default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		Config:        *servercfg.DefaultConfig(),
+		RateLimit:     *DefaultRateLimitConfig(),
+		Observability: *DefaultObservabilityConfig(),
+	}
+}
+
+// DefaultRateLimitConfig returns the default rate limit configuration, which
+// is disabled and only limits senders when enabled.
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enable:          DefaultRateLimitEnable,
+		MaxTxsPerSender: DefaultMaxTxsPerSender,
+		MaxGasPerSender: DefaultMaxGasPerSender,
+	}
+}
+
+// GetRateLimitConfig returns the rate limit configuration from the app
+// options, i.e. app.toml.
+func DefaultObservabilityConfig() *ObservabilityConfig {
+	return &ObservabilityConfig{
+		Enable:    DefaultObservabilityEnable,
+		Address:   DefaultObservabilityAddress,
+		Namespace: DefaultObservabilityNamespace,
+	}
+}
+
+func GetRateLimitConfig(appOpts servertypes.AppOptions) RateLimitConfig {
+	return RateLimitConfig{
+		Enable:            cast.ToBool(appOpts.Get(RateLimitEnable)),
+		MaxTxsPerSender:   cast.ToUint64(appOpts.Get(RateLimitMaxTxsPerSender)),
+		MaxGasPerSender:   cast.ToUint64(appOpts.Get(RateLimitMaxGasPerSender)),
+		MaxTxsPerContract: cast.ToUint64(appOpts.Get(RateLimitMaxTxsPerContract)),
+		MaxGasPerContract: cast.ToUint64(appOpts.Get(RateLimitMaxGasPerContract)),
+	}
+}
+
+func GetObservabilityConfig(appOpts servertypes.AppOptions) ObservabilityConfig {
+	return ObservabilityConfig{
+		Enable:    cast.ToBool(appOpts.Get(ObservabilityEnable)),
+		Address:   cast.ToString(appOpts.Get(ObservabilityAddress)),
+		Namespace: cast.ToString(appOpts.Get(ObservabilityNamespace)),
+	}
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppConfigTemplate(t *testing.T) {
+	customAppTemplate, customAppConfig := AppConfig("aevmos")
+
+	cfg, ok := customAppConfig.(Config)
+	require.True(t, ok)
+	cfg.RateLimit.Enable = true
+	cfg.RateLimit.MaxTxsPerContract = 10
+	cfg.Observability.Enable = true
+	cfg.Observability.Address = "127.0.0.1:9999"
+
+	tmpl, err := template.New("appConfigFileTemplate").Parse(customAppTemplate)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buffer, cfg))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buffer))
+
+	require.Equal(t, "0aevmos", v.GetString("minimum-gas-prices"))
+	require.Equal(t, cfg.RateLimit, GetRateLimitConfig(v))
+	require.Equal(t, cfg.Observability, GetObservabilityConfig(v))
+}
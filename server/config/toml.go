@@ -0,0 +1,64 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package config
+
+// DefaultConfigTemplate defines the configuration template of the Evmos
+// specific app.toml sections
+const DefaultConfigTemplate = `
+###############################################################################
+###                          Rate Limit Configuration                       ###
+###############################################################################
+
+[rate-limit]
+
+# Enable defines if the node limits the transactions it admits to its mempool
+# on CheckTx. The limits apply per block and don't affect block execution.
+enable = {{ .RateLimit.Enable }}
+
+# MaxTxsPerSender defines the maximum number of transactions of a sender
+# admitted per block. 0 means unlimited.
+max-txs-per-sender = {{ .RateLimit.MaxTxsPerSender }}
+
+# MaxGasPerSender defines the maximum total gas limit of the transactions of a
+# sender admitted per block. 0 means unlimited.
+max-gas-per-sender = {{ .RateLimit.MaxGasPerSender }}
+
+# MaxTxsPerContract defines the maximum number of EVM transactions calling a
+# contract admitted per block. 0 means unlimited.
+max-txs-per-contract = {{ .RateLimit.MaxTxsPerContract }}
+
+# MaxGasPerContract defines the maximum total gas limit of the EVM transactions
+# calling a contract admitted per block. 0 means unlimited.
+max-gas-per-contract = {{ .RateLimit.MaxGasPerContract }}
+
+###############################################################################
+###                        Observability Configuration                      ###
+###############################################################################
+
+[observability]
+
+# Enable defines if the node serves its OpenCensus metrics, e.g. the processed
+# transactions and the ERC20 conversions, on a Prometheus endpoint.
+enable = {{ .Observability.Enable }}
+
+# Address defines the address the Prometheus endpoint listens on. The metrics
+# are served on the /metrics path.
+address = "{{ .Observability.Address }}"
+
+# Namespace defines the prefix of the metric names.
+namespace = "{{ .Observability.Namespace }}"
+`
@@ -0,0 +1,174 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+// Package telemetry defines the metric conventions of the Evmos modules.
+//
+// Every metric key starts with the name of the module that emits it, followed
+// by snake_case parts. Counters end with "total", the counters of token
+// amounts with "amount", "total" and gauges with a noun, e.g. "balance".
+// Metrics are labeled with the denomination and channel they refer to. The
+// contract label is only used by low-volume metrics, e.g. of governance
+// proposals, or by metrics of governance-bounded contract sets, as a label
+// with unbounded values creates a time series per value.
+package telemetry
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Label names of the Evmos metrics
+const (
+	LabelDenom    = "denom"
+	LabelContract = "contract"
+	LabelChannel  = "channel"
+	LabelModule   = "module"
+	LabelStatus   = "status"
+	LabelAction   = "action"
+)
+
+// Metric key parts shared by the Evmos modules
+const (
+	KeyTotal   = "total"
+	KeyAmount  = "amount"
+	KeyBalance = "balance"
+)
+
+var keyPartRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// NewDenomLabel returns the label of a denomination
+func NewDenomLabel(denom string) metrics.Label {
+	return telemetry.NewLabel(LabelDenom, denom)
+}
+
+// NewContractLabel returns the label of a contract address. It must only be
+// used for governance-bounded contract sets or low-volume operations.
+func NewContractLabel(contract string) metrics.Label {
+	return telemetry.NewLabel(LabelContract, contract)
+}
+
+// NewChannelLabel returns the label of an IBC channel identifier
+func NewChannelLabel(channel string) metrics.Label {
+	return telemetry.NewLabel(LabelChannel, channel)
+}
+
+// NewModuleLabel returns the label of a module name
+func NewModuleLabel(module string) metrics.Label {
+	return telemetry.NewLabel(LabelModule, module)
+}
+
+// NewStatusLabel returns the label of a status, e.g. of an IBC packet
+func NewStatusLabel(status string) metrics.Label {
+	return telemetry.NewLabel(LabelStatus, status)
+}
+
+// NewActionLabel returns the label of an action, e.g. of a claimed action
+func NewActionLabel(action string) metrics.Label {
+	return telemetry.NewLabel(LabelAction, action)
+}
+
+// IncrCounter increments the counter of the given key by one
+func IncrCounter(key []string, labels ...metrics.Label) {
+	telemetry.IncrCounterWithLabels(key, 1, copyLabels(labels))
+}
+
+// IncrAmount adds the given amount to the counter of the given key. See
+// AmountToFloat32 for the conversion of the amount.
+func IncrAmount(key []string, amount sdkmath.Int, labels ...metrics.Label) {
+	telemetry.IncrCounterWithLabels(key, AmountToFloat32(amount), copyLabels(labels))
+}
+
+// IncrCoins adds the amount of each coin to the counter of the given key,
+// labeled with the coin denomination
+func IncrCoins(key []string, coins sdk.Coins, labels ...metrics.Label) {
+	for _, coin := range coins {
+		IncrAmount(key, coin.Amount, append(copyLabels(labels), NewDenomLabel(coin.Denom))...)
+	}
+}
+
+// SetGauge sets the gauge of the given key to the given value
+func SetGauge(key []string, value float32, labels ...metrics.Label) {
+	telemetry.SetGaugeWithLabels(key, value, copyLabels(labels))
+}
+
+// SetCoinGauges sets the gauge of the given key to the amount of each coin,
+// labeled with the coin denomination
+func SetCoinGauges(key []string, coins sdk.Coins, labels ...metrics.Label) {
+	for _, coin := range coins {
+		telemetry.SetGaugeWithLabels(
+			key,
+			AmountToFloat32(coin.Amount),
+			append(copyLabels(labels), NewDenomLabel(coin.Denom)),
+		)
+	}
+}
+
+// AmountToFloat32 converts an amount to a float32 metric value. Unlike int64
+// values, floats keep the scale of amounts with 18 decimals, e.g. 1e24aevmos,
+// at the cost of precision. Amounts beyond the float32 range are clamped to
+// its maximum.
+func AmountToFloat32(amount sdkmath.Int) float32 {
+	if amount.IsNil() {
+		return 0
+	}
+
+	f, _ := new(big.Float).SetInt(amount.BigInt()).Float32()
+	switch {
+	case math.IsInf(float64(f), 1):
+		return math.MaxFloat32
+	case math.IsInf(float64(f), -1):
+		return -math.MaxFloat32
+	default:
+		return f
+	}
+}
+
+// ValidateMetricKey checks that a metric key of the given module follows the
+// Evmos metric conventions
+func ValidateMetricKey(module string, key []string) error {
+	if len(key) < 2 {
+		return fmt.Errorf("metric key %v must have a module name and a metric name", key)
+	}
+
+	if key[0] != module {
+		return fmt.Errorf("metric key %v must start with the module name %s", key, module)
+	}
+
+	for _, part := range key {
+		if !keyPartRegex.MatchString(part) {
+			return fmt.Errorf("metric key %v has an invalid part %q", key, part)
+		}
+	}
+
+	if key[len(key)-2] == KeyAmount && key[len(key)-1] != KeyTotal {
+		return fmt.Errorf("amount metric key %v must end with %s", key, KeyTotal)
+	}
+
+	return nil
+}
+
+// copyLabels copies the given labels so that appending to them doesn't
+// modify the slice of the caller
+func copyLabels(labels []metrics.Label) []metrics.Label {
+	return append(make([]metrics.Label, 0, len(labels)+1), labels...)
+}
@@ -0,0 +1,119 @@
+package telemetry_test
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/app"
+	"github.com/evmos/evmos/v11/telemetry"
+	callbackstypes "github.com/evmos/evmos/v11/x/callbacks/types"
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
+	epochstypes "github.com/evmos/evmos/v11/x/epochs/types"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	feeabstypes "github.com/evmos/evmos/v11/x/feeabs/types"
+	forwardtypes "github.com/evmos/evmos/v11/x/forward/types"
+	transfermetrics "github.com/evmos/evmos/v11/x/ibc/transfer/types"
+	incentivestypes "github.com/evmos/evmos/v11/x/incentives/types"
+	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+	intertxtypes "github.com/evmos/evmos/v11/x/intertx/types"
+	msgfiltertypes "github.com/evmos/evmos/v11/x/msgfilter/types"
+	paymastertypes "github.com/evmos/evmos/v11/x/paymaster/types"
+	ratelimittypes "github.com/evmos/evmos/v11/x/ratelimit/types"
+	recoverytypes "github.com/evmos/evmos/v11/x/recovery/types"
+	revenuetypes "github.com/evmos/evmos/v11/x/revenue/types"
+	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+func TestModuleMetricKeys(t *testing.T) {
+	testCases := []struct {
+		module string
+		keys   [][]string
+	}{
+		{callbackstypes.ModuleName, callbackstypes.MetricKeys()},
+		{claimstypes.ModuleName, claimstypes.MetricKeys()},
+		{epochstypes.ModuleName, epochstypes.MetricKeys()},
+		{erc20types.ModuleName, erc20types.MetricKeys()},
+		{feeabstypes.ModuleName, feeabstypes.MetricKeys()},
+		{forwardtypes.ModuleName, forwardtypes.MetricKeys()},
+		{transfertypes.ModuleName, transfermetrics.MetricKeys()},
+		{incentivestypes.ModuleName, incentivestypes.MetricKeys()},
+		{inflationtypes.ModuleName, inflationtypes.MetricKeys()},
+		{intertxtypes.ModuleName, intertxtypes.MetricKeys()},
+		{msgfiltertypes.ModuleName, msgfiltertypes.MetricKeys()},
+		{paymastertypes.ModuleName, paymastertypes.MetricKeys()},
+		{ratelimittypes.ModuleName, ratelimittypes.MetricKeys()},
+		{recoverytypes.ModuleName, recoverytypes.MetricKeys()},
+		{revenuetypes.ModuleName, revenuetypes.MetricKeys()},
+		{vestingtypes.ModuleName, vestingtypes.MetricKeys()},
+		{"auth", [][]string{app.MetricKeyModuleAccountBalance}},
+	}
+
+	seen := make(map[string]bool)
+	for _, tc := range testCases {
+		require.NotEmpty(t, tc.keys, tc.module)
+
+		for _, key := range tc.keys {
+			require.NoError(t, telemetry.ValidateMetricKey(tc.module, key))
+
+			name := strings.Join(key, "_")
+			require.False(t, seen[name], "duplicate metric %s", name)
+			seen[name] = true
+		}
+	}
+}
+
+func TestValidateMetricKey(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     []string
+		expPass bool
+	}{
+		{"valid counter", []string{"erc20", "convert_coin", "total"}, true},
+		{"valid amount", []string{"erc20", "convert_coin", "amount", "total"}, true},
+		{"valid gauge", []string{"erc20", "balance"}, true},
+		{"no metric name", []string{"erc20"}, false},
+		{"other module", []string{"revenue", "distribute", "total"}, false},
+		{"uppercase part", []string{"erc20", "ConvertCoin", "total"}, false},
+		{"empty part", []string{"erc20", "", "total"}, false},
+		{"amount without total", []string{"erc20", "convert_coin", "amount", "sum"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := telemetry.ValidateMetricKey("erc20", tc.key)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAmountToFloat32(t *testing.T) {
+	large, ok := sdkmath.NewIntFromString("1000000000000000000000000")
+	require.True(t, ok)
+
+	huge := sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200))
+
+	testCases := []struct {
+		name   string
+		amount sdkmath.Int
+		exp    float32
+	}{
+		{"nil", sdkmath.Int{}, 0},
+		{"zero", sdkmath.ZeroInt(), 0},
+		{"int64", sdkmath.NewInt(100), 100},
+		{"beyond int64", large, 1e24},
+		{"beyond float32", huge, math.MaxFloat32},
+		{"negative beyond float32", huge.Neg(), -math.MaxFloat32},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, telemetry.AmountToFloat32(tc.amount), tc.name)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+// Package erc20 provides the genesis state of ERC20 contracts, so that the
+// tests and simulations can set up token pairs on genesis.
+package erc20
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/evmos/evmos/v11/contracts"
+	"github.com/evmos/evmos/v11/x/erc20/types"
+)
+
+// GenesisContract returns the genesis state of the ERC20 contract of a Cosmos
+// coin, as deployed by the erc20 keeper when the erc20 module account has the
+// given nonce. It returns the account of the contract for the auth genesis
+// state and its code and storage for the EVM genesis state.
+//
+// The storage is computed by running the contract constructor on an in-memory
+// EVM state. The sequence of the erc20 module account must be set above the
+// nonce on genesis, so that the following deployments don't reuse the
+// contract address.
+func GenesisContract(
+	coinMetadata banktypes.Metadata,
+	nonce uint64,
+) (*ethermint.EthAccount, evmtypes.GenesisAccount, error) {
+	data, err := contractData(coinMetadata)
+	if err != nil {
+		return nil, evmtypes.GenesisAccount{}, err
+	}
+
+	// the preimages are required to iterate over the storage keys
+	db := state.NewDatabaseWithConfig(rawdb.NewMemoryDatabase(), &trie.Config{Preimages: true})
+	stateDB, err := state.New(common.Hash{}, db, nil)
+	if err != nil {
+		return nil, evmtypes.GenesisAccount{}, err
+	}
+	stateDB.SetNonce(types.ModuleAddress, nonce)
+
+	code, contract, _, err := runtime.Create(data, &runtime.Config{
+		Origin: types.ModuleAddress,
+		State:  stateDB,
+	})
+	if err != nil {
+		return nil, evmtypes.GenesisAccount{}, errorsmod.Wrapf(err, "failed to deploy contract for %s", coinMetadata.Name)
+	}
+
+	if _, err := stateDB.Commit(true); err != nil {
+		return nil, evmtypes.GenesisAccount{}, err
+	}
+
+	genesisAccount := evmtypes.GenesisAccount{
+		Address: contract.Hex(),
+		Code:    common.Bytes2Hex(code),
+	}
+
+	err = stateDB.ForEachStorage(contract, func(key, value common.Hash) bool {
+		genesisAccount.Storage = append(genesisAccount.Storage, evmtypes.NewState(key, value))
+		return true
+	})
+	if err != nil {
+		return nil, evmtypes.GenesisAccount{}, err
+	}
+
+	account := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(contract.Bytes(), nil, 0, stateDB.GetNonce(contract)),
+		CodeHash:    crypto.Keccak256Hash(code).Hex(),
+	}
+
+	return account, genesisAccount, nil
+}
+
+// contractData returns the creation bytecode of the ERC20 contract of a Cosmos
+// coin, followed by its constructor arguments, as deployed by the erc20 keeper
+func contractData(coinMetadata banktypes.Metadata) ([]byte, error) {
+	decimals := uint8(0)
+	if len(coinMetadata.DenomUnits) > 0 {
+		decimals = uint8(coinMetadata.DenomUnits[len(coinMetadata.DenomUnits)-1].Exponent)
+	}
+
+	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
+		"",
+		coinMetadata.Name,
+		coinMetadata.Symbol,
+		decimals,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
+	}
+
+	data := make([]byte, 0, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
+	data = append(data, contracts.ERC20MinterBurnerDecimalsContract.Bin...)
+	return append(data, ctorArgs...), nil
+}
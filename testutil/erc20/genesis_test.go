@@ -0,0 +1,60 @@
+package erc20_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/evmos/v11/app"
+	erc20testutil "github.com/evmos/evmos/v11/testutil/erc20"
+	evmostypes "github.com/evmos/evmos/v11/types"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+)
+
+func TestGenesisContract(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Description: "Coin",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: "acoin"}, {Denom: "coin", Exponent: 18}},
+		Base:        "acoin",
+		Display:     "coin",
+		Name:        "Coin",
+		Symbol:      "COIN",
+	}
+
+	evmosApp := app.Setup(false, nil)
+	ctx := evmosApp.BaseApp.NewContext(false, tmproto.Header{ChainID: evmostypes.MainnetChainID + "-1"})
+
+	// the EVM calls require the block proposer
+	validators := evmosApp.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+
+	nonce, err := evmosApp.AccountKeeper.GetSequence(ctx, erc20types.ModuleAddress.Bytes())
+	require.NoError(t, err)
+
+	account, genesisAccount, err := erc20testutil.GenesisContract(metadata, nonce)
+	require.NoError(t, err)
+
+	contract, err := evmosApp.Erc20Keeper.DeployERC20Contract(ctx, metadata)
+	require.NoError(t, err)
+
+	// the genesis state matches the state of the deployed contract
+	require.Equal(t, contract.Hex(), genesisAccount.Address)
+	require.Equal(t, contract, common.BytesToAddress(account.GetAddress()))
+	require.Equal(t, common.Bytes2Hex(evmosApp.EvmKeeper.GetCode(ctx, common.HexToHash(account.CodeHash))), genesisAccount.Code)
+
+	var storage evmtypes.Storage
+	evmosApp.EvmKeeper.ForEachStorage(ctx, contract, func(key, value common.Hash) bool {
+		storage = append(storage, evmtypes.NewState(key, value))
+		return true
+	})
+	require.NotEmpty(t, storage)
+	require.ElementsMatch(t, storage, genesisAccount.Storage)
+}
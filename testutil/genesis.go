@@ -0,0 +1,268 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package testutil
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/gogo/protobuf/proto"
+
+	erc20testutil "github.com/evmos/evmos/v11/testutil/erc20"
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	incentivestypes "github.com/evmos/evmos/v11/x/incentives/types"
+	revenuetypes "github.com/evmos/evmos/v11/x/revenue/types"
+	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+// GenesisBuilder builds the genesis state of the Evmos modules for tests. The
+// state added with its With methods is merged into an existing genesis state
+// by Build, so that a builder can be used both with the network.Config of an
+// in-process network and with app.SetupWithGenesis:
+//
+//	cfg := network.DefaultConfig()
+//	cfg.GenesisState = testutil.NewGenesisBuilder().
+//		WithRegisteredCoin(metadata).
+//		WithRevenue(revenue).
+//		Build(cfg.Codec, cfg.GenesisState)
+//
+//	evmosApp := app.SetupWithGenesis(false, nil, builder.Build)
+type GenesisBuilder struct {
+	accounts      []authtypes.GenesisAccount
+	balances      []banktypes.Balance
+	denomMetadata []banktypes.Metadata
+	coins         []banktypes.Metadata
+	tokenPairs    []erc20types.TokenPair
+	incentives    []incentivestypes.Incentive
+	revenues      []revenuetypes.Revenue
+	claimsRecords []claimstypes.ClaimsRecordAddress
+}
+
+// NewGenesisBuilder returns an empty genesis builder
+func NewGenesisBuilder() *GenesisBuilder {
+	return &GenesisBuilder{}
+}
+
+// WithAccount adds a genesis account with the given balance
+func (b *GenesisBuilder) WithAccount(account authtypes.GenesisAccount, coins sdk.Coins) *GenesisBuilder {
+	b.accounts = append(b.accounts, account)
+	return b.WithBalances(banktypes.Balance{Address: account.GetAddress().String(), Coins: coins})
+}
+
+// WithBalances adds the balances to the bank genesis state and its total
+// supply
+func (b *GenesisBuilder) WithBalances(balances ...banktypes.Balance) *GenesisBuilder {
+	for _, balance := range balances {
+		if !balance.Coins.IsZero() {
+			b.balances = append(b.balances, balance)
+		}
+	}
+	return b
+}
+
+// WithDenomMetadata adds the metadata of a denomination to the bank genesis
+// state, e.g. for the Cosmos coin of a token pair
+func (b *GenesisBuilder) WithDenomMetadata(metadata ...banktypes.Metadata) *GenesisBuilder {
+	b.denomMetadata = append(b.denomMetadata, metadata...)
+	return b
+}
+
+// WithRegisteredCoin registers the Cosmos coins of the given metadata as
+// enabled token pairs, like a RegisterCoinProposal: the metadata is added to
+// the bank genesis state and the ERC20 contracts are deployed on genesis by
+// the erc20 module account. The genesis state given to Build must not contain
+// the erc20 module account, as its sequence is set to the number of deployed
+// contracts.
+func (b *GenesisBuilder) WithRegisteredCoin(metadata ...banktypes.Metadata) *GenesisBuilder {
+	b.coins = append(b.coins, metadata...)
+	return b
+}
+
+// WithTokenPair adds a token pair to the erc20 genesis state. Only the pair is
+// added: the denomination metadata and the ERC20 contract must be set up
+// separately, e.g. with WithDenomMetadata, or the pair must be registered with
+// WithRegisteredCoin instead.
+func (b *GenesisBuilder) WithTokenPair(pairs ...erc20types.TokenPair) *GenesisBuilder {
+	b.tokenPairs = append(b.tokenPairs, pairs...)
+	return b
+}
+
+// WithIncentive adds an incentive to the incentives genesis state
+func (b *GenesisBuilder) WithIncentive(incentives ...incentivestypes.Incentive) *GenesisBuilder {
+	b.incentives = append(b.incentives, incentives...)
+	return b
+}
+
+// WithRevenue adds a registered revenue contract to the revenue genesis state
+func (b *GenesisBuilder) WithRevenue(revenues ...revenuetypes.Revenue) *GenesisBuilder {
+	b.revenues = append(b.revenues, revenues...)
+	return b
+}
+
+// WithClawbackVestingAccount adds a clawback vesting account, funded with its
+// original vesting coins
+func (b *GenesisBuilder) WithClawbackVestingAccount(va *vestingtypes.ClawbackVestingAccount) *GenesisBuilder {
+	return b.WithAccount(va, va.OriginalVesting)
+}
+
+// WithClaimsRecords adds claims records to the claims genesis state. The claims
+// module account is funded with the unclaimed amount of the records of the
+// Evmos campaign, as required by the claims genesis.
+func (b *GenesisBuilder) WithClaimsRecords(records ...claimstypes.ClaimsRecordAddress) *GenesisBuilder {
+	b.claimsRecords = append(b.claimsRecords, records...)
+	return b
+}
+
+// Build returns the genesis state with the state of the builder added to it.
+// The given genesis state is not modified.
+func (b *GenesisBuilder) Build(cdc codec.JSONCodec, genesisState simapp.GenesisState) simapp.GenesisState {
+	genesis := make(simapp.GenesisState, len(genesisState))
+	for module, state := range genesisState {
+		genesis[module] = state
+	}
+
+	accounts := b.accounts
+	denomMetadata := b.denomMetadata
+	tokenPairs := b.tokenPairs
+	var contracts []evmtypes.GenesisAccount
+	for i, metadata := range b.coins {
+		account, contract, err := erc20testutil.GenesisContract(metadata, uint64(i))
+		if err != nil {
+			panic(err)
+		}
+
+		accounts = append(accounts, account)
+		contracts = append(contracts, contract)
+		denomMetadata = append(denomMetadata, metadata)
+		tokenPairs = append(tokenPairs, erc20types.NewTokenPair(
+			common.BytesToAddress(account.GetAddress()), metadata.Base, true, erc20types.OWNER_MODULE,
+		))
+	}
+
+	if len(b.coins) > 0 {
+		moduleAccount := authtypes.NewEmptyModuleAccount(erc20types.ModuleName, authtypes.Minter, authtypes.Burner)
+		if err := moduleAccount.SetSequence(uint64(len(b.coins))); err != nil {
+			panic(err)
+		}
+		accounts = append(accounts, moduleAccount)
+	}
+
+	balances := b.balances
+	if len(b.claimsRecords) > 0 {
+		var claimsGenesis claimstypes.GenesisState
+		updateGenesis(cdc, genesis, claimstypes.ModuleName, &claimsGenesis, func() {
+			claimsGenesis.ClaimsRecords = append(claimsGenesis.ClaimsRecords, b.claimsRecords...)
+		})
+
+		unclaimed := unclaimedAmount(b.claimsRecords)
+		if unclaimed.IsPositive() {
+			balances = append(balances, banktypes.Balance{
+				Address: authtypes.NewModuleAddress(claimstypes.ModuleName).String(),
+				Coins:   sdk.Coins{sdk.NewCoin(claimsGenesis.Params.ClaimsDenom, unclaimed)},
+			})
+		}
+	}
+
+	if len(accounts) > 0 {
+		packed, err := authtypes.PackAccounts(accounts)
+		if err != nil {
+			panic(err)
+		}
+
+		var authGenesis authtypes.GenesisState
+		updateGenesis(cdc, genesis, authtypes.ModuleName, &authGenesis, func() {
+			authGenesis.Accounts = append(authGenesis.Accounts, packed...)
+		})
+	}
+
+	if len(balances) > 0 || len(denomMetadata) > 0 {
+		var bankGenesis banktypes.GenesisState
+		updateGenesis(cdc, genesis, banktypes.ModuleName, &bankGenesis, func() {
+			bankGenesis.Balances = append(bankGenesis.Balances, balances...)
+			bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, denomMetadata...)
+
+			// an empty supply is computed from the balances on genesis
+			if !bankGenesis.Supply.Empty() {
+				for _, balance := range balances {
+					bankGenesis.Supply = bankGenesis.Supply.Add(balance.Coins...)
+				}
+			}
+		})
+	}
+
+	if len(tokenPairs) > 0 {
+		var erc20Genesis erc20types.GenesisState
+		updateGenesis(cdc, genesis, erc20types.ModuleName, &erc20Genesis, func() {
+			erc20Genesis.TokenPairs = append(erc20Genesis.TokenPairs, tokenPairs...)
+		})
+	}
+
+	if len(contracts) > 0 {
+		var evmGenesis evmtypes.GenesisState
+		updateGenesis(cdc, genesis, evmtypes.ModuleName, &evmGenesis, func() {
+			evmGenesis.Accounts = append(evmGenesis.Accounts, contracts...)
+		})
+	}
+
+	if len(b.incentives) > 0 {
+		var incentivesGenesis incentivestypes.GenesisState
+		updateGenesis(cdc, genesis, incentivestypes.ModuleName, &incentivesGenesis, func() {
+			incentivesGenesis.Incentives = append(incentivesGenesis.Incentives, b.incentives...)
+		})
+	}
+
+	if len(b.revenues) > 0 {
+		var revenueGenesis revenuetypes.GenesisState
+		updateGenesis(cdc, genesis, revenuetypes.ModuleName, &revenueGenesis, func() {
+			revenueGenesis.Revenues = append(revenueGenesis.Revenues, b.revenues...)
+		})
+	}
+
+	return genesis
+}
+
+// updateGenesis unmarshals the genesis state of a module, updates it and
+// marshals it back
+func updateGenesis(cdc codec.JSONCodec, genesis simapp.GenesisState, module string, state proto.Message, update func()) {
+	cdc.MustUnmarshalJSON(genesis[module], state)
+	update()
+	genesis[module] = cdc.MustMarshalJSON(state)
+}
+
+// unclaimedAmount returns the amount of the actions that are not completed
+// in the claims records of the Evmos campaign
+func unclaimedAmount(records []claimstypes.ClaimsRecordAddress) sdk.Int {
+	unclaimed := sdk.ZeroInt()
+	for _, record := range records {
+		if record.CampaignID != claimstypes.EvmosCampaignID {
+			continue
+		}
+
+		perAction := record.InitialClaimableAmount.QuoRaw(int64(len(claimstypes.Action_name) - 1))
+		for _, completed := range record.ActionsCompleted {
+			if !completed {
+				unclaimed = unclaimed.Add(perAction)
+			}
+		}
+	}
+	return unclaimed
+}
@@ -0,0 +1,151 @@
+package testutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/tests"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/evmos/v11/app"
+	"github.com/evmos/evmos/v11/contracts"
+	"github.com/evmos/evmos/v11/testutil"
+	evmostypes "github.com/evmos/evmos/v11/types"
+	claimstypes "github.com/evmos/evmos/v11/x/claims/types"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	incentivestypes "github.com/evmos/evmos/v11/x/incentives/types"
+	revenuetypes "github.com/evmos/evmos/v11/x/revenue/types"
+	vestingtypes "github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+func TestGenesisBuilder(t *testing.T) {
+	contract := tests.GenerateAddress()
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	claimer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	vestingAddr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1000))
+	periods := sdkvesting.Periods{{Length: 100, Amount: vestingCoins}}
+	va := vestingtypes.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vestingAddr), deployer, vestingCoins, time.Now(), periods, periods,
+	)
+
+	pair := erc20types.NewTokenPair(contract, "acoin", true, erc20types.OWNER_MODULE)
+	incentive := incentivestypes.NewIncentive(contract, sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))}, 10)
+	revenue := revenuetypes.NewRevenue(contract, deployer, nil)
+	record := claimstypes.NewClaimsRecordAddress(claimer, sdk.NewInt(400))
+
+	builder := testutil.NewGenesisBuilder().
+		WithTokenPair(pair).
+		WithIncentive(incentive).
+		WithRevenue(revenue).
+		WithClawbackVestingAccount(va).
+		WithClaimsRecords(record)
+
+	evmosApp := app.SetupWithGenesis(false, nil, builder.Build)
+	ctx := evmosApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	require.Equal(t, []erc20types.TokenPair{pair}, evmosApp.Erc20Keeper.GetTokenPairs(ctx))
+	require.Equal(t, []revenuetypes.Revenue{revenue}, evmosApp.RevenueKeeper.GetRevenues(ctx))
+	require.Len(t, evmosApp.IncentivesKeeper.GetAllIncentives(ctx), 1)
+	require.Len(t, evmosApp.ClaimsKeeper.GetClaimsRecords(ctx), 1)
+
+	account := evmosApp.AccountKeeper.GetAccount(ctx, vestingAddr)
+	require.IsType(t, &vestingtypes.ClawbackVestingAccount{}, account)
+	require.Equal(t, vestingCoins, evmosApp.BankKeeper.GetAllBalances(ctx, vestingAddr))
+
+	escrowed := evmosApp.ClaimsKeeper.GetModuleAccountBalances(ctx)
+	require.Equal(t, sdk.NewInt(400), escrowed.AmountOf(claimstypes.DefaultClaimsDenom))
+}
+
+func TestGenesisBuilderRegisteredCoin(t *testing.T) {
+	holder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("acoin", 1000), sdk.NewInt64Coin("atoken", 1000))
+	metadata := []banktypes.Metadata{
+		{
+			Description: "Coin",
+			DenomUnits:  []*banktypes.DenomUnit{{Denom: "acoin"}, {Denom: "coin", Exponent: 18}},
+			Base:        "acoin",
+			Display:     "coin",
+			Name:        "Coin",
+			Symbol:      "COIN",
+		},
+		{
+			Description: "Token",
+			DenomUnits:  []*banktypes.DenomUnit{{Denom: "atoken"}},
+			Base:        "atoken",
+			Display:     "atoken",
+			Name:        "Token",
+			Symbol:      "TKN",
+		},
+	}
+
+	builder := testutil.NewGenesisBuilder().
+		WithAccount(authtypes.NewBaseAccountWithAddress(holder), coins).
+		WithRegisteredCoin(metadata...)
+
+	evmosApp := app.SetupWithGenesis(false, nil, builder.Build)
+	ctx := evmosApp.BaseApp.NewContext(false, tmproto.Header{ChainID: evmostypes.MainnetChainID + "-1"})
+
+	// the EVM calls require the block proposer
+	validators := evmosApp.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+
+	pairs := evmosApp.Erc20Keeper.GetTokenPairs(ctx)
+	require.Len(t, pairs, 2)
+
+	for _, md := range metadata {
+		id := evmosApp.Erc20Keeper.GetTokenPairID(ctx, md.Base)
+		pair, found := evmosApp.Erc20Keeper.GetTokenPair(ctx, id)
+		require.True(t, found)
+		require.True(t, pair.Enabled)
+
+		_, found = evmosApp.BankKeeper.GetDenomMetaData(ctx, md.Base)
+		require.True(t, found)
+
+		erc20Data, err := evmosApp.Erc20Keeper.QueryERC20(ctx, pair.GetERC20Contract())
+		require.NoError(t, err)
+		require.Equal(t, md.Name, erc20Data.Name)
+
+		// the coins of the pair can be converted
+		msg := erc20types.NewMsgConvertCoin(sdk.NewInt64Coin(md.Base, 400), common.BytesToAddress(holder), holder)
+		_, err = evmosApp.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		balance := evmosApp.Erc20Keeper.BalanceOf(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(holder))
+		require.Equal(t, int64(400), balance.Int64())
+	}
+
+	// the next registered coin doesn't reuse the address of a genesis contract
+	contract, err := evmosApp.Erc20Keeper.DeployERC20Contract(ctx, metadata[0])
+	require.NoError(t, err)
+	for _, pair := range pairs {
+		require.NotEqual(t, pair.GetERC20Contract(), contract)
+	}
+}
+
+func TestGenesisBuilderDoesNotModifyGenesis(t *testing.T) {
+	cdc := encoding.MakeConfig(app.ModuleBasics).Codec
+	genesis := app.NewDefaultGenesisState()
+	erc20Genesis := genesis[erc20types.ModuleName]
+
+	pair := erc20types.NewTokenPair(tests.GenerateAddress(), "acoin", true, erc20types.OWNER_MODULE)
+	built := testutil.NewGenesisBuilder().WithTokenPair(pair).Build(cdc, genesis)
+
+	require.Equal(t, erc20Genesis, genesis[erc20types.ModuleName])
+
+	var erc20GenesisState erc20types.GenesisState
+	cdc.MustUnmarshalJSON(built[erc20types.ModuleName], &erc20GenesisState)
+	require.Equal(t, []erc20types.TokenPair{pair}, erc20GenesisState.TokenPairs)
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package callbacks
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/callbacks/keeper"
+	"github.com/evmos/evmos/v11/x/callbacks/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	// ensure callbacks module account is set on genesis
+	if acc := k.GetModuleAccount(ctx); acc == nil {
+		panic("the callbacks module account has not been set")
+	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrapf(err, "cannot set parameters"))
+	}
+
+	for _, callback := range data.Callbacks {
+		k.SetPacketCallback(ctx, callback)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:    k.GetParams(ctx),
+		Callbacks: k.GetPacketCallbacks(ctx),
+	}
+}
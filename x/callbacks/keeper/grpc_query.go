@@ -0,0 +1,74 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/evmos/v11/x/callbacks/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Callbacks returns the callbacks of the packets that are pending completion
+func (k Keeper) Callbacks(
+	c context.Context,
+	req *types.QueryCallbacksRequest,
+) (*types.QueryCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var callbacks []types.PacketCallback
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var callback types.PacketCallback
+		if err := k.cdc.Unmarshal(value, &callback); err != nil {
+			return err
+		}
+		callbacks = append(callbacks, callback)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Params returns the callbacks module params
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
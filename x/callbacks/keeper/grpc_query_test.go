@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/x/callbacks/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryCallbacks() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.Callbacks(ctx, &types.QueryCallbacksRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Callbacks)
+
+	callback := types.NewPacketCallback("transfer", "channel-0", 1, tests.GenerateAddress().Bytes(), tests.GenerateAddress())
+	suite.app.CallbacksKeeper.SetPacketCallback(suite.ctx, callback)
+
+	res, err = suite.queryClient.Callbacks(ctx, &types.QueryCallbacksRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PacketCallback{callback}, res.Callbacks)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	expParams := types.DefaultParams()
+
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, res.Params)
+}
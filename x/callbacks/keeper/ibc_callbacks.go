@@ -0,0 +1,279 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/callbacks/types"
+)
+
+// SendPacket implements the ICS4Wrapper interface from the transfer module.
+// It registers the contract callback defined in the memo of an outbound ICS20
+// packet after calling the underlying SendPacket function, so that the
+// contract is called with the result of the packet. The send fails if the
+// callback is invalid.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	sender, metadata, err := k.parseCallback(ctx, data)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err = k.ics4Wrapper.SendPacket(
+		ctx,
+		channelCap,
+		sourcePort,
+		sourceChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+		data,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if metadata == nil {
+		return sequence, nil
+	}
+
+	callback := types.NewPacketCallback(sourcePort, sourceChannel, sequence, sender, metadata.GetContractAddress())
+	k.SetPacketCallback(ctx, callback)
+
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyRegisterCallback,
+			evmostelemetry.NewChannelLabel(callback.ChannelId),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCallback,
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+			sdk.NewAttribute(types.AttributeKeySender, callback.Sender),
+			sdk.NewAttribute(types.AttributeKeyChannel, callback.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(callback.Sequence, 10)),
+		),
+	)
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying WriteAcknowledgement function directly to move down the middleware stack.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, packet, ack)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnAcknowledgementPacket calls the contract registered for an outbound packet
+// with its acknowledgement. The callback is removed even if it fails, and its
+// failure doesn't revert the acknowledgement of the packet.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) error {
+	callback, found := k.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	k.DeletePacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	data, ok := parseTransferData(packet.GetData())
+	if !ok || !k.GetParams(ctx).EnableCallbacks {
+		return nil
+	}
+
+	result := ack.GetResult()
+	if !ack.Success() {
+		result = []byte(ack.GetError())
+	}
+
+	k.callContract(
+		ctx, callback, types.ContractMethodOnAcknowledgement,
+		callback.ChannelId, callback.Sequence, callback.GetSenderAddress(),
+		data.Denom, parseAmount(data.Amount), ack.Success(), result,
+	)
+	return nil
+}
+
+// OnTimeoutPacket calls the contract registered for an outbound packet that
+// has timed out. The callback is removed even if it fails, and its failure
+// doesn't revert the refund of the packet.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	callback, found := k.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeletePacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	data, ok := parseTransferData(packet.GetData())
+	if !ok || !k.GetParams(ctx).EnableCallbacks {
+		return nil
+	}
+
+	k.callContract(
+		ctx, callback, types.ContractMethodOnTimeout,
+		callback.ChannelId, callback.Sequence, callback.GetSenderAddress(),
+		data.Denom, parseAmount(data.Amount),
+	)
+	return nil
+}
+
+// parseCallback returns the sender and the callback metadata of an outbound
+// packet. It returns nil metadata if callbacks are disabled, the packet isn't
+// an ICS20 packet, its memo doesn't define a callback or its sender is a module
+// account, e.g. the forward module whose memos are set on other chains.
+func (k Keeper) parseCallback(ctx sdk.Context, bz []byte) (sdk.AccAddress, *types.CallbackMetadata, error) {
+	if !k.GetParams(ctx).EnableCallbacks {
+		return nil, nil, nil
+	}
+
+	data, ok := parseTransferData(bz)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	metadata, err := types.ParseCallbackMetadata(data.Memo)
+	if err != nil || metadata == nil {
+		return nil, nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender address %s: %s", data.Sender, err)
+	}
+
+	if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, sender).(authtypes.ModuleAccountI); isModuleAccount {
+		return nil, nil, nil
+	}
+
+	return sender, metadata, nil
+}
+
+// callContract calls a callback method of the contract of a packet callback.
+// The state changes of failed callbacks are discarded, and the gas used by the
+// callback is charged to the relayer transaction.
+func (k Keeper) callContract(ctx sdk.Context, callback types.PacketCallback, method string, args ...interface{}) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasUsed, err := k.callEVM(cacheCtx, callback.GetContractAddress(), method, args...)
+	if err != nil {
+		k.Logger(ctx).Error("contract callback failed", "contract", callback.Contract, "method", method, "error", err.Error())
+	} else {
+		writeCache()
+	}
+
+	ctx.GasMeter().ConsumeGas(gasUsed, "packet contract callback")
+
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "failure"
+		}
+		evmostelemetry.IncrCounter(
+			types.MetricKeyContractCallback,
+			evmostelemetry.NewChannelLabel(callback.ChannelId),
+			evmostelemetry.NewActionLabel(method),
+			evmostelemetry.NewStatusLabel(status),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractCallback,
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+			sdk.NewAttribute(types.AttributeKeyChannel, callback.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(callback.Sequence, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAction, method),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		),
+	)
+}
+
+// callEVM calls a callback method of a contract from the module account with
+// the callback gas limit. It returns the gas used by the call.
+func (k Keeper) callEVM(ctx sdk.Context, contract common.Address, method string, args ...interface{}) (uint64, error) {
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return 0, errorsmod.Wrapf(types.ErrContractCallback, "%s is not a contract", contract.Hex())
+	}
+
+	data, err := types.CallbacksABI.Pack(method, args...)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrContractCallback, err.Error())
+	}
+
+	from := common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName))
+	gasUsed, err := k.erc20Keeper.CallEVMWithGasLimit(ctx, from, contract, data, k.GetParams(ctx).CallbackGasLimit)
+	if err != nil {
+		return gasUsed, errorsmod.Wrap(types.ErrContractCallback, err.Error())
+	}
+
+	return gasUsed, nil
+}
+
+// parseTransferData returns the ICS20 data of a packet
+func parseTransferData(bz []byte) (transfertypes.FungibleTokenPacketData, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return data, false
+	}
+	return data, true
+}
+
+// parseAmount returns the amount of an ICS20 packet, which has already been
+// validated by the transfer application
+func parseAmount(amount string) *big.Int {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return value
+}
@@ -0,0 +1,328 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/evmos/evmos/v11/app"
+	ibctesting "github.com/evmos/evmos/v11/ibc/testing"
+	evmostypes "github.com/evmos/evmos/v11/types"
+	"github.com/evmos/evmos/v11/x/callbacks/types"
+	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+)
+
+var (
+	// callbackCode is the runtime bytecode of a contract that stores the size
+	// of its calldata in the storage slot 0:
+	//
+	//	CALLDATASIZE PUSH1 0x00 SSTORE STOP
+	callbackCode = []byte{0x36, 0x60, 0x00, 0x55, 0x00}
+	// revertCode is the runtime bytecode of a contract that always reverts:
+	//
+	//	PUSH1 0x00 DUP1 REVERT
+	revertCode = []byte{0x60, 0x00, 0x80, 0xfd}
+)
+
+type IBCTestingSuite struct {
+	suite.Suite
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	EvmosChain     *ibcgotesting.TestChain
+	IBCCosmosChain *ibcgotesting.TestChain
+
+	app *app.Evmos
+
+	path *ibctesting.Path
+}
+
+func TestIBCTestingSuite(t *testing.T) {
+	suite.Run(t, new(IBCTestingSuite))
+}
+
+func (suite *IBCTestingSuite) SetupTest() {
+	// initializes 2 test chains
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 1)
+	suite.EvmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.IBCCosmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.coordinator.CommitNBlocks(suite.EvmosChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCCosmosChain, 2)
+
+	suite.app = suite.EvmosChain.App.(*app.Evmos)
+	evmParams := suite.app.EvmKeeper.GetParams(suite.EvmosChain.GetContext())
+	evmParams.EvmDenom = evmostypes.BaseDenom
+	err := suite.app.EvmKeeper.SetParams(suite.EvmosChain.GetContext(), evmParams)
+	suite.Require().NoError(err)
+
+	// Set block proposer once, so its carried over on the ibc-go-testing suite
+	validators := suite.app.StakingKeeper.GetValidators(suite.EvmosChain.GetContext(), 2)
+	cons, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.EvmosChain.CurrentHeader.ProposerAddress = cons.Bytes()
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.EvmosChain.GetContext(), validators[0])
+	suite.Require().NoError(err)
+
+	// Fund sender address to pay fees and transfer
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(evmostypes.BaseDenom, amt))
+	err = suite.app.BankKeeper.MintCoins(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, suite.EvmosChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	suite.path = ibctesting.NewTransferPath(suite.EvmosChain, suite.IBCCosmosChain)
+	ibctesting.SetupPath(suite.coordinator, suite.path)
+}
+
+// deployContract sets the runtime code on a new contract address
+func (suite *IBCTestingSuite) deployContract(code []byte) common.Address {
+	contract := tests.GenerateAddress()
+	codeHash := crypto.Keccak256(code)
+
+	ctx := suite.EvmosChain.GetContext()
+	suite.app.EvmKeeper.SetCode(ctx, codeHash, code)
+	err := suite.app.EvmKeeper.SetAccount(ctx, contract, statedb.Account{Nonce: 1, Balance: big.NewInt(0), CodeHash: codeHash})
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.EvmosChain)
+
+	return contract
+}
+
+// sendTransfer transfers aevmos from Evmos to the receiver on Cosmos with the
+// given memo, and returns the sent packet
+func (suite *IBCTestingSuite) sendTransfer(receiver, memo string, timeoutTimestamp uint64) channeltypes.Packet {
+	path := suite.path
+	coin := sdk.NewInt64Coin(evmostypes.BaseDenom, 100)
+	timeoutHeight := clienttypes.ZeroHeight()
+	if timeoutTimestamp == 0 {
+		timeoutHeight = clienttypes.NewHeight(1000, 1000)
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		suite.EvmosChain.SenderAccount.GetAddress().String(), receiver,
+		timeoutHeight, timeoutTimestamp, memo,
+	)
+	res, err := ibctesting.SendMsgs(suite.EvmosChain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+// relayPacket relays the packet to Cosmos and its acknowledgement back to
+// Evmos, and returns the acknowledgement
+func (suite *IBCTestingSuite) relayPacket(packet channeltypes.Packet) channeltypes.Acknowledgement {
+	path := suite.path
+
+	err := path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ackBz, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.AcknowledgePacket(packet, ackBz)
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	err = transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+	suite.Require().NoError(err)
+	return ack
+}
+
+// callbackMemo returns the memo registering the contract callback
+func callbackMemo(contract common.Address) string {
+	return `{"callback":{"address":"` + contract.Hex() + `"}}`
+}
+
+// escrowBalance returns the aevmos escrowed on the transfer channel
+func (suite *IBCTestingSuite) escrowBalance() sdk.Int {
+	escrow := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	return suite.app.BankKeeper.GetBalance(suite.EvmosChain.GetContext(), escrow, evmostypes.BaseDenom).Amount
+}
+
+// callbackCalldataSize returns the size of the calldata of the last call of
+// the callback contract
+func (suite *IBCTestingSuite) callbackCalldataSize(contract common.Address) int64 {
+	return suite.app.EvmKeeper.GetState(suite.EvmosChain.GetContext(), contract, common.Hash{}).Big().Int64()
+}
+
+func (suite *IBCTestingSuite) TestCallbackAcknowledgement() {
+	testCases := []struct {
+		name     string
+		receiver func() string
+		expAck   bool
+	}{
+		{
+			"successful transfer",
+			func() string { return suite.IBCCosmosChain.SenderAccount.GetAddress().String() },
+			true,
+		},
+		{
+			"refunded transfer",
+			func() string { return "invalid" },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract := suite.deployContract(callbackCode)
+			sender := suite.EvmosChain.SenderAccount.GetAddress()
+
+			packet := suite.sendTransfer(tc.receiver(), callbackMemo(contract), 0)
+
+			ctx := suite.EvmosChain.GetContext()
+			callback, found := suite.app.CallbacksKeeper.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewPacketCallback(packet.SourcePort, packet.SourceChannel, packet.Sequence, sender, contract), callback)
+
+			ack := suite.relayPacket(packet)
+			suite.Require().Equal(tc.expAck, ack.Success())
+
+			// the acknowledgement callback is called with the result
+			result := ack.GetResult()
+			if !tc.expAck {
+				result = []byte(ack.GetError())
+			}
+			calldata, err := types.CallbacksABI.Pack(
+				types.ContractMethodOnAcknowledgement,
+				packet.SourceChannel, packet.Sequence, common.BytesToAddress(sender.Bytes()),
+				evmostypes.BaseDenom, big.NewInt(100), tc.expAck, result,
+			)
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(len(calldata)), suite.callbackCalldataSize(contract))
+
+			_, found = suite.app.CallbacksKeeper.GetPacketCallback(suite.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			// the failed transfer is refunded
+			expEscrow := int64(100)
+			if !tc.expAck {
+				expEscrow = 0
+			}
+			suite.Require().Equal(expEscrow, suite.escrowBalance().Int64())
+		})
+	}
+}
+
+func (suite *IBCTestingSuite) TestCallbackTimeout() {
+	contract := suite.deployContract(callbackCode)
+	sender := suite.EvmosChain.SenderAccount.GetAddress()
+	path := suite.path
+
+	timeout := suite.IBCCosmosChain.CurrentHeader.Time.Add(time.Minute)
+	packet := suite.sendTransfer(suite.IBCCosmosChain.SenderAccount.GetAddress().String(), callbackMemo(contract), uint64(timeout.UnixNano()))
+	suite.Require().Equal(int64(100), suite.escrowBalance().Int64())
+
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.IBCCosmosChain)
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	// the timeout callback is called after the refund
+	calldata, err := types.CallbacksABI.Pack(
+		types.ContractMethodOnTimeout,
+		packet.SourceChannel, packet.Sequence, common.BytesToAddress(sender.Bytes()),
+		evmostypes.BaseDenom, big.NewInt(100),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(len(calldata)), suite.callbackCalldataSize(contract))
+	suite.Require().True(suite.escrowBalance().IsZero())
+
+	_, found := suite.app.CallbacksKeeper.GetPacketCallback(suite.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *IBCTestingSuite) TestFailedCallbackDoesNotBlockRefund() {
+	testCases := []struct {
+		name     string
+		contract func() common.Address
+	}{
+		{"reverting contract", func() common.Address { return suite.deployContract(revertCode) }},
+		{"not a contract", func() common.Address { return tests.GenerateAddress() }},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract := tc.contract()
+
+			packet := suite.sendTransfer("invalid", callbackMemo(contract), 0)
+			ack := suite.relayPacket(packet)
+			suite.Require().False(ack.Success())
+
+			// the transfer is refunded and the callback removed
+			suite.Require().True(suite.escrowBalance().IsZero())
+			_, found := suite.app.CallbacksKeeper.GetPacketCallback(suite.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *IBCTestingSuite) TestRegisterCallback() {
+	testCases := []struct {
+		name        string
+		malleate    func()
+		memo        string
+		expRegister bool
+	}{
+		{"no memo", func() {}, "", false},
+		{"other memo", func() {}, `{"wasm":{}}`, false},
+		{"callback memo", func() {}, callbackMemo(tests.GenerateAddress()), true},
+		{
+			"callbacks disabled",
+			func() {
+				err := suite.app.CallbacksKeeper.SetParams(suite.EvmosChain.GetContext(), types.NewParams(false, types.DefaultCallbackGasLimit))
+				suite.Require().NoError(err)
+				suite.coordinator.CommitBlock(suite.EvmosChain)
+			},
+			callbackMemo(tests.GenerateAddress()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			packet := suite.sendTransfer(suite.IBCCosmosChain.SenderAccount.GetAddress().String(), tc.memo, 0)
+
+			_, found := suite.app.CallbacksKeeper.GetPacketCallback(suite.EvmosChain.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().Equal(tc.expRegister, found)
+		})
+	}
+}
+
+func (suite *IBCTestingSuite) TestInvalidCallbackMemo() {
+	path := suite.path
+	coin := sdk.NewInt64Coin(evmostypes.BaseDenom, 100)
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+		suite.EvmosChain.SenderAccount.GetAddress().String(), suite.IBCCosmosChain.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1000, 1000), 0, `{"callback":{"address":"evmos1"}}`,
+	)
+	_, err := suite.app.TransferKeeper.Transfer(sdk.WrapSDKContext(suite.EvmosChain.GetContext()), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidCallbackMetadata)
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v11/x/callbacks/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name    string
+		request *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "foobar"},
+			false,
+		},
+		{
+			"pass - valid update params",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(false, 100_000),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.app.CallbacksKeeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params, suite.app.CallbacksKeeper.GetParams(suite.ctx))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
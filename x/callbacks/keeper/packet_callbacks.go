@@ -0,0 +1,69 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/callbacks/types"
+)
+
+// GetPacketCallbacks returns the callbacks of all the pending packets
+func (k Keeper) GetPacketCallbacks(ctx sdk.Context) []types.PacketCallback {
+	callbacks := []types.PacketCallback{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var callback types.PacketCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &callback)
+		callbacks = append(callbacks, callback)
+	}
+
+	return callbacks
+}
+
+// GetPacketCallback returns the callback of the packet sent on a port and
+// channel with a sequence
+func (k Keeper) GetPacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketCallback, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+	bz := store.Get(types.GetPacketCallbackKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PacketCallback{}, false
+	}
+
+	var callback types.PacketCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return callback, true
+}
+
+// SetPacketCallback stores a packet callback
+func (k Keeper) SetPacketCallback(ctx sdk.Context, callback types.PacketCallback) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+	bz := k.cdc.MustMarshal(&callback)
+	store.Set(types.GetPacketCallbackKey(callback.PortId, callback.ChannelId, callback.Sequence), bz)
+}
+
+// DeletePacketCallback removes the callback of the packet sent on a port and
+// channel with a sequence
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketCallback)
+	store.Delete(types.GetPacketCallbackKey(portID, channelID, sequence))
+}
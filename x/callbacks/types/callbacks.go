@@ -0,0 +1,73 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewPacketCallback returns an instance of PacketCallback for the packet sent
+// on a port and channel with a sequence
+func NewPacketCallback(portID, channelID string, sequence uint64, sender sdk.AccAddress, contract common.Address) PacketCallback {
+	return PacketCallback{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Sender:    sender.String(),
+		Contract:  contract.Hex(),
+	}
+}
+
+// GetContractAddress returns the address of the contract that is called
+func (c PacketCallback) GetContractAddress() common.Address {
+	return common.HexToAddress(c.Contract)
+}
+
+// GetSenderAddress returns the EVM address of the sender of the transfer
+func (c PacketCallback) GetSenderAddress() common.Address {
+	sender := sdk.MustAccAddressFromBech32(c.Sender)
+	return common.BytesToAddress(sender.Bytes())
+}
+
+// Validate performs a stateless validation of a packet callback
+func (c PacketCallback) Validate() error {
+	if err := host.PortIdentifierValidator(c.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return err
+	}
+
+	if c.Sequence == 0 {
+		return fmt.Errorf("sequence cannot be 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.Sender); err != nil {
+		return fmt.Errorf("invalid sender address %s: %w", c.Sender, err)
+	}
+
+	if !common.IsHexAddress(c.Contract) {
+		return fmt.Errorf("invalid contract address %s", c.Contract)
+	}
+
+	return nil
+}
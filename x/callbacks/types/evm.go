@@ -0,0 +1,61 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// contract callback methods
+const (
+	ContractMethodOnAcknowledgement = "onTransferAcknowledgement"
+	ContractMethodOnTimeout         = "onTransferTimeout"
+)
+
+// callbacksJSON defines the callback methods of the contracts that are called
+// with the result of the ICS20 packets
+const callbacksJSON = `[
+	{"type":"function","name":"onTransferAcknowledgement","stateMutability":"nonpayable","outputs":[],"inputs":[
+		{"name":"channelId","type":"string"},
+		{"name":"sequence","type":"uint64"},
+		{"name":"sender","type":"address"},
+		{"name":"denom","type":"string"},
+		{"name":"amount","type":"uint256"},
+		{"name":"success","type":"bool"},
+		{"name":"result","type":"bytes"}
+	]},
+	{"type":"function","name":"onTransferTimeout","stateMutability":"nonpayable","outputs":[],"inputs":[
+		{"name":"channelId","type":"string"},
+		{"name":"sequence","type":"uint64"},
+		{"name":"sender","type":"address"},
+		{"name":"denom","type":"string"},
+		{"name":"amount","type":"uint256"}
+	]}
+]`
+
+// CallbacksABI is the ABI of the contract callbacks
+var CallbacksABI abi.ABI
+
+func init() {
+	var err error
+	CallbacksABI, err = abi.JSON(strings.NewReader(callbacksJSON))
+	if err != nil {
+		panic(err)
+	}
+}
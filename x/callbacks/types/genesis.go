@@ -0,0 +1,53 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, callbacks []PacketCallback) GenesisState {
+	return GenesisState{
+		Params:    params,
+		Callbacks: callbacks,
+	}
+}
+
+// DefaultGenesisState sets default callbacks genesis state with default params
+// and no pending callbacks
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, callback := range gs.Callbacks {
+		key := fmt.Sprintf("%s/%s/%d", callback.PortId, callback.ChannelId, callback.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate packet callback %s", key)
+		}
+		if err := callback.Validate(); err != nil {
+			return err
+		}
+		seen[key] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	sender := tests.GenerateAddress().Bytes()
+	callback := NewPacketCallback("transfer", "channel-0", 1, sender, tests.GenerateAddress())
+
+	otherCallback := callback
+	otherCallback.Sequence = 2
+
+	noSequence := callback
+	noSequence.Sequence = 0
+
+	invalidChannel := callback
+	invalidChannel.ChannelId = "1"
+
+	invalidSender := callback
+	invalidSender.Sender = "evmos1"
+
+	invalidContract := callback
+	invalidContract.Contract = "0x1"
+
+	testCases := []struct {
+		name     string
+		genesis  GenesisState
+		expError bool
+	}{
+		{"default genesis", *DefaultGenesisState(), false},
+		{"valid genesis", NewGenesisState(DefaultParams(), []PacketCallback{callback, otherCallback}), false},
+		{"duplicate callback", NewGenesisState(DefaultParams(), []PacketCallback{callback, callback}), true},
+		{"missing sequence", NewGenesisState(DefaultParams(), []PacketCallback{noSequence}), true},
+		{"invalid channel", NewGenesisState(DefaultParams(), []PacketCallback{invalidChannel}), true},
+		{"invalid sender", NewGenesisState(DefaultParams(), []PacketCallback{invalidSender}), true},
+		{"invalid contract", NewGenesisState(DefaultParams(), []PacketCallback{invalidContract}), true},
+		{"zero callback gas limit", NewGenesisState(NewParams(true, 0), nil), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	require.Equal(t, common.BytesToAddress(sender), callback.GetSenderAddress())
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// ModuleName defines the callbacks module name
+	ModuleName = "callbacks"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the callbacks module's persistent store
+const (
+	prefixParams = iota + 1
+	prefixPacketCallback
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey               = []byte{prefixParams}
+	KeyPrefixPacketCallback = []byte{prefixPacketCallback}
+)
+
+// GetPacketCallbackKey returns the key of the callback of the packet sent on a
+// port and channel with a sequence, relative to its prefix store
+func GetPacketCallbackKey(portID, channelID string, sequence uint64) []byte {
+	key := append(address.MustLengthPrefix([]byte(portID)), address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
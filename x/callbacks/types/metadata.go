@@ -0,0 +1,87 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PacketMetadata defines the memo of an outbound ICS20 packet whose result is
+// sent to a contract, e.g.
+//
+//	{"callback": {"address": "0x..."}}
+type PacketMetadata struct {
+	Callback *CallbackMetadata `json:"callback"`
+}
+
+// CallbackMetadata defines the contract that is called with the result of the
+// packet
+type CallbackMetadata struct {
+	// Address is the hex address of the contract
+	Address string `json:"address"`
+}
+
+// ParseCallbackMetadata returns the callback metadata of the memo of an ICS20
+// packet. It returns nil if the memo doesn't define a callback, and an error
+// if the callback is invalid.
+func ParseCallbackMetadata(memo string) (*CallbackMetadata, error) {
+	// other memos, e.g. plain text ones, are left to the other middlewares
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+
+	if _, found := fields["callback"]; !found {
+		return nil, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidCallbackMetadata, err.Error())
+	}
+
+	if metadata.Callback == nil {
+		return nil, errorsmod.Wrap(ErrInvalidCallbackMetadata, "callback cannot be null")
+	}
+
+	if err := metadata.Callback.Validate(); err != nil {
+		return nil, err
+	}
+
+	return metadata.Callback, nil
+}
+
+// Validate performs a stateless validation of the callback metadata
+func (m CallbackMetadata) Validate() error {
+	if !common.IsHexAddress(m.Address) {
+		return errorsmod.Wrapf(ErrInvalidCallbackMetadata, "invalid contract address %s", m.Address)
+	}
+
+	if m.GetContractAddress() == (common.Address{}) {
+		return errorsmod.Wrap(ErrInvalidCallbackMetadata, "contract address cannot be the zero address")
+	}
+
+	return nil
+}
+
+// GetContractAddress returns the address of the contract
+func (m CallbackMetadata) GetContractAddress() common.Address {
+	return common.HexToAddress(m.Address)
+}
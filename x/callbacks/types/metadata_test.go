@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCallbackMetadata(t *testing.T) {
+	contract := "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expCallback bool
+		expError    bool
+	}{
+		{"empty memo", "", false, false},
+		{"plain text memo", "hello", false, false},
+		{"other JSON memo", `{"forward": {}}`, false, false},
+		{"valid callback", `{"callback": {"address": "` + contract + `"}}`, true, false},
+		{"null callback", `{"callback": null}`, false, true},
+		{"invalid callback", `{"callback": "` + contract + `"}`, false, true},
+		{"missing address", `{"callback": {}}`, false, true},
+		{"invalid address", `{"callback": {"address": "evmos1"}}`, false, true},
+		{"zero address", `{"callback": {"address": "0x0000000000000000000000000000000000000000"}}`, false, true},
+	}
+
+	for _, tc := range testCases {
+		metadata, err := ParseCallbackMetadata(tc.memo)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		if !tc.expCallback {
+			require.Nil(t, metadata, tc.name)
+			continue
+		}
+
+		require.NotNil(t, metadata, tc.name)
+		require.Equal(t, common.HexToAddress(contract), metadata.GetContractAddress(), tc.name)
+	}
+}
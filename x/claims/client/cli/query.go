@@ -19,6 +19,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +30,9 @@ import (
 	"github.com/evmos/evmos/v11/x/claims/types"
 )
 
+// FlagCampaignID is the flag to select the campaign of the claims records queries
+const FlagCampaignID = "campaign-id"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	claimQueryCmd := &cobra.Command{
@@ -44,6 +48,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryClaimsRecords(),
 		GetCmdQueryClaimsRecord(),
+		GetCmdQueryCampaigns(),
+		GetCmdQueryCampaign(),
 	)
 
 	return claimQueryCmd
@@ -130,8 +136,14 @@ func GetCmdQueryClaimsRecords() *cobra.Command {
 				return err
 			}
 
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryClaimsRecordsRequest{
 				Pagination: pageReq,
+				CampaignID: campaignID,
 			}
 
 			// Query store
@@ -143,6 +155,7 @@ func GetCmdQueryClaimsRecords() *cobra.Command {
 			return clientCtx.PrintProto(res)
 		},
 	}
+	cmd.Flags().Uint64(FlagCampaignID, types.EvmosCampaignID, "campaign identifier; defaults to the Evmos airdrop")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -163,8 +176,79 @@ func GetCmdQueryClaimsRecord() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClaimsRecordRequest{
+				Address:    args[0],
+				CampaignID: campaignID,
+			}
+
 			// Query store
-			res, err := queryClient.ClaimsRecord(context.Background(), &types.QueryClaimsRecordRequest{Address: args[0]})
+			res, err := queryClient.ClaimsRecord(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(FlagCampaignID, types.EvmosCampaignID, "campaign identifier; defaults to the Evmos airdrop")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCampaigns implements the query campaigns command.
+func GetCmdQueryCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaigns",
+		Args:    cobra.NoArgs,
+		Short:   "Query all the airdrop campaigns",
+		Long:    "Query the list of all the airdrop campaigns, including the Evmos airdrop (campaign 0)",
+		Example: fmt.Sprintf("%s query claims campaigns", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Campaigns(context.Background(), &types.QueryCampaignsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCampaign implements the query campaign command.
+func GetCmdQueryCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaign CAMPAIGN_ID",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query an airdrop campaign",
+		Example: fmt.Sprintf("%s query claims campaign 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Campaign(context.Background(), &types.QueryCampaignRequest{CampaignId: campaignID})
 			if err != nil {
 				return err
 			}
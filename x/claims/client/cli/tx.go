@@ -0,0 +1,363 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+const (
+	FlagActions         = "actions"
+	FlagClawbackAddress = "clawback-address"
+	FlagContractActions = "contract-actions"
+	FlagMerkleRoot      = "merkle-root"
+	FlagMerkleTotal     = "merkle-total"
+)
+
+// NewTxCmd returns a root CLI command handler for claims transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "claims subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateCampaignCmd(),
+		NewAddClaimsRecordsCmd(),
+		NewSubmitClaimProofCmd(),
+		NewTransferClaimsRecordCmd(),
+	)
+	return txCmd
+}
+
+// NewCreateCampaignCmd returns a CLI command handler for creating and funding
+// a new airdrop campaign
+func NewCreateCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-campaign NAME AMOUNT START_TIME DURATION_UNTIL_DECAY DURATION_OF_DECAY",
+		Short: "Create an airdrop campaign funded with the given amount, e.g. create-campaign \"Partner drop\" 1000000aevmos 2023-06-01T00:00:00Z 720h 720h --actions vote,delegate",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+
+			durationUntilDecay, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid duration until decay: %w", err)
+			}
+
+			durationOfDecay, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid duration of decay: %w", err)
+			}
+
+			actionNames, err := cmd.Flags().GetStringSlice(FlagActions)
+			if err != nil {
+				return err
+			}
+
+			actions, err := parseActions(actionNames)
+			if err != nil {
+				return err
+			}
+
+			clawbackAddress, err := cmd.Flags().GetString(FlagClawbackAddress)
+			if err != nil {
+				return err
+			}
+
+			contractActionsArgs, err := cmd.Flags().GetStringArray(FlagContractActions)
+			if err != nil {
+				return err
+			}
+
+			contractActions := make([]types.ContractAction, len(contractActionsArgs))
+			for i, arg := range contractActionsArgs {
+				contract, eventSignature, _ := strings.Cut(arg, ":")
+				contractActions[i] = types.ContractAction{
+					Contract:       contract,
+					EventSignature: eventSignature,
+				}
+			}
+
+			merkleRootHex, err := cmd.Flags().GetString(FlagMerkleRoot)
+			if err != nil {
+				return err
+			}
+
+			merkleRoot, err := hex.DecodeString(strings.TrimPrefix(merkleRootHex, "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid merkle root hex: %w", err)
+			}
+
+			merkleTotalStr, err := cmd.Flags().GetString(FlagMerkleTotal)
+			if err != nil {
+				return err
+			}
+
+			merkleTotal := math.ZeroInt()
+			if merkleTotalStr != "" {
+				var ok bool
+				merkleTotal, ok = math.NewIntFromString(merkleTotalStr)
+				if !ok {
+					return fmt.Errorf("invalid merkle total: %s", merkleTotalStr)
+				}
+			}
+
+			msg := &types.MsgCreateCampaign{
+				Creator:            cliCtx.GetFromAddress().String(),
+				Name:               args[0],
+				Amount:             amount,
+				StartTime:          startTime,
+				DurationUntilDecay: durationUntilDecay,
+				DurationOfDecay:    durationOfDecay,
+				Actions:            actions,
+				ClawbackAddress:    clawbackAddress,
+				ContractActions:    contractActions,
+				MerkleRoot:         merkleRoot,
+				MerkleTotal:        merkleTotal,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagActions, []string{"vote", "delegate", "evm", "ibc-transfer"}, "qualifying actions of the campaign (vote, delegate, evm, ibc-transfer)")
+	cmd.Flags().String(FlagClawbackAddress, "", "recipient of the unclaimed tokens; defaults to the community pool")
+	cmd.Flags().StringArray(FlagContractActions, []string{}, "qualifying contract interaction formatted as CONTRACT[:EVENT_SIGNATURE], e.g. 0x...:Transfer(address,address,uint256); can be repeated")
+	cmd.Flags().String(FlagMerkleRoot, "", "hex encoded Merkle root of the allocations; recipients create their claims records with a Merkle proof")
+	cmd.Flags().String(FlagMerkleTotal, "", "sum of the allocations of the Merkle tree; required with --merkle-root")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAddClaimsRecordsCmd returns a CLI command handler for allocating the
+// funds of a campaign to a list of recipients
+func NewAddClaimsRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-claims-records CAMPAIGN_ID ALLOCATIONS_FILE",
+		Short: "Allocate the campaign funds to the recipients listed on a JSON file, e.g. [{\"address\":\"evmos1...\",\"amount\":\"1000\"}]",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id: %w", err)
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var allocations []types.ClaimsAllocation
+			if err := json.Unmarshal(bz, &allocations); err != nil {
+				return fmt.Errorf("failed to parse allocations file: %w", err)
+			}
+
+			msg := &types.MsgAddClaimsRecords{
+				Creator:     cliCtx.GetFromAddress().String(),
+				CampaignID:  campaignID,
+				Allocations: allocations,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSubmitClaimProofCmd returns a CLI command handler for creating the claims
+// record of the sender on a Merkle campaign
+func NewSubmitClaimProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-claim-proof CAMPAIGN_ID AMOUNT MSGS_JSON_FILE [PROOF_HEX,...]",
+		Short: "Submit the Merkle proof of the sender allocation on a Merkle campaign with the first claiming action, e.g. submit-claim-proof 1 1000 delegate.json 0xab..,0xcd..",
+		Long:  "Submit the Merkle proof of the sender allocation on a Merkle campaign with the first claiming action. The file contains a JSON encoded vote, delegation or IBC transfer message of the sender, or an array of them.",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id: %w", err)
+			}
+
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			msgs, err := parseMsgs(cliCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			var proof [][]byte
+			if len(args) == 4 {
+				for _, nodeHex := range strings.Split(args[3], ",") {
+					node, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(nodeHex), "0x"))
+					if err != nil {
+						return fmt.Errorf("invalid proof hex: %w", err)
+					}
+					proof = append(proof, node)
+				}
+			}
+
+			msg, err := types.NewMsgSubmitClaimProof(cliCtx.GetFromAddress(), campaignID, amount, proof, msgs)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTransferClaimsRecordCmd returns a CLI command handler for moving the
+// claims record of the sender to another address
+func NewTransferClaimsRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-claims-record RECIPIENT",
+		Short: "Move the claims record of the sender to the recipient, merging it with the recipient claims record if it exists",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := cmd.Flags().GetUint64(FlagCampaignID)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferClaimsRecord{
+				Sender:     cliCtx.GetFromAddress().String(),
+				Recipient:  args[0],
+				CampaignID: campaignID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCampaignID, types.EvmosCampaignID, "campaign identifier; defaults to the Evmos airdrop")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseActions converts the action names (e.g. "ibc-transfer" or
+// "ACTION_IBC_TRANSFER") to their Action values
+func parseActions(names []string) ([]types.Action, error) {
+	actions := make([]types.Action, len(names))
+	for i, name := range names {
+		name = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(name), "-", "_"))
+		if !strings.HasPrefix(name, "ACTION_") {
+			name = "ACTION_" + name
+		}
+
+		value, ok := types.Action_value[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid action %s", names[i])
+		}
+		actions[i] = types.Action(value)
+	}
+	return actions, nil
+}
+
+// parseMsgs reads a JSON encoded message, or an array of JSON encoded
+// messages, from a file
+func parseMsgs(cliCtx client.Context, path string) ([]sdk.Msg, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(contents, &raws); err != nil {
+		raws = []json.RawMessage{contents}
+	}
+
+	msgs := make([]sdk.Msg, len(raws))
+	for i, raw := range raws {
+		if err := cliCtx.Codec.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
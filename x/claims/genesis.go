@@ -44,6 +44,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		data.Params.AirdropStartTime = ctx.BlockTime()
 	}
 
+	evmosCampaignFound := false
+	nextCampaignID := types.EvmosCampaignID + 1
+	for _, campaign := range data.Campaigns {
+		k.SetCampaign(ctx, campaign)
+		if campaign.ID == types.EvmosCampaignID {
+			evmosCampaignFound = true
+		}
+		if campaign.ID >= nextCampaignID {
+			nextCampaignID = campaign.ID + 1
+		}
+	}
+	k.SetNextCampaignID(ctx, nextCampaignID)
+
+	// NOTE: the params are set after the campaigns as they update the Evmos
+	// airdrop campaign
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "error setting params"))
@@ -54,17 +69,27 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		totalEscrowed = escrowedCoins.AmountOfNoDenomValidation(data.Params.ClaimsDenom)
 	}
 
+	totalAllocated := sdk.ZeroInt()
+
 	for _, claimsRecord := range data.ClaimsRecords {
 		addr := sdk.MustAccAddressFromBech32(claimsRecord.Address)
 		cr := types.ClaimsRecord{
-			InitialClaimableAmount: claimsRecord.InitialClaimableAmount,
-			ActionsCompleted:       claimsRecord.ActionsCompleted,
+			InitialClaimableAmount:   claimsRecord.InitialClaimableAmount,
+			ActionsCompleted:         claimsRecord.ActionsCompleted,
+			ContractActionsCompleted: claimsRecord.ContractActionsCompleted,
 		}
 
 		if len(cr.ActionsCompleted) != len(types.Action_name)-1 {
 			panic(fmt.Errorf("invalid actions completed length for address %s", claimsRecord.Address))
 		}
 
+		// NOTE: partner campaigns are funded from their own escrow accounts
+		if claimsRecord.CampaignID != types.EvmosCampaignID {
+			k.SetCampaignClaimsRecord(ctx, claimsRecord.CampaignID, addr, cr)
+			continue
+		}
+
+		totalAllocated = totalAllocated.Add(claimsRecord.InitialClaimableAmount)
 		initialClaimablePerAction := claimsRecord.InitialClaimableAmount.Quo(numActions)
 
 		for _, actionCompleted := range cr.ActionsCompleted {
@@ -77,6 +102,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		k.SetClaimsRecord(ctx, addr, cr)
 	}
 
+	for _, usedProof := range data.UsedClaimProofs {
+		addr := sdk.MustAccAddressFromBech32(usedProof.Address)
+		k.SetUsedClaimProof(ctx, usedProof.CampaignID, addr)
+	}
+
 	// check for equal only for unclaimed actions
 	if !sumUnclaimed.Equal(totalEscrowed) {
 		panic(
@@ -86,12 +116,23 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			),
 		)
 	}
+
+	// the allocated and claimed totals of the Evmos airdrop are derived from
+	// its claims records when the genesis doesn't define the campaign
+	if !evmosCampaignFound {
+		evmosCampaign, _ := k.GetCampaign(ctx, types.EvmosCampaignID)
+		evmosCampaign.TotalAllocated = totalAllocated
+		evmosCampaign.TotalClaimed = totalAllocated.Sub(sumUnclaimed)
+		k.SetCampaign(ctx, evmosCampaign)
+	}
 }
 
 // ExportGenesis returns the claim module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		ClaimsRecords: k.GetClaimsRecords(ctx),
+		Params:          k.GetParams(ctx),
+		ClaimsRecords:   k.GetClaimsRecords(ctx),
+		Campaigns:       k.GetCampaigns(ctx),
+		UsedClaimProofs: k.GetUsedClaimProofs(ctx),
 	}
 }
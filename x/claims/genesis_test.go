@@ -197,11 +197,61 @@ func (suite *GenesisTestSuite) TestClaimExportGenesis() {
 		ActionsCompleted:       []bool{false, false, false, false},
 	})
 
-	claimableAmount, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecord, types.ActionIBCTransfer, suite.genesis.Params)
+	claimableAmount, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecord, types.ActionIBCTransfer)
 	suite.Require().Equal(sdk.NewInt(100), claimableAmount)
 	suite.Require().Equal(sdk.ZeroInt(), remainder)
 
+	// the Evmos airdrop totals are derived from its claims records
+	evmosCampaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, types.EvmosCampaignID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(10_400), evmosCampaign.TotalAllocated)
+	suite.Require().Equal(sdk.NewInt(10_000), evmosCampaign.TotalClaimed)
+
 	genesisExported := claims.ExportGenesis(suite.ctx, *suite.app.ClaimsKeeper)
 	suite.Require().Equal(genesisExported.Params, suite.genesis.Params)
 	suite.Require().Equal(genesisExported.ClaimsRecords, suite.genesis.ClaimsRecords)
+	suite.Require().Equal([]types.Campaign{evmosCampaign}, genesisExported.Campaigns)
+
+	// re-importing the exported genesis keeps the Evmos airdrop totals
+	claims.InitGenesis(suite.ctx, *suite.app.ClaimsKeeper, *genesisExported)
+	reimported, _ := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, types.EvmosCampaignID)
+	suite.Require().Equal(evmosCampaign, reimported)
+}
+
+func (suite *GenesisTestSuite) TestClaimExportGenesisCampaigns() {
+	campaign := types.NewCampaign(
+		5, "partner", acc1, "atest", suite.ctx.BlockTime(), time.Hour, time.Hour, []types.Action{types.ActionVote}, nil, "",
+	)
+	campaign.TotalAllocated = sdk.NewInt(100)
+
+	suite.genesis.Campaigns = []types.Campaign{campaign}
+	suite.genesis.ClaimsRecords = []types.ClaimsRecordAddress{
+		{
+			Address:                acc2.String(),
+			InitialClaimableAmount: sdk.NewInt(100),
+			ActionsCompleted:       []bool{false, false, false, false},
+			CampaignID:             campaign.ID,
+		},
+	}
+	suite.genesis.UsedClaimProofs = []types.UsedClaimProof{{CampaignID: campaign.ID, Address: acc1.String()}}
+
+	// partner campaigns records don't count towards the module escrow
+	claims.InitGenesis(suite.ctx, *suite.app.ClaimsKeeper, suite.genesis)
+
+	suite.Require().False(suite.app.ClaimsKeeper.HasClaimsRecord(suite.ctx, acc2))
+	suite.Require().True(suite.app.ClaimsKeeper.HasCampaignClaimsRecord(suite.ctx, campaign.ID, acc2))
+	suite.Require().Equal(campaign.ID+1, suite.app.ClaimsKeeper.GetNextCampaignID(suite.ctx))
+	suite.Require().Len(suite.app.ClaimsKeeper.GetActiveCampaigns(suite.ctx), 1)
+	suite.Require().True(suite.app.ClaimsKeeper.HasUsedClaimProof(suite.ctx, campaign.ID, acc1))
+
+	// the Evmos airdrop campaign is exported along with the partner campaigns
+	evmosCampaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, types.EvmosCampaignID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.genesis.Params.ClaimsDenom, evmosCampaign.Denom)
+	suite.Require().True(evmosCampaign.TotalAllocated.IsZero())
+
+	genesisExported := claims.ExportGenesis(suite.ctx, *suite.app.ClaimsKeeper)
+	suite.Require().Equal(append([]types.Campaign{evmosCampaign}, suite.genesis.Campaigns...), genesisExported.Campaigns)
+	suite.Require().Equal(suite.genesis.ClaimsRecords, genesisExported.ClaimsRecords)
+	suite.Require().Equal(suite.genesis.UsedClaimProofs, genesisExported.UsedClaimProofs)
 }
@@ -59,7 +59,12 @@ func (im IBCMiddleware) OnRecvPacket(
 		return ack
 	}
 
-	return im.keeper.OnRecvPacket(ctx, packet, ack)
+	ack = im.keeper.OnRecvPacket(ctx, packet, ack)
+	if ack.Success() {
+		im.keeper.OnRecvPacketCampaigns(ctx, packet)
+	}
+
+	return ack
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -74,7 +79,12 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
-	return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacketCampaigns(ctx, packet, acknowledgement)
+	return nil
 }
 
 // SendPacket implements the ICS4 Wrapper interface
@@ -20,16 +20,20 @@ import (
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	ethermint "github.com/evmos/ethermint/types"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/claims/types"
 )
 
-// EndBlocker checks if the airdrop claiming period has ended in order to
-// process the clawback of unclaimed tokens
+// EndBlocker checks if the airdrop and partner campaigns claiming periods have
+// ended in order to process the clawback of unclaimed tokens
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.EndCampaigns(ctx)
+
 	params := k.GetParams(ctx)
 
 	// NOTE: ignore end of airdrop period check if claiming is disabled
@@ -91,6 +95,10 @@ func (k Keeper) ClawbackEscrowedTokens(ctx sdk.Context) error {
 		return errorsmod.Wrap(err, "failed to transfer escrowed airdrop tokens")
 	}
 
+	defer func() {
+		evmostelemetry.IncrCoins(types.MetricKeyClawbackAmount, balances)
+	}()
+
 	logger.Info(
 		"clawback of funds to community pool treasury",
 		"total", balances.String(),
@@ -199,6 +207,11 @@ func (k Keeper) ClawbackEmptyAccounts(ctx sdk.Context, claimsDenom string) {
 		k.DeleteClaimsRecord(ctx, addr)
 	}
 
+	defer func() {
+		evmostelemetry.IncrCoins(types.MetricKeyClawbackAmount, totalClawback)
+		telemetry.IncrCounter(float32(accPruned), types.MetricKeyPrunedAccounts...)
+	}()
+
 	logger.Info(
 		"clawed back funds into community pool",
 		"total", totalClawback.String(),
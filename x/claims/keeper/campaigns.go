@@ -0,0 +1,484 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+// GetNextCampaignID returns the identifier of the next partner campaign
+func (k Keeper) GetNextCampaignID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextCampaignID)
+	if len(bz) == 0 {
+		return types.EvmosCampaignID + 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextCampaignID sets the identifier of the next partner campaign
+func (k Keeper) SetNextCampaignID(ctx sdk.Context, campaignID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextCampaignID, types.GetCampaignIDBytes(campaignID))
+}
+
+// GetCampaign returns the campaign for the given identifier
+func (k Keeper) GetCampaign(ctx sdk.Context, campaignID uint64) (types.Campaign, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+	bz := store.Get(types.GetCampaignIDBytes(campaignID))
+	if len(bz) == 0 {
+		return types.Campaign{}, false
+	}
+
+	var campaign types.Campaign
+	k.cdc.MustUnmarshal(bz, &campaign)
+	return campaign, true
+}
+
+// SetCampaign stores a campaign and, for partner campaigns, indexes it as
+// active while it is enabled. The Evmos airdrop isn't indexed as its actions
+// are claimed and merged by the Evmos airdrop hooks and IBC callbacks, and it
+// is ended by EndAirdrop.
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) {
+	idBz := types.GetCampaignIDBytes(campaign.ID)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+	store.Set(idBz, k.cdc.MustMarshal(&campaign))
+
+	activeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixActiveCampaigns)
+	if campaign.Enabled && campaign.ID != types.EvmosCampaignID {
+		activeStore.Set(idBz, []byte{1})
+	} else {
+		activeStore.Delete(idBz)
+	}
+}
+
+// setEvmosCampaign stores the Evmos airdrop campaign (campaign 0) with the
+// denom, schedule and status of the given module parameters, keeping the
+// allocated and claimed totals of the stored campaign.
+func (k Keeper) setEvmosCampaign(ctx sdk.Context, params types.Params) {
+	campaign := types.NewEvmosCampaign(params, k.GetModuleAccountAddress())
+	if stored, found := k.GetCampaign(ctx, types.EvmosCampaignID); found {
+		campaign.TotalAllocated = stored.TotalAllocated
+		campaign.TotalClaimed = stored.TotalClaimed
+	}
+	k.SetCampaign(ctx, campaign)
+}
+
+// setCampaignEscrowAccount sets the escrow account of a partner campaign as a
+// base account. The escrow address is derived from the campaign identifier and
+// is 32 bytes long, so it cannot be stored as an EthAccount: the EVM module
+// exports accounts by their 20-byte Ethereum address.
+func (k Keeper) setCampaignEscrowAccount(ctx sdk.Context, escrowAddr sdk.AccAddress) {
+	acc := k.accountKeeper.GetAccount(ctx, escrowAddr)
+	switch acc.(type) {
+	case *authtypes.BaseAccount:
+		return
+	case nil:
+		acc = k.accountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(escrowAddr))
+	default:
+		// the escrow address was funded before the campaign was created
+		acc = authtypes.NewBaseAccount(escrowAddr, acc.GetPubKey(), acc.GetAccountNumber(), acc.GetSequence())
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+}
+
+// IterateCampaigns iterates over all the campaigns, including the Evmos
+// airdrop, and performs a callback.
+func (k Keeper) IterateCampaigns(ctx sdk.Context, handlerFn func(campaign types.Campaign) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCampaigns)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var campaign types.Campaign
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+
+		if handlerFn(campaign) {
+			break
+		}
+	}
+}
+
+// GetCampaigns returns all the campaigns, including the Evmos airdrop
+func (k Keeper) GetCampaigns(ctx sdk.Context) []types.Campaign {
+	campaigns := []types.Campaign{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) (stop bool) {
+		campaigns = append(campaigns, campaign)
+		return false
+	})
+	return campaigns
+}
+
+// GetActiveCampaigns returns the partner campaigns that haven't ended yet
+func (k Keeper) GetActiveCampaigns(ctx sdk.Context) []types.Campaign {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixActiveCampaigns)
+	defer iterator.Close()
+
+	campaigns := []types.Campaign{}
+	for ; iterator.Valid(); iterator.Next() {
+		campaignID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixActiveCampaigns):])
+		if campaign, found := k.GetCampaign(ctx, campaignID); found {
+			campaigns = append(campaigns, campaign)
+		}
+	}
+	return campaigns
+}
+
+// GetActiveCampaignsCount returns the number of partner campaigns that haven't
+// ended yet
+func (k Keeper) GetActiveCampaignsCount(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixActiveCampaigns)
+	defer iterator.Close()
+
+	count := uint32(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// ClaimCampaignsAction claims the given action for all the active partner
+// campaigns in which the address has a claims record. Failed claims are
+// logged and don't affect the other campaigns.
+func (k Keeper) ClaimCampaignsAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) {
+	for _, campaign := range k.GetActiveCampaigns(ctx) {
+		if !campaign.IsActive(ctx.BlockTime()) || !campaign.HasAction(action) {
+			continue
+		}
+
+		claimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaign.ID, addr)
+		if !found {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.ClaimCampaignCoinsForAction(cacheCtx, campaign, addr, claimsRecord, action); err != nil {
+			k.Logger(ctx).Error(
+				"failed to claim campaign action",
+				"campaign-id", campaign.ID,
+				"address", addr.String(),
+				"action", action.String(),
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		writeCache()
+	}
+}
+
+// ClaimCampaignCoinsForAction removes the claimable amount entry from the
+// claims record of a partner campaign and transfers it from the campaign
+// escrow to the user's account.
+func (k Keeper) ClaimCampaignCoinsForAction(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	addr sdk.AccAddress,
+	claimsRecord types.ClaimsRecord,
+	action types.Action,
+) (math.Int, error) {
+	if !campaign.HasAction(action) {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAction, "%s is not an action of campaign %d", action, campaign.ID)
+	}
+
+	// If we are before the start time, after end time, or the campaign ended, do nothing.
+	if !campaign.IsActive(ctx.BlockTime()) {
+		return sdk.ZeroInt(), nil
+	}
+
+	// if action already completed, nothing is claimable
+	if claimsRecord.HasClaimedAction(action) {
+		return sdk.ZeroInt(), nil
+	}
+
+	claimableAmount, remainderAmount := k.CampaignClaimableAmountForAction(ctx, campaign, claimsRecord, action)
+	if claimableAmount.IsZero() {
+		return sdk.ZeroInt(), nil
+	}
+
+	if err := k.sendCampaignClaim(ctx, campaign, addr, claimableAmount, remainderAmount, action.String()); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	claimsRecord.MarkClaimed(action)
+	k.SetCampaignClaimsRecord(ctx, campaign.ID, addr, claimsRecord)
+
+	return claimableAmount, nil
+}
+
+// ClaimCampaignsContractActions claims the contract actions of all the active
+// partner campaigns in which the address has a claims record and that are
+// completed by the EVM transaction sent to the given recipient. Failed claims
+// are logged and don't affect the other campaigns.
+func (k Keeper) ClaimCampaignsContractActions(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	to *common.Address,
+	receipt *ethtypes.Receipt,
+) {
+	for _, campaign := range k.GetActiveCampaigns(ctx) {
+		if len(campaign.ContractActions) == 0 || !campaign.IsActive(ctx.BlockTime()) {
+			continue
+		}
+
+		claimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaign.ID, addr)
+		if !found {
+			continue
+		}
+
+		for i, contractAction := range campaign.ContractActions {
+			if claimsRecord.HasClaimedContractAction(i) || !contractAction.IsCompletedBy(to, receipt) {
+				continue
+			}
+
+			cacheCtx, writeCache := ctx.CacheContext()
+			if _, err := k.ClaimCampaignCoinsForContractAction(cacheCtx, campaign, addr, claimsRecord, i); err != nil {
+				k.Logger(ctx).Error(
+					"failed to claim campaign contract action",
+					"campaign-id", campaign.ID,
+					"address", addr.String(),
+					"action", contractAction.Name(),
+					"error", err.Error(),
+				)
+				continue
+			}
+
+			writeCache()
+
+			// reload the record as it was updated by the claim
+			claimsRecord, _ = k.GetCampaignClaimsRecord(ctx, campaign.ID, addr)
+		}
+	}
+}
+
+// ClaimCampaignCoinsForContractAction marks the contract action at the given
+// index of the partner campaign as completed and transfers its claimable
+// amount from the campaign escrow to the user's account.
+func (k Keeper) ClaimCampaignCoinsForContractAction(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	addr sdk.AccAddress,
+	claimsRecord types.ClaimsRecord,
+	index int,
+) (math.Int, error) {
+	if index < 0 || index >= len(campaign.ContractActions) {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAction, "contract action %d is not an action of campaign %d", index, campaign.ID)
+	}
+
+	// If we are before the start time, after end time, or the campaign ended, do nothing.
+	if !campaign.IsActive(ctx.BlockTime()) {
+		return sdk.ZeroInt(), nil
+	}
+
+	// if action already completed, nothing is claimable
+	if claimsRecord.HasClaimedContractAction(index) {
+		return sdk.ZeroInt(), nil
+	}
+
+	claimableAmount, remainderAmount := k.CampaignClaimableAmountForContractAction(ctx, campaign, claimsRecord, index)
+	if claimableAmount.IsZero() {
+		return sdk.ZeroInt(), nil
+	}
+
+	if err := k.sendCampaignClaim(ctx, campaign, addr, claimableAmount, remainderAmount, campaign.ContractActions[index].Name()); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	claimsRecord.MarkContractActionClaimed(index)
+	k.SetCampaignClaimsRecord(ctx, campaign.ID, addr, claimsRecord)
+
+	return claimableAmount, nil
+}
+
+// sendCampaignClaim transfers the claimed amount from the campaign escrow to
+// the user's account and the amount lost to the decay to the campaign clawback
+// destination.
+func (k Keeper) sendCampaignClaim(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	addr sdk.AccAddress,
+	claimableAmount,
+	remainderAmount math.Int,
+	actionName string,
+) error {
+	escrowAddr := sdk.MustAccAddressFromBech32(campaign.EscrowAddress)
+	claimedCoins := sdk.Coins{sdk.Coin{Denom: campaign.Denom, Amount: claimableAmount}}
+
+	if err := k.bankKeeper.SendCoins(ctx, escrowAddr, addr, claimedCoins); err != nil {
+		return err
+	}
+
+	// clawback the decayed amount if remainder is not 0
+	if !remainderAmount.IsZero() {
+		remainderCoins := sdk.Coins{sdk.Coin{Denom: campaign.Denom, Amount: remainderAmount}}
+		if err := k.clawbackCampaignCoins(ctx, campaign, remainderCoins); err != nil {
+			return err
+		}
+	}
+
+	// NOTE: read the campaign again as it could have been updated by a
+	// previous claim of the same transaction
+	stored, found := k.GetCampaign(ctx, campaign.ID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCampaignNotFound, "campaign %d", campaign.ID)
+	}
+
+	stored.TotalClaimed = stored.TotalClaimed.Add(claimableAmount).Add(remainderAmount)
+	// NOTE: the Evmos airdrop escrow is checked against its claims records on
+	// InitGenesis and by the claims invariant instead
+	if stored.ID != types.EvmosCampaignID && stored.TotalClaimed.GT(stored.TotalAllocated) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientFunds,
+			"total claimed %s exceeds the total allocated %s of campaign %d", stored.TotalClaimed, stored.TotalAllocated, campaign.ID,
+		)
+	}
+	k.SetCampaign(ctx, stored)
+
+	defer func() {
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(campaign.Denom),
+			evmostelemetry.NewActionLabel(actionName),
+		}
+		evmostelemetry.IncrCounter(types.MetricKeyClaim, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeyClaimAmount, claimableAmount, labels...)
+	}()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyActionType, actionName),
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+		),
+	})
+
+	k.Logger(ctx).Info(
+		"claimed campaign action",
+		"campaign-id", campaign.ID,
+		"address", addr.String(),
+		"action", actionName,
+	)
+
+	return nil
+}
+
+// EndCampaigns ends all the partner campaigns whose claiming period has
+// passed. A campaign that fails to end is logged and retried on the next
+// block.
+func (k Keeper) EndCampaigns(ctx sdk.Context) {
+	for _, campaign := range k.GetActiveCampaigns(ctx) {
+		if !ctx.BlockTime().After(campaign.EndTime()) {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.EndCampaign(cacheCtx, campaign); err != nil {
+			k.Logger(ctx).Error(
+				"failed to end campaign",
+				"campaign-id", campaign.ID,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		writeCache()
+	}
+}
+
+// EndCampaign transfers the remaining escrowed tokens of a partner campaign
+// to its clawback destination, removes the campaign claims records and used
+// claim proofs from state and disables the campaign.
+func (k Keeper) EndCampaign(ctx sdk.Context, campaign types.Campaign) error {
+	escrowAddr := sdk.MustAccAddressFromBech32(campaign.EscrowAddress)
+	balances := k.bankKeeper.GetAllBalances(ctx, escrowAddr)
+
+	if !balances.IsZero() {
+		if err := k.clawbackCampaignCoins(ctx, campaign, balances); err != nil {
+			return errorsmod.Wrap(err, "failed to clawback escrowed campaign tokens")
+		}
+	}
+
+	var addresses []sdk.AccAddress
+	k.IterateCampaignClaimsRecords(ctx, campaign.ID, func(addr sdk.AccAddress, _ types.ClaimsRecord) (stop bool) {
+		addresses = append(addresses, addr)
+		return false
+	})
+
+	for _, addr := range addresses {
+		k.DeleteCampaignClaimsRecord(ctx, campaign.ID, addr)
+	}
+
+	// NOTE: proofs can't be submitted to a disabled campaign
+	k.DeleteUsedClaimProofs(ctx, campaign.ID)
+
+	campaign.Enabled = false
+	k.SetCampaign(ctx, campaign)
+
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyEndCampaign, evmostelemetry.NewDenomLabel(campaign.Denom))
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyClawbackCoins, balances.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"ended campaign",
+		"campaign-id", campaign.ID,
+		"clawback", balances.String(),
+		"records-deleted", len(addresses),
+	)
+
+	return nil
+}
+
+// clawbackCampaignCoins transfers coins from the campaign escrow to the
+// clawback address or, if it's not set, to the community pool
+func (k Keeper) clawbackCampaignCoins(ctx sdk.Context, campaign types.Campaign, coins sdk.Coins) error {
+	escrowAddr := sdk.MustAccAddressFromBech32(campaign.EscrowAddress)
+
+	defer func() {
+		evmostelemetry.IncrCoins(types.MetricKeyClawbackAmount, coins)
+	}()
+
+	if campaign.ClawbackAddress == "" {
+		return k.distrKeeper.FundCommunityPool(ctx, coins, escrowAddr)
+	}
+
+	clawbackAddr := sdk.MustAccAddressFromBech32(campaign.ClawbackAddress)
+	return k.bankKeeper.SendCoins(ctx, escrowAddr, clawbackAddr, coins)
+}
@@ -0,0 +1,633 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/testutil"
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+// fundCampaignCreator funds the creator with the campaign amount and the
+// campaign creation fee
+func (suite *KeeperTestSuite) fundCampaignCreator(creator sdk.AccAddress, amount sdk.Coin) {
+	fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, creator, fee.Add(amount))
+	suite.Require().NoError(err)
+}
+
+// createCampaign funds the creator and creates a partner campaign with the
+// given actions that starts one hour after the current block time
+func (suite *KeeperTestSuite) createCampaign(creator sdk.AccAddress, amount sdk.Coin, actions []types.Action, clawback string) uint64 {
+	suite.fundCampaignCreator(creator, amount)
+
+	res, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateCampaign{
+		Creator:            creator.String(),
+		Name:               "partner airdrop",
+		Amount:             amount,
+		StartTime:          suite.ctx.BlockTime().Add(time.Hour),
+		DurationUntilDecay: time.Hour,
+		DurationOfDecay:    time.Hour,
+		Actions:            actions,
+		ClawbackAddress:    clawback,
+	})
+	suite.Require().NoError(err)
+	return res.CampaignID
+}
+
+func (suite *KeeperTestSuite) TestCreateCampaign() {
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin("atest", sdk.NewInt(1000))
+
+	testCases := []struct {
+		name      string
+		malleate  func(msg *types.MsgCreateCampaign)
+		fund      bool
+		expectErr bool
+	}{
+		{
+			"fail - start time not after block time",
+			func(msg *types.MsgCreateCampaign) {
+				msg.StartTime = suite.ctx.BlockTime()
+			},
+			true,
+			true,
+		},
+		{
+			"fail - creator has insufficient funds",
+			func(*types.MsgCreateCampaign) {},
+			false,
+			true,
+		},
+		{
+			"fail - maximum number of active campaigns reached",
+			func(*types.MsgCreateCampaign) {
+				params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+				params.MaxActiveCampaigns = 1
+				suite.Require().NoError(suite.app.ClaimsKeeper.SetParams(suite.ctx, params))
+				suite.createCampaign(sdk.AccAddress(tests.GenerateAddress().Bytes()), amount, []types.Action{types.ActionVote}, "")
+			},
+			true,
+			true,
+		},
+		{
+			"pass - campaign created and escrowed",
+			func(*types.MsgCreateCampaign) {},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.fund {
+				suite.fundCampaignCreator(creator, amount)
+			}
+
+			msg := &types.MsgCreateCampaign{
+				Creator:            creator.String(),
+				Name:               "partner airdrop",
+				Amount:             amount,
+				StartTime:          suite.ctx.BlockTime().Add(time.Hour),
+				DurationUntilDecay: time.Hour,
+				DurationOfDecay:    time.Hour,
+				Actions:            []types.Action{types.ActionVote},
+			}
+			tc.malleate(msg)
+
+			res, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.CampaignID)
+
+			// the creation fee is paid to the community pool
+			fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+			suite.Require().True(communityPool.AmountOf(types.DefaultClaimsDenom).GTE(sdk.NewDecFromInt(fee.AmountOf(types.DefaultClaimsDenom))))
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, creator, types.DefaultClaimsDenom).IsZero())
+			suite.Require().Equal(uint64(2), suite.app.ClaimsKeeper.GetNextCampaignID(suite.ctx))
+
+			campaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, res.CampaignID)
+			suite.Require().True(found)
+			suite.Require().True(campaign.Enabled)
+			suite.Require().Equal(creator.String(), campaign.Creator)
+			suite.Require().Equal(types.GetCampaignEscrowAddress(res.CampaignID).String(), campaign.EscrowAddress)
+
+			escrowBalance := suite.app.BankKeeper.GetBalance(suite.ctx, types.GetCampaignEscrowAddress(res.CampaignID), amount.Denom)
+			suite.Require().Equal(amount, escrowBalance)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, creator, amount.Denom).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateCampaignEscrowAccount() {
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin("atest", sdk.NewInt(1000))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"escrow account not set",
+			func() {},
+		},
+		{
+			"escrow address funded before the campaign",
+			func() {
+				escrowAddr := types.GetCampaignEscrowAddress(suite.app.ClaimsKeeper.GetNextCampaignID(suite.ctx))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, escrowAddr, sdk.Coins{amount})
+				suite.Require().NoError(err)
+				_, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, escrowAddr).(*authtypes.BaseAccount)
+				suite.Require().False(ok)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			campaignID := suite.createCampaign(creator, amount, []types.Action{types.ActionVote}, "")
+
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, types.GetCampaignEscrowAddress(campaignID))
+			suite.Require().IsType(&authtypes.BaseAccount{}, acc)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAddClaimsRecords() {
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin("atest", sdk.NewInt(1000))
+
+	var campaignID uint64
+
+	testCases := []struct {
+		name      string
+		malleate  func() *types.MsgAddClaimsRecords
+		expectErr bool
+	}{
+		{
+			"fail - campaign not found",
+			func() *types.MsgAddClaimsRecords {
+				return &types.MsgAddClaimsRecords{
+					Creator:     creator.String(),
+					CampaignID:  campaignID + 1,
+					Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(100)}},
+				}
+			},
+			true,
+		},
+		{
+			"fail - not the campaign creator",
+			func() *types.MsgAddClaimsRecords {
+				return &types.MsgAddClaimsRecords{
+					Creator:     recipient.String(),
+					CampaignID:  campaignID,
+					Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(100)}},
+				}
+			},
+			true,
+		},
+		{
+			"fail - allocations exceed the escrowed funds",
+			func() *types.MsgAddClaimsRecords {
+				return &types.MsgAddClaimsRecords{
+					Creator:     creator.String(),
+					CampaignID:  campaignID,
+					Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(1001)}},
+				}
+			},
+			true,
+		},
+		{
+			"fail - claims record already exists",
+			func() *types.MsgAddClaimsRecords {
+				suite.app.ClaimsKeeper.SetCampaignClaimsRecord(suite.ctx, campaignID, recipient, types.NewClaimsRecord(sdk.NewInt(100)))
+				return &types.MsgAddClaimsRecords{
+					Creator:     creator.String(),
+					CampaignID:  campaignID,
+					Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(100)}},
+				}
+			},
+			true,
+		},
+		{
+			"fail - campaign already started",
+			func() *types.MsgAddClaimsRecords {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+				return &types.MsgAddClaimsRecords{
+					Creator:     creator.String(),
+					CampaignID:  campaignID,
+					Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(100)}},
+				}
+			},
+			true,
+		},
+		{
+			"pass - claims records added",
+			func() *types.MsgAddClaimsRecords {
+				return &types.MsgAddClaimsRecords{
+					Creator:     creator.String(),
+					CampaignID:  campaignID,
+					Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(1000)}},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			campaignID = suite.createCampaign(creator, amount, []types.Action{types.ActionVote}, "")
+
+			msg := tc.malleate()
+			_, err := suite.app.ClaimsKeeper.AddClaimsRecords(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			record, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, campaignID, recipient)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewInt(1000), record.InitialClaimableAmount)
+			suite.Require().False(suite.app.ClaimsKeeper.HasClaimsRecord(suite.ctx, recipient))
+
+			campaign, _ := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaignID)
+			suite.Require().Equal(sdk.NewInt(1000), campaign.TotalAllocated)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimCampaignsAction() {
+	suite.SetupTest()
+
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin("atest", sdk.NewInt(1000))
+
+	campaignID := suite.createCampaign(creator, amount, []types.Action{types.ActionVote, types.ActionDelegate}, "")
+	_, err := suite.app.ClaimsKeeper.AddClaimsRecords(sdk.WrapSDKContext(suite.ctx), &types.MsgAddClaimsRecords{
+		Creator:     creator.String(),
+		CampaignID:  campaignID,
+		Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(1000)}},
+	})
+	suite.Require().NoError(err)
+
+	// no-op before the campaign starts
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, recipient)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).IsZero())
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+
+	// the EVM action doesn't qualify for the campaign
+	suite.app.ClaimsKeeper.ClaimCampaignsAction(suite.ctx, recipient, types.ActionEVM)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).IsZero())
+
+	// the amount is split between the two campaign actions
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, recipient)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).Amount)
+
+	// the action can only be claimed once
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, recipient)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).Amount)
+
+	record, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, campaignID, recipient)
+	suite.Require().True(found)
+	suite.Require().True(record.HasClaimedAction(types.ActionVote))
+	suite.Require().False(record.HasClaimedAction(types.ActionDelegate))
+
+	res, err := suite.queryClient.ClaimsRecord(sdk.WrapSDKContext(suite.ctx), &types.QueryClaimsRecordRequest{
+		Address:    recipient.String(),
+		CampaignID: campaignID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Claims, 2)
+	suite.Require().True(res.Claims[0].Completed)
+	suite.Require().Equal(sdk.NewInt(500), res.Claims[1].ClaimableAmount)
+}
+
+func (suite *KeeperTestSuite) TestEndCampaigns() {
+	suite.SetupTest()
+
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	clawback := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin("atest", sdk.NewInt(1000))
+
+	campaignID := suite.createCampaign(creator, amount, []types.Action{types.ActionVote}, clawback.String())
+	_, err := suite.app.ClaimsKeeper.AddClaimsRecords(sdk.WrapSDKContext(suite.ctx), &types.MsgAddClaimsRecords{
+		Creator:     creator.String(),
+		CampaignID:  campaignID,
+		Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(400)}},
+	})
+	suite.Require().NoError(err)
+
+	campaign, _ := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaignID)
+
+	// no-op while the campaign is active
+	suite.ctx = suite.ctx.WithBlockTime(campaign.EndTime())
+	suite.app.ClaimsKeeper.EndCampaigns(suite.ctx)
+	suite.Require().Len(suite.app.ClaimsKeeper.GetActiveCampaigns(suite.ctx), 1)
+
+	suite.ctx = suite.ctx.WithBlockTime(campaign.EndTime().Add(time.Second))
+	suite.app.ClaimsKeeper.EndCampaigns(suite.ctx)
+
+	campaign, _ = suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaignID)
+	suite.Require().False(campaign.Enabled)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetActiveCampaigns(suite.ctx))
+	suite.Require().False(suite.app.ClaimsKeeper.HasCampaignClaimsRecord(suite.ctx, campaignID, recipient))
+	suite.Require().Equal(amount, suite.app.BankKeeper.GetBalance(suite.ctx, clawback, amount.Denom))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, types.GetCampaignEscrowAddress(campaignID), amount.Denom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestQueryCampaigns() {
+	suite.SetupTest()
+
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	campaignID := suite.createCampaign(creator, sdk.NewCoin("atest", sdk.NewInt(1000)), []types.Action{types.ActionEVM}, "")
+
+	res, err := suite.queryClient.Campaigns(sdk.WrapSDKContext(suite.ctx), &types.QueryCampaignsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Campaigns, 2)
+	suite.Require().Equal(types.EvmosCampaignID, res.Campaigns[0].ID)
+	suite.Require().Equal(campaignID, res.Campaigns[1].ID)
+
+	campaignRes, err := suite.queryClient.Campaign(sdk.WrapSDKContext(suite.ctx), &types.QueryCampaignRequest{CampaignId: campaignID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Campaigns[1], campaignRes.Campaign)
+
+	_, err = suite.queryClient.Campaign(sdk.WrapSDKContext(suite.ctx), &types.QueryCampaignRequest{CampaignId: campaignID + 1})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestClaimCampaignsContractActions() {
+	suite.SetupTest()
+
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	from := tests.GenerateAddress()
+	recipient := sdk.AccAddress(from.Bytes())
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	amount := sdk.NewCoin("atest", sdk.NewInt(900))
+
+	transferAction := types.NewContractAction(contract, "Transfer(address,address,uint256)")
+
+	suite.fundCampaignCreator(creator, amount)
+
+	res, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateCampaign{
+		Creator:            creator.String(),
+		Name:               "dApp airdrop",
+		Amount:             amount,
+		StartTime:          suite.ctx.BlockTime().Add(time.Hour),
+		DurationUntilDecay: time.Hour,
+		DurationOfDecay:    time.Hour,
+		Actions:            []types.Action{types.ActionVote},
+		ContractActions:    []types.ContractAction{types.NewContractAction(contract, ""), transferAction},
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.ClaimsKeeper.AddClaimsRecords(sdk.WrapSDKContext(suite.ctx), &types.MsgAddClaimsRecords{
+		Creator:     creator.String(),
+		CampaignID:  res.CampaignID,
+		Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(900)}},
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+
+	// a tx to another contract doesn't complete any contract action
+	msg := ethtypes.NewMessage(from, &other, 0, nil, 0, nil, nil, nil, nil, nil, false)
+	err = suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).IsZero())
+
+	// a tx to the contract without the event completes only the first action
+	msg = ethtypes.NewMessage(from, &contract, 0, nil, 0, nil, nil, nil, nil, nil, false)
+	err = suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(300), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).Amount)
+
+	// the event emitted by the contract completes the second action
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{{Address: contract, Topics: []common.Hash{transferAction.EventTopic()}}},
+	}
+	err = suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(600), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).Amount)
+
+	record, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, res.CampaignID, recipient)
+	suite.Require().True(found)
+	suite.Require().Equal([]bool{true, true}, record.ContractActionsCompleted)
+	suite.Require().False(record.HasClaimedAction(types.ActionVote))
+
+	queryRes, err := suite.queryClient.ClaimsRecord(sdk.WrapSDKContext(suite.ctx), &types.QueryClaimsRecordRequest{
+		Address:    recipient.String(),
+		CampaignID: res.CampaignID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.ContractClaims, 2)
+	suite.Require().True(queryRes.ContractClaims[1].Completed)
+	suite.Require().Equal(sdk.NewInt(300), queryRes.Claims[0].ClaimableAmount)
+}
+
+func (suite *KeeperTestSuite) TestSubmitClaimProof() {
+	suite.SetupTest()
+
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin("atest", sdk.NewInt(1000))
+
+	leaves := [][]byte{
+		types.MerkleLeaf(recipient, sdk.NewInt(600)),
+		types.MerkleLeaf(other, sdk.NewInt(400)),
+	}
+	root := types.MerkleRoot(leaves)
+
+	suite.fundCampaignCreator(creator, amount)
+
+	createRes, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateCampaign{
+		Creator:            creator.String(),
+		Name:               "merkle airdrop",
+		Amount:             amount,
+		StartTime:          suite.ctx.BlockTime().Add(time.Hour),
+		DurationUntilDecay: time.Hour,
+		DurationOfDecay:    time.Hour,
+		Actions:            []types.Action{types.ActionDelegate},
+		MerkleRoot:         root,
+		MerkleTotal:        sdk.NewInt(1000),
+	})
+	suite.Require().NoError(err)
+	campaignID := createRes.CampaignID
+
+	campaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaignID)
+	suite.Require().True(found)
+	suite.Require().True(campaign.IsMerkle())
+	suite.Require().Equal(sdk.NewInt(1000), campaign.TotalAllocated)
+
+	// claims records of merkle campaigns can't be added by the creator
+	_, err = suite.app.ClaimsKeeper.AddClaimsRecords(sdk.WrapSDKContext(suite.ctx), &types.MsgAddClaimsRecords{
+		Creator:     creator.String(),
+		CampaignID:  campaignID,
+		Allocations: []types.ClaimsAllocation{{Address: recipient.String(), Amount: sdk.NewInt(100)}},
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidCampaign)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	for _, addr := range []sdk.AccAddress{recipient, other} {
+		err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(100))))
+		suite.Require().NoError(err)
+	}
+
+	// newClaimProofMsg returns a claim proof submitted with a delegation of
+	// the sender
+	newClaimProofMsg := func(sender sdk.AccAddress, campaignID uint64, amount math.Int, index int) *types.MsgSubmitClaimProof {
+		delegateMsg := stakingtypes.NewMsgDelegate(sender, suite.validator.GetOperator(), sdk.NewCoin(bondDenom, sdk.NewInt(10)))
+		msg, err := types.NewMsgSubmitClaimProof(sender, campaignID, amount, types.MerkleProof(leaves, index), []sdk.Msg{delegateMsg})
+		suite.Require().NoError(err)
+		return msg
+	}
+
+	// newClaimProofMsgWithActions returns a claim proof of the recipient
+	// submitted with the given messages, skipping the message validation as
+	// done for the messages nested in an authz MsgExec
+	newClaimProofMsgWithActions := func(msgs ...sdk.Msg) *types.MsgSubmitClaimProof {
+		msg, err := types.NewMsgSubmitClaimProof(recipient, campaignID, sdk.NewInt(600), types.MerkleProof(leaves, 0), msgs)
+		suite.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSubmitClaimProof
+		expErr error
+	}{
+		{
+			"fail - claiming action signed by another account",
+			newClaimProofMsgWithActions(
+				stakingtypes.NewMsgDelegate(other, suite.validator.GetOperator(), sdk.NewCoin(bondDenom, sdk.NewInt(10))),
+			),
+			errortypes.ErrUnauthorized,
+		},
+		{
+			"fail - not a claiming action message",
+			newClaimProofMsgWithActions(
+				banktypes.NewMsgSend(other, creator, sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(10)))),
+			),
+			errortypes.ErrInvalidRequest,
+		},
+		{
+			"fail - no claiming action message",
+			newClaimProofMsgWithActions(),
+			errortypes.ErrInvalidRequest,
+		},
+		{
+			"fail - campaign not found",
+			newClaimProofMsg(recipient, 100, sdk.NewInt(600), 0),
+			types.ErrCampaignNotFound,
+		},
+		{
+			"fail - wrong amount",
+			newClaimProofMsg(recipient, campaignID, sdk.NewInt(1000), 0),
+			types.ErrInvalidProof,
+		},
+		{
+			"fail - proof of another recipient",
+			newClaimProofMsg(recipient, campaignID, sdk.NewInt(400), 1),
+			types.ErrInvalidProof,
+		},
+		{
+			"pass - the delegation claims the record",
+			newClaimProofMsg(recipient, campaignID, sdk.NewInt(600), 0),
+			nil,
+		},
+		{
+			"fail - proof already submitted",
+			newClaimProofMsg(recipient, campaignID, sdk.NewInt(600), 0),
+			types.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := suite.app.ClaimsKeeper.SubmitClaimProof(sdk.WrapSDKContext(suite.ctx), tc.msg)
+		if tc.expErr != nil {
+			suite.Require().ErrorIs(err, tc.expErr, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	record, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, campaignID, recipient)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(600), record.InitialClaimableAmount)
+	suite.Require().True(record.ActionsCompleted[types.ActionDelegate-1])
+	suite.Require().Equal(sdk.NewInt(600), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).Amount)
+
+	// the messages rejected with the proof did not move the funds of the other account
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, other, bondDenom).Amount)
+
+	campaign, _ = suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaignID)
+	suite.Require().Equal(sdk.NewInt(600), campaign.TotalClaimed)
+
+	_, broken := suite.app.ClaimsKeeper.CampaignsInvariant()(suite.ctx)
+	suite.Require().False(broken)
+
+	// the proof can't be submitted again once the claims record is transferred
+	_, err = suite.app.ClaimsKeeper.TransferClaimsRecord(sdk.WrapSDKContext(suite.ctx), &types.MsgTransferClaimsRecord{
+		Sender: recipient.String(), Recipient: other.String(), CampaignID: campaignID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.ClaimsKeeper.HasCampaignClaimsRecord(suite.ctx, campaignID, recipient))
+	suite.Require().True(suite.app.ClaimsKeeper.HasUsedClaimProof(suite.ctx, campaignID, recipient))
+
+	_, err = suite.app.ClaimsKeeper.SubmitClaimProof(sdk.WrapSDKContext(suite.ctx), newClaimProofMsg(recipient, campaignID, sdk.NewInt(600), 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidProof)
+
+	// the proof of the transfer recipient is merged with the transferred record
+	_, err = suite.app.ClaimsKeeper.SubmitClaimProof(sdk.WrapSDKContext(suite.ctx), newClaimProofMsg(other, campaignID, sdk.NewInt(400), 1))
+	suite.Require().NoError(err)
+
+	record, found = suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, campaignID, other)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000), record.InitialClaimableAmount)
+	suite.Require().True(record.ActionsCompleted[types.ActionDelegate-1])
+	suite.Require().Equal(sdk.NewInt(400), suite.app.BankKeeper.GetBalance(suite.ctx, other, amount.Denom).Amount)
+
+	campaign, _ = suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaignID)
+	suite.Require().Equal(sdk.NewInt(1000), campaign.TotalClaimed)
+
+	_, broken = suite.app.ClaimsKeeper.CampaignsInvariant()(suite.ctx)
+	suite.Require().False(broken)
+
+	// the proof can't be submitted once the campaign has ended
+	suite.ctx = suite.ctx.WithBlockTime(campaign.EndTime().Add(time.Second))
+	_, err = suite.app.ClaimsKeeper.SubmitClaimProof(sdk.WrapSDKContext(suite.ctx), newClaimProofMsg(creator, campaignID, sdk.NewInt(400), 1))
+	suite.Require().ErrorIs(err, types.ErrInvalidCampaign)
+
+	// the used claim proofs are deleted with the campaign claims records
+	suite.app.ClaimsKeeper.EndCampaigns(suite.ctx)
+	suite.Require().False(suite.app.ClaimsKeeper.HasUsedClaimProof(suite.ctx, campaignID, recipient))
+}
@@ -17,79 +17,32 @@
 package keeper
 
 import (
+	"strconv"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/claims/types"
 )
 
-// ClaimCoinsForAction removes the claimable amount entry from a claims record
-// and transfers it to the user's account
+// ClaimCoinsForAction claims the action of the Evmos airdrop (campaign 0)
+// claims record and transfers the claimable amount from the module account to
+// the user's account
 func (k Keeper) ClaimCoinsForAction(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
 	claimsRecord types.ClaimsRecord,
 	action types.Action,
-	params types.Params,
 ) (math.Int, error) {
-	if action == types.ActionUnspecified || action > types.ActionIBCTransfer {
-		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAction, "%d", action)
-	}
-
-	// If we are before the start time, after end time, or claims are disabled, do nothing.
-	if !params.IsClaimsActive(ctx.BlockTime()) {
-		return sdk.ZeroInt(), nil
-	}
-
-	// if action already completed, nothing is claimable
-	if claimsRecord.HasClaimedAction(action) {
-		return sdk.ZeroInt(), nil
-	}
-
-	claimableAmount, remainderAmount := k.GetClaimableAmountForAction(ctx, claimsRecord, action, params)
-
-	if claimableAmount.IsZero() {
-		return sdk.ZeroInt(), nil
-	}
-
-	claimedCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: claimableAmount}}
-	remainderCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: remainderAmount}}
-
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, claimedCoins); err != nil {
-		return sdk.ZeroInt(), err
+	campaign, found := k.GetCampaign(ctx, types.EvmosCampaignID)
+	if !found {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrCampaignNotFound, "campaign %d", types.EvmosCampaignID)
 	}
 
-	// fund community pool if remainder is not 0
-	if !remainderAmount.IsZero() {
-		escrowAddr := k.GetModuleAccountAddress()
-
-		if err := k.distrKeeper.FundCommunityPool(ctx, remainderCoins, escrowAddr); err != nil {
-			return sdk.ZeroInt(), err
-		}
-	}
-
-	claimsRecord.MarkClaimed(action)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, claimedCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyActionType, action.String()),
-		),
-	})
-
-	k.SetClaimsRecord(ctx, addr, claimsRecord)
-
-	k.Logger(ctx).Info(
-		"claimed action",
-		"address", addr.String(),
-		"action", action.String(),
-	)
-
-	return claimableAmount, nil
+	return k.ClaimCampaignCoinsForAction(ctx, campaign, addr, claimsRecord, action)
 }
 
 // MergeClaimsRecords merges two independent claims records (sender and
@@ -104,126 +57,267 @@ func (k Keeper) MergeClaimsRecords(
 	recipient sdk.AccAddress,
 	senderClaimsRecord,
 	recipientClaimsRecord types.ClaimsRecord,
-	params types.Params,
 ) (mergedRecord types.ClaimsRecord, err error) {
-	claimedAmt := sdk.ZeroInt()
-	remainderAmt := sdk.ZeroInt()
+	// Safety check: the sender record cannot have any claimed actions, as
+	//  - the sender is not an evmos address and can't claim vote, delegation or evm actions
+	//  - the first attempt to perform an ibc callback from the senders account will merge/migrate the entire claims record
+	for _, action := range []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer} {
+		if senderClaimsRecord.HasClaimedAction(action) {
+			return types.ClaimsRecord{}, errorsmod.Wrapf(errortypes.ErrNotSupported, "non-evmos sender must not have claimed action: %v", action)
+		}
+	}
+
+	campaign, found := k.GetCampaign(ctx, types.EvmosCampaignID)
+	if !found {
+		return types.ClaimsRecord{}, errorsmod.Wrapf(types.ErrCampaignNotFound, "campaign %d", types.EvmosCampaignID)
+	}
+
+	mergedRecord, claimedAmt, remainderAmt := k.mergeClaimsRecords(ctx, campaign, senderClaimsRecord, recipientClaimsRecord, true)
+
+	// safety check to prevent error while sending coins from the module escrow balance to the recipient
+	if claimedAmt.IsZero() {
+		return mergedRecord, nil
+	}
+
+	if err := k.sendMergedClaim(ctx, campaign, recipient, claimedAmt, remainderAmt); err != nil {
+		return types.ClaimsRecord{}, err
+	}
+
+	return mergedRecord, nil
+}
+
+// mergeClaimsRecords merges two claims records of the same campaign into a new
+// instance by summing up their initial claimable amounts. An action completed
+// by only one of the records is marked as completed on the merged record and
+// the amount of the other record for that action is claimed. If claimIBC is
+// true, the IBC transfer action is claimed for both records.
+func (k Keeper) mergeClaimsRecords(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	senderClaimsRecord,
+	recipientClaimsRecord types.ClaimsRecord,
+	claimIBC bool,
+) (mergedRecord types.ClaimsRecord, claimedAmt, remainderAmt math.Int) {
+	claimedAmt = sdk.ZeroInt()
+	remainderAmt = sdk.ZeroInt()
 
 	// new total is the sum of the sender and recipient claims records amounts
 	totalClaimableAmt := senderClaimsRecord.InitialClaimableAmount.Add(recipientClaimsRecord.InitialClaimableAmount)
-	mergedRecord = types.NewClaimsRecord(totalClaimableAmt)
+	mergedRecord = types.NewCampaignClaimsRecord(campaign, totalClaimableAmt)
 
 	// iterate over all the available actions and claim the amount if
 	// the recipient or sender has completed an action but the other hasn't
 	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
 	for _, action := range actions {
-
-		// Safety check: the sender record cannot have any claimed actions, as
-		//  - the sender is not an evmos address and can't claim vote, delegation or evm actions
-		//  - the first attempt to perform an ibc callback from the senders account will merge/migrate the entire claims record
-		if senderClaimsRecord.HasClaimedAction(action) {
-			return types.ClaimsRecord{}, errorsmod.Wrapf(errortypes.ErrNotSupported, "non-evmos sender must not have claimed action: %v", action)
-		}
-
+		senderCompleted := senderClaimsRecord.HasClaimedAction(action)
 		recipientCompleted := recipientClaimsRecord.HasClaimedAction(action)
 
-		if recipientCompleted {
+		switch {
+		case senderCompleted && recipientCompleted:
+			mergedRecord.MarkClaimed(action)
+		case recipientCompleted:
 			// claim action for sender since the recipient completed it
-			amt, remainder := k.GetClaimableAmountForAction(ctx, senderClaimsRecord, action, params)
+			amt, remainder := k.CampaignClaimableAmountForAction(ctx, campaign, senderClaimsRecord, action)
 			claimedAmt = claimedAmt.Add(amt)
 			remainderAmt = remainderAmt.Add(remainder)
 			mergedRecord.MarkClaimed(action)
-		} else {
-			// Neither sender or recipient completed the action.
-			if action != types.ActionIBCTransfer {
-				// No-op if the action is not IBC transfer
-				continue
-			}
-
+		case senderCompleted:
+			// claim action for recipient since the sender completed it
+			amt, remainder := k.CampaignClaimableAmountForAction(ctx, campaign, recipientClaimsRecord, action)
+			claimedAmt = claimedAmt.Add(amt)
+			remainderAmt = remainderAmt.Add(remainder)
+			mergedRecord.MarkClaimed(action)
+		case claimIBC && action == types.ActionIBCTransfer:
 			// claim IBC action for both sender and recipient
-			amtIBCRecipient, remainderRecipient := k.GetClaimableAmountForAction(ctx, recipientClaimsRecord, action, params)
-			amtIBCSender, remainderSender := k.GetClaimableAmountForAction(ctx, senderClaimsRecord, action, params)
+			amtIBCRecipient, remainderRecipient := k.CampaignClaimableAmountForAction(ctx, campaign, recipientClaimsRecord, action)
+			amtIBCSender, remainderSender := k.CampaignClaimableAmountForAction(ctx, campaign, senderClaimsRecord, action)
 			claimedAmt = claimedAmt.Add(amtIBCRecipient).Add(amtIBCSender)
 			remainderAmt = remainderAmt.Add(remainderRecipient).Add(remainderSender)
 			mergedRecord.MarkClaimed(action)
 		}
 	}
 
-	// safety check to prevent error while sending coins from the module escrow balance to the recipient
-	if claimedAmt.IsZero() {
-		return mergedRecord, nil
+	// apply the same bookkeeping to the contract actions of the campaign
+	for i := range campaign.ContractActions {
+		senderCompleted := senderClaimsRecord.HasClaimedContractAction(i)
+		recipientCompleted := recipientClaimsRecord.HasClaimedContractAction(i)
+
+		switch {
+		case senderCompleted && recipientCompleted:
+			mergedRecord.MarkContractActionClaimed(i)
+		case recipientCompleted:
+			amt, remainder := k.CampaignClaimableAmountForContractAction(ctx, campaign, senderClaimsRecord, i)
+			claimedAmt = claimedAmt.Add(amt)
+			remainderAmt = remainderAmt.Add(remainder)
+			mergedRecord.MarkContractActionClaimed(i)
+		case senderCompleted:
+			amt, remainder := k.CampaignClaimableAmountForContractAction(ctx, campaign, recipientClaimsRecord, i)
+			claimedAmt = claimedAmt.Add(amt)
+			remainderAmt = remainderAmt.Add(remainder)
+			mergedRecord.MarkContractActionClaimed(i)
+		}
 	}
 
-	claimedCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: claimedAmt}}
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, claimedCoins); err != nil {
-		return types.ClaimsRecord{}, err
+	return mergedRecord, claimedAmt, remainderAmt
+}
+
+// TransferCampaignClaimsRecord moves the claims record of the sender on the
+// given campaign to the recipient. If the recipient already has a claims record,
+// both records are merged and the actions completed by only one of them are
+// claimed for the other one and transferred to the recipient. On Merkle
+// campaigns, the proof of the sender allocation is marked as used so that the
+// sender can't recreate its claims record after the transfer.
+func (k Keeper) TransferCampaignClaimsRecord(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	sender,
+	recipient sdk.AccAddress,
+) (types.ClaimsRecord, error) {
+	senderClaimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaign.ID, sender)
+	if !found {
+		return types.ClaimsRecord{}, errorsmod.Wrapf(types.ErrClaimsRecordNotFound, "address %s, campaign %d", sender, campaign.ID)
 	}
 
-	remainderCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: remainderAmt}}
-	// short-circuit: don't fund community pool if remainder is 0
-	if remainderCoins.IsZero() {
-		return mergedRecord, nil
+	// NOTE: the proof is already marked as used when the record was created
+	// with it, this covers the records imported without their used proof
+	if campaign.IsMerkle() {
+		k.SetUsedClaimProof(ctx, campaign.ID, sender)
 	}
 
-	escrowAddr := k.GetModuleAccountAddress()
-	if err := k.distrKeeper.FundCommunityPool(ctx, remainderCoins, escrowAddr); err != nil {
-		return types.ClaimsRecord{}, err
+	recipientClaimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaign.ID, recipient)
+	if !found {
+		// migrate the sender record to the recipient address
+		k.SetCampaignClaimsRecord(ctx, campaign.ID, recipient, senderClaimsRecord)
+		k.DeleteCampaignClaimsRecord(ctx, campaign.ID, sender)
+		return senderClaimsRecord, nil
 	}
 
+	mergedRecord, claimedAmt, remainderAmt := k.mergeClaimsRecords(ctx, campaign, senderClaimsRecord, recipientClaimsRecord, false)
+
+	// safety check to prevent error while sending coins from the escrow balance to the recipient
+	if !claimedAmt.IsZero() {
+		if err := k.sendMergedClaim(ctx, campaign, recipient, claimedAmt, remainderAmt); err != nil {
+			return types.ClaimsRecord{}, err
+		}
+	}
+
+	k.SetCampaignClaimsRecord(ctx, campaign.ID, recipient, mergedRecord)
+	k.DeleteCampaignClaimsRecord(ctx, campaign.ID, sender)
+
+	return mergedRecord, nil
+}
+
+// sendMergedClaim transfers the amount claimed while merging two claims
+// records from the campaign escrow to the recipient, and the amount lost to
+// the decay to the campaign clawback destination.
+func (k Keeper) sendMergedClaim(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	recipient sdk.AccAddress,
+	claimedAmt,
+	remainderAmt math.Int,
+) error {
+	if err := k.sendCampaignClaim(ctx, campaign, recipient, claimedAmt, remainderAmt, types.EventTypeMergeClaimsRecords); err != nil {
+		return err
+	}
+
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyMergeClaimsRecords, evmostelemetry.NewDenomLabel(campaign.Denom))
+	}()
+
+	claimedCoins := sdk.Coins{sdk.Coin{Denom: campaign.Denom, Amount: claimedAmt}}
+	remainderCoins := sdk.Coins{sdk.Coin{Denom: campaign.Denom, Amount: remainderAmt}}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeMergeClaimsRecords,
 			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimedCoins, claimedCoins.String()),
 			sdk.NewAttribute(types.AttributeKeyFundCommunityPoolCoins, remainderCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
 		),
 	})
 
-	return mergedRecord, nil
+	return nil
 }
 
-// GetClaimableAmountForAction returns claimable amount for a specific action
-// done by an address
+// GetClaimableAmountForAction returns claimable amount of the Evmos airdrop
+// (campaign 0) for a specific action done by an address
 // returns zero if airdrop didn't start, isn't enabled or has finished
 func (k Keeper) GetClaimableAmountForAction(
 	ctx sdk.Context,
 	claimsRecord types.ClaimsRecord,
 	action types.Action,
-	params types.Params,
 ) (claimableCoins, remainder math.Int) {
 	// check if the entire airdrop has completed. This shouldn't occur since at
 	// the end of the airdrop, the EnableClaims param is disabled.
-	if !params.IsClaimsActive(ctx.BlockTime()) {
+	campaign, found := k.GetCampaign(ctx, types.EvmosCampaignID)
+	if !found || !campaign.IsActive(ctx.BlockTime()) {
 		return sdk.ZeroInt(), sdk.ZeroInt()
 	}
 
-	return k.ClaimableAmountForAction(ctx, claimsRecord, action, params)
+	return k.CampaignClaimableAmountForAction(ctx, campaign, claimsRecord, action)
 }
 
-// ClaimableAmountForAction returns claimable amount for a specific action
-// done by an address
-func (k Keeper) ClaimableAmountForAction(
+// CampaignClaimableAmountForAction returns claimable amount of a campaign for
+// a specific action done by an address.
+func (k Keeper) CampaignClaimableAmountForAction(
 	ctx sdk.Context,
+	campaign types.Campaign,
 	claimsRecord types.ClaimsRecord,
 	action types.Action,
-	params types.Params,
 ) (claimableCoins, remainder math.Int) {
 	// return zero if there are no coins to claim
 	if claimsRecord.InitialClaimableAmount.IsNil() || claimsRecord.InitialClaimableAmount.IsZero() {
 		return sdk.ZeroInt(), sdk.ZeroInt()
 	}
 
-	// check if action already completed
-	if claimsRecord.HasClaimedAction(action) {
+	// check if the action qualifies for the campaign or was already completed
+	if !campaign.HasAction(action) || claimsRecord.HasClaimedAction(action) {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	return k.campaignClaimableAmount(ctx, campaign, claimsRecord.InitialClaimableAmount)
+}
+
+// CampaignClaimableAmountForContractAction returns claimable amount of a
+// campaign for the contract action at the given index done by an address.
+func (k Keeper) CampaignClaimableAmountForContractAction(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	claimsRecord types.ClaimsRecord,
+	index int,
+) (claimableCoins, remainder math.Int) {
+	// return zero if there are no coins to claim
+	if claimsRecord.InitialClaimableAmount.IsNil() || claimsRecord.InitialClaimableAmount.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	// check if the contract action exists or was already completed
+	if index < 0 || index >= len(campaign.ContractActions) || claimsRecord.HasClaimedContractAction(index) {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	return k.campaignClaimableAmount(ctx, campaign, claimsRecord.InitialClaimableAmount)
+}
+
+// campaignClaimableAmount returns the claimable amount of a single campaign
+// action, given the initial claimable amount of the claims record. The initial
+// claimable amount is split evenly between all the campaign actions.
+func (k Keeper) campaignClaimableAmount(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	initialClaimableAmount math.Int,
+) (claimableCoins, remainder math.Int) {
+	actionsCount := int64(campaign.ActionsCount())
+	if actionsCount == 0 {
 		return sdk.ZeroInt(), sdk.ZeroInt()
 	}
 
-	// NOTE: use len(actions)-1 as we don't consider the Unspecified Action
-	actionsCount := int64(len(types.Action_name) - 1)
-	initialClaimablePerAction := claimsRecord.InitialClaimableAmount.QuoRaw(actionsCount)
+	initialClaimablePerAction := initialClaimableAmount.QuoRaw(actionsCount)
 
 	// return full claim amount if the elapsed time <= decay start time
-	decayStartTime := params.DecayStartTime()
+	decayStartTime := campaign.DecayStartTime()
 	if !ctx.BlockTime().After(decayStartTime) {
 		return initialClaimablePerAction, sdk.ZeroInt()
 	}
@@ -235,7 +329,7 @@ func (k Keeper) ClaimableAmountForAction(
 	//
 	// Claimable percent = (1 - elapsed decay) x 100
 	elapsedDecay := ctx.BlockTime().Sub(decayStartTime)
-	elapsedDecayRatio := sdk.NewDec(elapsedDecay.Nanoseconds()).QuoInt64(params.DurationOfDecay.Nanoseconds())
+	elapsedDecayRatio := sdk.NewDec(elapsedDecay.Nanoseconds()).QuoInt64(campaign.DurationOfDecay.Nanoseconds())
 	claimableRatio := sdk.OneDec().Sub(elapsedDecayRatio)
 
 	// calculate the claimable coins, while rounding the decimals
@@ -96,9 +96,10 @@ func (suite *KeeperTestSuite) TestGetClaimableAmountForAction() {
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
 			suite.SetupTest() // reset
+			suite.app.ClaimsKeeper.SetCampaign(suite.ctx, types.NewEvmosCampaign(tc.params, suite.app.ClaimsKeeper.GetModuleAccountAddress()))
 
 			action := types.ActionDelegate
-			amt, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, tc.claimsRecord, action, tc.params)
+			amt, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, tc.claimsRecord, action)
 			suite.Require().Equal(tc.expAmt.Int64(), amt.Int64())
 			suite.Require().Equal(tc.expRemainder.Int64(), remainder.Int64())
 		})
@@ -385,13 +386,14 @@ func (suite *KeeperTestSuite) TestClaimCoinsForAction() {
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
 			suite.SetupTest() // reset
+			suite.app.ClaimsKeeper.SetCampaign(suite.ctx, types.NewEvmosCampaign(tc.params, suite.app.ClaimsKeeper.GetModuleAccountAddress()))
 
 			tc.malleate()
 
 			initialBalance := suite.app.BankKeeper.GetBalance(suite.ctx, addr, types.DefaultClaimsDenom)
 			initialCommunityPoolCoins := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
 
-			amt, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr, tc.claimsRecord, tc.action, tc.params)
+			amt, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr, tc.claimsRecord, tc.action)
 			if tc.expError {
 				suite.Require().Error(err)
 				suite.Require().Equal(int64(0), amt.Int64())
@@ -460,8 +462,9 @@ func (suite *KeeperTestSuite) TestMergeClaimRecords() {
 					DurationOfDecay:    time.Hour,
 					ClaimsDenom:        types.DefaultClaimsDenom,
 				}
+				suite.app.ClaimsKeeper.SetParams(suite.ctx, params) //nolint:errcheck
 
-				mergedRecord, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord, params)
+				mergedRecord, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord)
 				suite.Require().NoError(err)
 
 				expectedRecord := types.ClaimsRecord{
@@ -492,7 +495,7 @@ func (suite *KeeperTestSuite) TestMergeClaimRecords() {
 				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
 				suite.Require().NoError(err)
 
-				mergedRecord, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord, params)
+				mergedRecord, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord)
 				suite.Require().NoError(err)
 
 				// only IBC action should be claimed
@@ -524,7 +527,7 @@ func (suite *KeeperTestSuite) TestMergeClaimRecords() {
 				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
 				suite.Require().NoError(err)
 
-				mergedRecord, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord, params)
+				mergedRecord, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord)
 				suite.Require().NoError(err)
 
 				expectedRecord := types.ClaimsRecord{
@@ -552,7 +555,7 @@ func (suite *KeeperTestSuite) TestMergeClaimRecords() {
 					ActionsCompleted:       []bool{true, true, true, true},
 				}
 
-				_, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord, params)
+				_, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord)
 				suite.Require().Error(err)
 			},
 		},
@@ -562,7 +565,7 @@ func (suite *KeeperTestSuite) TestMergeClaimRecords() {
 				senderClaimsRecord := types.NewClaimsRecord(sdk.NewInt(200))
 				recipientClaimsRecord := types.NewClaimsRecord(sdk.NewInt(200))
 
-				_, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord, params)
+				_, err := suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord)
 				suite.Require().Error(err)
 			},
 		},
@@ -586,7 +589,7 @@ func (suite *KeeperTestSuite) TestMergeClaimRecords() {
 				err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
 				suite.Require().NoError(err)
 
-				_, err = suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord, params)
+				_, err = suite.app.ClaimsKeeper.MergeClaimsRecords(suite.ctx, recipient, senderClaimsRecord, recipientClaimsRecord)
 				suite.Require().Error(err)
 			},
 		},
@@ -608,13 +611,11 @@ func (suite *KeeperTestSuite) TestHookOfUnclaimableAccount() {
 	addr1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
 	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr1, nil, 0, 0))
 
-	params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
-
 	claim, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, addr1)
 	suite.Require().False(found)
 	suite.Require().Equal(types.ClaimsRecord{}, claim)
 
-	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claim, types.ActionEVM, params)
+	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claim, types.ActionEVM)
 	suite.Require().NoError(err)
 
 	balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1)
@@ -640,11 +641,11 @@ func (suite *KeeperTestSuite) TestHookBeforeAirdropStart() {
 	coins := suite.getUserTotalClaimable(suite.ctx, addr1)
 	suite.Require().Equal(sdk.ZeroInt().String(), coins.String())
 
-	coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecord, types.ActionVote, params)
+	coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecord, types.ActionVote)
 	suite.Require().Equal(sdk.ZeroInt().String(), coins.String())
 	suite.Require().Equal(sdk.ZeroInt().String(), remainder.String())
 
-	claimedAmount, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionVote, params)
+	claimedAmount, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionVote)
 	suite.Require().NoError(err)
 	suite.Require().Equal(coins.Int64(), claimedAmount.Int64())
 
@@ -655,7 +656,7 @@ func (suite *KeeperTestSuite) TestHookBeforeAirdropStart() {
 
 	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
 
-	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx.WithBlockTime(airdropStartTime), addr1, claimsRecord, types.ActionVote, params)
+	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx.WithBlockTime(airdropStartTime), addr1, claimsRecord, types.ActionVote)
 	suite.Require().NoError(err)
 
 	balances = suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1)
@@ -683,7 +684,7 @@ func (suite *KeeperTestSuite) TestHookAfterAirdropEnd() {
 	err := suite.app.ClaimsKeeper.EndAirdrop(suite.ctx, params)
 	suite.Require().NoError(err)
 
-	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionDelegate, params)
+	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionDelegate)
 	suite.Require().NoError(err)
 }
 
@@ -705,7 +706,7 @@ func (suite *KeeperTestSuite) TestDuplicatedActionNotWithdrawRepeatedly() {
 	coins1 := suite.getUserTotalClaimable(suite.ctx, addr1)
 	suite.Require().Equal(coins1, claimsRecord.InitialClaimableAmount)
 
-	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM, params)
+	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM)
 	suite.Require().NoError(err)
 
 	claim, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, addr1)
@@ -714,7 +715,7 @@ func (suite *KeeperTestSuite) TestDuplicatedActionNotWithdrawRepeatedly() {
 	claimedCoins := suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1)
 	suite.Require().Equal(claimedCoins.AmountOf(params.GetClaimsDenom()), claimsRecord.InitialClaimableAmount.Quo(sdk.NewInt(4)))
 
-	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM, params)
+	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM)
 
 	suite.NoError(err)
 	suite.True(claim.ActionsCompleted[types.ActionEVM-1])
@@ -816,7 +817,7 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 	suite.Require().True(coins3.IsZero())
 
 	// get rewards amount per action
-	coins4, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecords[0], types.ActionDelegate, suite.app.ClaimsKeeper.GetParams(suite.ctx))
+	coins4, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecords[0], types.ActionDelegate)
 	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.GetClaimsDenom(), 25)).AmountOf(params.GetClaimsDenom()), coins4) // 2 = 10.Quo(4)
 	suite.Require().Equal(sdk.ZeroInt(), remainder)
 
@@ -829,9 +830,9 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 	}
 
 	// do half of actions
-	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionEVM, params)
+	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionEVM)
 	suite.Require().NoError(err)
-	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionDelegate, params)
+	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionDelegate)
 	suite.Require().NoError(err)
 
 	// check that half are completed
@@ -847,14 +848,14 @@ func (suite *KeeperTestSuite) TestAirdropFlow() {
 
 	// check that claimable for completed activity is 0
 	claimsRecord1, _ := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, addrs[0])
-	bal4, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecord1, types.ActionEVM, params)
+	bal4, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(suite.ctx, claimsRecord1, types.ActionEVM)
 	suite.Require().Equal(sdk.ZeroInt(), bal4)
 	suite.Require().Equal(sdk.ZeroInt(), remainder)
 
 	// do rest of actions
-	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionIBCTransfer, params)
+	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionIBCTransfer)
 	suite.Require().NoError(err)
-	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionVote, params)
+	_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addrs[0], claimsRecord, types.ActionVote)
 	suite.Require().NoError(err)
 
 	// get balance after rest actions done
@@ -903,11 +904,11 @@ func (suite *KeeperTestSuite) TestClaimOfDecayed() {
 			fn: func() {
 				ctx := suite.ctx.WithBlockTime(airdropStartTime)
 
-				coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, types.ActionEVM, params)
+				coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, types.ActionEVM)
 				suite.Require().Equal(claimsRecord.InitialClaimableAmount.Quo(sdk.NewInt(4)).String(), coins.String())
 				suite.Require().Equal(sdk.ZeroInt(), remainder)
 
-				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM, params)
+				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM)
 				suite.Require().NoError(err)
 				bal := suite.app.BankKeeper.GetAllBalances(ctx, addr1)
 				suite.Require().Equal(claimsRecord.InitialClaimableAmount.Quo(sdk.NewInt(4)).String(), bal.AmountOf(params.GetClaimsDenom()).String())
@@ -917,11 +918,11 @@ func (suite *KeeperTestSuite) TestClaimOfDecayed() {
 			fn: func() {
 				ctx := suite.ctx.WithBlockTime(airdropStartTime.Add(durationUntilDecay))
 
-				coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, types.ActionEVM, params)
+				coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, types.ActionEVM)
 				suite.Require().Equal(claimsRecord.InitialClaimableAmount.Quo(sdk.NewInt(4)).String(), coins.String())
 				suite.Require().Equal(sdk.ZeroInt(), remainder)
 
-				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM, params)
+				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr1, claimsRecord, types.ActionEVM)
 				suite.Require().NoError(err)
 				bal := suite.app.BankKeeper.GetAllBalances(ctx, addr1)
 				suite.Require().Equal(claimsRecord.InitialClaimableAmount.Quo(sdk.NewInt(4)).String(), bal.AmountOf(params.GetClaimsDenom()).String())
@@ -937,11 +938,11 @@ func (suite *KeeperTestSuite) TestClaimOfDecayed() {
 
 				ctx := suite.ctx.WithBlockTime(blockTime)
 
-				coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, types.ActionEVM, params)
+				coins, remainder := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, types.ActionEVM)
 				suite.Require().Equal(claimablePercent.MulInt(claimsRecord.InitialClaimableAmount).QuoInt64(4).RoundInt().String(), coins.String())
 				suite.Require().Equal(sdk.NewInt(13).String(), remainder.String())
 
-				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionEVM, params)
+				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionEVM)
 				suite.Require().NoError(err)
 
 				bal := suite.app.BankKeeper.GetAllBalances(ctx, addr1)
@@ -952,7 +953,7 @@ func (suite *KeeperTestSuite) TestClaimOfDecayed() {
 		{
 			fn: func() {
 				ctx := suite.ctx.WithBlockTime(airdropStartTime.Add(durationUntilDecay).Add(durationOfDecay))
-				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionEVM, params)
+				_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionEVM)
 				suite.Require().NoError(err)
 				bal := suite.app.BankKeeper.GetAllBalances(ctx, addr1)
 				suite.Require().True(bal.Empty())
@@ -1012,7 +1013,7 @@ func (suite *KeeperTestSuite) TestClawbackEscrowedTokens() {
 	}
 	suite.app.AccountKeeper.SetAccount(ctx, authtypes.NewBaseAccount(addr1, nil, 0, 1))
 	suite.app.ClaimsKeeper.SetClaimsRecord(ctx, addr1, claimsRecord)
-	claimedCoins, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionEVM, params)
+	claimedCoins, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionEVM)
 	suite.Require().NoError(err)
 	coins = suite.app.ClaimsKeeper.GetModuleAccountBalances(ctx)
 	suite.Require().Equal(coins.AmountOf(params.GetClaimsDenom()), escrow.Sub(claimedCoins))
@@ -1022,8 +1023,8 @@ func (suite *KeeperTestSuite) TestClawbackEscrowedTokens() {
 	suite.Require().NoError(err)
 
 	// Make sure no one can claim after airdrop ends
-	claimedCoinsAfter, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionDelegate, params)
-	suite.Require().Error(err)
+	claimedCoinsAfter, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(ctx, addr1, claimsRecord, types.ActionDelegate)
+	suite.Require().NoError(err)
 	suite.Require().Equal(claimedCoinsAfter, sdk.ZeroInt())
 
 	// ensure claim is disabled and the module account is empty
@@ -1121,11 +1122,9 @@ func (suite *KeeperTestSuite) getUserTotalClaimable(ctx sdk.Context, addr sdk.Ac
 		return sdk.ZeroInt()
 	}
 
-	params := suite.app.ClaimsKeeper.GetParams(ctx)
-
 	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
 	for _, action := range actions {
-		claimableForAction, _ := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, action, params)
+		claimableForAction, _ := suite.app.ClaimsKeeper.GetClaimableAmountForAction(ctx, claimsRecord, action)
 		totalClaimable = totalClaimable.Add(claimableForAction)
 	}
 
@@ -23,9 +23,38 @@ import (
 	"github.com/evmos/evmos/v11/x/claims/types"
 )
 
-// GetClaimsRecord returns the claims record for a specific address
+// GetClaimsRecord returns the Evmos airdrop claims record for a specific
+// address
 func (k Keeper) GetClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) (types.ClaimsRecord, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+	return k.GetCampaignClaimsRecord(ctx, types.EvmosCampaignID, addr)
+}
+
+// HasClaimsRecord returns if the Evmos airdrop claims record is found in the
+// store a given address
+func (k Keeper) HasClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.HasCampaignClaimsRecord(ctx, types.EvmosCampaignID, addr)
+}
+
+// SetClaimsRecord sets an Evmos airdrop claims record for an address in store
+func (k Keeper) SetClaimsRecord(ctx sdk.Context, addr sdk.AccAddress, claimsRecord types.ClaimsRecord) {
+	k.SetCampaignClaimsRecord(ctx, types.EvmosCampaignID, addr, claimsRecord)
+}
+
+// DeleteClaimsRecord deletes an Evmos airdrop claims record from the store
+func (k Keeper) DeleteClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) {
+	k.DeleteCampaignClaimsRecord(ctx, types.EvmosCampaignID, addr)
+}
+
+// IterateClaimsRecords iterates over all Evmos airdrop claims records and
+// performs a callback.
+func (k Keeper) IterateClaimsRecords(ctx sdk.Context, handlerFn func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool)) {
+	k.IterateCampaignClaimsRecords(ctx, types.EvmosCampaignID, handlerFn)
+}
+
+// GetCampaignClaimsRecord returns the claims record of a campaign for a
+// specific address
+func (k Keeper) GetCampaignClaimsRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) (types.ClaimsRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimsRecordsPrefix(campaignID))
 
 	bz := store.Get(addr)
 	if len(bz) == 0 {
@@ -38,41 +67,48 @@ func (k Keeper) GetClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) (types.Cla
 	return claimsRecord, true
 }
 
-// HasClaimsRecord returns if the claims record is found in the store a
-// given address
-func (k Keeper) HasClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+// HasCampaignClaimsRecord returns if the claims record of a campaign is found
+// in the store a given address
+func (k Keeper) HasCampaignClaimsRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimsRecordsPrefix(campaignID))
 	return store.Has(addr)
 }
 
-// SetClaimsRecord sets a claims record for an address in store
-func (k Keeper) SetClaimsRecord(ctx sdk.Context, addr sdk.AccAddress, claimsRecord types.ClaimsRecord) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+// SetCampaignClaimsRecord sets a claims record of a campaign for an address in
+// store
+func (k Keeper) SetCampaignClaimsRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress, claimsRecord types.ClaimsRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimsRecordsPrefix(campaignID))
 	bz := k.cdc.MustMarshal(&claimsRecord)
 	store.Set(addr, bz)
 }
 
-// DeleteClaimsRecord deletes a claims record from the store
-func (k Keeper) DeleteClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+// DeleteCampaignClaimsRecord deletes a claims record of a campaign from the
+// store
+func (k Keeper) DeleteCampaignClaimsRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimsRecordsPrefix(campaignID))
 	store.Delete(addr)
 }
 
-// IterateClaimsRecords iterates over all claims records and performs a
-// callback.
-func (k Keeper) IterateClaimsRecords(ctx sdk.Context, handlerFn func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool)) {
+// IterateCampaignClaimsRecords iterates over all claims records of a campaign
+// and performs a callback.
+func (k Keeper) IterateCampaignClaimsRecords(
+	ctx sdk.Context,
+	campaignID uint64,
+	handlerFn func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool),
+) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixClaimsRecords)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetClaimsRecordsPrefix(campaignID))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
 		var claimsRecord types.ClaimsRecord
 		k.cdc.MustUnmarshal(iterator.Value(), &claimsRecord)
 
-		addr := sdk.AccAddress(iterator.Key()[1:])
+		_, addr := types.SplitClaimsRecordKey(iterator.Key())
 		cr := types.ClaimsRecord{
-			InitialClaimableAmount: claimsRecord.InitialClaimableAmount,
-			ActionsCompleted:       claimsRecord.ActionsCompleted,
+			InitialClaimableAmount:   claimsRecord.InitialClaimableAmount,
+			ActionsCompleted:         claimsRecord.ActionsCompleted,
+			ContractActionsCompleted: claimsRecord.ContractActionsCompleted,
 		}
 
 		if handlerFn(addr, cr) {
@@ -81,19 +117,82 @@ func (k Keeper) IterateClaimsRecords(ctx sdk.Context, handlerFn func(addr sdk.Ac
 	}
 }
 
-// GetClaimsRecords get claims record instances for genesis export
+// GetClaimsRecords get the claims record instances of all campaigns for
+// genesis export
 func (k Keeper) GetClaimsRecords(ctx sdk.Context) []types.ClaimsRecordAddress {
 	claimsRecords := []types.ClaimsRecordAddress{}
-	k.IterateClaimsRecords(ctx, func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixClaimsRecords)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var cr types.ClaimsRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &cr)
+
+		campaignID, addr := types.SplitClaimsRecordKey(iterator.Key())
 		cra := types.ClaimsRecordAddress{
-			Address:                addr.String(),
-			InitialClaimableAmount: cr.InitialClaimableAmount,
-			ActionsCompleted:       cr.ActionsCompleted,
+			Address:                  addr.String(),
+			InitialClaimableAmount:   cr.InitialClaimableAmount,
+			ActionsCompleted:         cr.ActionsCompleted,
+			CampaignID:               campaignID,
+			ContractActionsCompleted: cr.ContractActionsCompleted,
 		}
 
 		claimsRecords = append(claimsRecords, cra)
-		return false
-	})
+	}
 
 	return claimsRecords
 }
+
+// HasUsedClaimProof returns if the Merkle proof of the allocation of an address
+// on a campaign has already been submitted
+func (k Keeper) HasUsedClaimProof(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUsedClaimProofsPrefix(campaignID))
+	return store.Has(addr)
+}
+
+// SetUsedClaimProof marks the Merkle proof of the allocation of an address on a
+// campaign as submitted. The marker is kept when the claims record is deleted
+// or transferred.
+func (k Keeper) SetUsedClaimProof(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUsedClaimProofsPrefix(campaignID))
+	store.Set(addr, []byte{1})
+}
+
+// DeleteUsedClaimProofs deletes the used claim proof markers of a campaign
+// from the store
+func (k Keeper) DeleteUsedClaimProofs(ctx sdk.Context, campaignID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUsedClaimProofsPrefix(campaignID))
+	iterator := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetUsedClaimProofs gets the used claim proofs of all campaigns for genesis
+// export
+func (k Keeper) GetUsedClaimProofs(ctx sdk.Context) []types.UsedClaimProof {
+	usedProofs := []types.UsedClaimProof{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixUsedClaimProofs)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		campaignID, addr := types.SplitUsedClaimProofKey(iterator.Key())
+		usedProofs = append(usedProofs, types.UsedClaimProof{
+			CampaignID: campaignID,
+			Address:    addr.String(),
+		})
+	}
+
+	return usedProofs
+}
@@ -56,7 +56,7 @@ func (k Keeper) Params(
 	}, nil
 }
 
-// ClaimsRecords returns all claims records
+// ClaimsRecords returns all claims records of a campaign
 func (k Keeper) ClaimsRecords(
 	c context.Context,
 	req *types.QueryClaimsRecordsRequest,
@@ -66,7 +66,7 @@ func (k Keeper) ClaimsRecords(
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimsRecordsPrefix(req.CampaignID))
 
 	claimsRecords := []types.ClaimsRecordAddress{}
 
@@ -83,6 +83,7 @@ func (k Keeper) ClaimsRecords(
 				Address:                sdk.AccAddress(key).String(),
 				InitialClaimableAmount: cr.InitialClaimableAmount,
 				ActionsCompleted:       cr.ActionsCompleted,
+				CampaignID:             req.CampaignID,
 			}
 
 			claimsRecords = append(claimsRecords, cra)
@@ -100,7 +101,7 @@ func (k Keeper) ClaimsRecords(
 }
 
 // ClaimsRecord returns the initial claimable amount per user and the claims per
-// action. Claimable amount per action will be 0 if claiming is disabled, before
+// action of a campaign. Claimable amount per action will be 0 if claiming is disabled, before
 // the start time, or after end time.
 func (k Keeper) ClaimsRecord(
 	c context.Context,
@@ -117,17 +118,19 @@ func (k Keeper) ClaimsRecord(
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	claimsRecord, found := k.GetClaimsRecord(ctx, addr)
+	campaign, found := k.GetCampaign(ctx, req.CampaignID)
 	if !found {
-		return nil, status.Errorf(codes.NotFound, "claims record for address '%s'", req.Address)
+		return nil, status.Errorf(codes.NotFound, "campaign %d", req.CampaignID)
 	}
 
-	params := k.GetParams(ctx)
-	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
+	claimsRecord, found := k.GetCampaignClaimsRecord(ctx, req.CampaignID, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "claims record for address '%s'", req.Address)
+	}
 
-	claims := make([]types.Claim, len(actions))
-	for i, action := range actions {
-		claimableAmt, _ := k.ClaimableAmountForAction(ctx, claimsRecord, action, params)
+	claims := make([]types.Claim, len(campaign.Actions))
+	for i, action := range campaign.Actions {
+		claimableAmt, _ := k.CampaignClaimableAmountForAction(ctx, campaign, claimsRecord, action)
 
 		claims[i] = types.Claim{
 			Action:          action,
@@ -136,8 +139,53 @@ func (k Keeper) ClaimsRecord(
 		}
 	}
 
+	contractClaims := make([]types.ContractClaim, len(campaign.ContractActions))
+	for i, contractAction := range campaign.ContractActions {
+		claimableAmt, _ := k.CampaignClaimableAmountForContractAction(ctx, campaign, claimsRecord, i)
+
+		contractClaims[i] = types.ContractClaim{
+			ContractAction:  contractAction,
+			Completed:       claimsRecord.HasClaimedContractAction(i),
+			ClaimableAmount: claimableAmt,
+		}
+	}
+
 	return &types.QueryClaimsRecordResponse{
 		InitialClaimableAmount: claimsRecord.InitialClaimableAmount,
 		Claims:                 claims,
+		ContractClaims:         contractClaims,
+	}, nil
+}
+
+// Campaigns returns the Evmos airdrop and all the partner campaigns
+func (k Keeper) Campaigns(
+	c context.Context,
+	_ *types.QueryCampaignsRequest,
+) (*types.QueryCampaignsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCampaignsResponse{
+		Campaigns: k.GetCampaigns(ctx),
+	}, nil
+}
+
+// Campaign returns the campaign for a given identifier
+func (k Keeper) Campaign(
+	c context.Context,
+	req *types.QueryCampaignRequest,
+) (*types.QueryCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.CampaignId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign %d", req.CampaignId)
+	}
+
+	return &types.QueryCampaignResponse{
+		Campaign: campaign,
 	}, nil
 }
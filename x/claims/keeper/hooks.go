@@ -58,16 +58,17 @@ func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr s
 
 // AfterProposalVote is called after a vote on a proposal is cast. Once the vote
 // is successfully included, the claimable amount for the user's claims record
-// vote action is claimed and the transferred to the user address.
+// vote action is claimed and the transferred to the user address. The vote
+// action of the active partner campaigns is claimed as well.
 func (k Keeper) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
-	params := k.GetParams(ctx)
+	k.ClaimCampaignsAction(ctx, voterAddr, types.ActionVote)
 
 	claimsRecord, found := k.GetClaimsRecord(ctx, voterAddr)
 	if !found {
 		return
 	}
 
-	_, err := k.ClaimCoinsForAction(ctx, voterAddr, claimsRecord, types.ActionVote, params)
+	_, err := k.ClaimCoinsForAction(ctx, voterAddr, claimsRecord, types.ActionVote)
 	if err != nil {
 		k.Logger(ctx).Error(
 			"failed to claim Vote action",
@@ -87,16 +88,17 @@ func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 // AfterDelegationModified is called after a delegation is modified. Once a user
 // delegates their EVMOS tokens to a validator, the claimable amount for the
 // user's claims record delegation action is claimed and transferred to the user
-// address.
+// address. The delegation action of the active partner campaigns is claimed as
+// well.
 func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
-	params := k.GetParams(ctx)
+	k.ClaimCampaignsAction(ctx, delAddr, types.ActionDelegate)
 
 	claimsRecord, found := k.GetClaimsRecord(ctx, delAddr)
 	if !found {
 		return nil
 	}
 
-	_, err := k.ClaimCoinsForAction(ctx, delAddr, claimsRecord, types.ActionDelegate, params)
+	_, err := k.ClaimCoinsForAction(ctx, delAddr, claimsRecord, types.ActionDelegate)
 	if err != nil {
 		k.Logger(ctx).Error(
 			"failed to claim Delegation action",
@@ -117,17 +119,20 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 // PostTxProcessing implements the ethermint evm PostTxProcessing hook.
 // After a EVM state transition is successfully processed, the claimable amount
 // for the users's claims record evm action is claimed and transferred to the
-// user address.
+// user address. The evm action and the contract actions completed by the
+// transaction of the active partner campaigns are claimed as well.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
-	params := k.GetParams(ctx)
 	fromAddr := sdk.AccAddress(msg.From().Bytes())
 
+	k.ClaimCampaignsAction(ctx, fromAddr, types.ActionEVM)
+	k.ClaimCampaignsContractActions(ctx, fromAddr, msg.To(), receipt)
+
 	claimsRecord, found := k.GetClaimsRecord(ctx, fromAddr)
 	if !found {
 		return nil
 	}
 
-	_, err := k.ClaimCoinsForAction(ctx, fromAddr, claimsRecord, types.ActionEVM, params)
+	_, err := k.ClaimCoinsForAction(ctx, fromAddr, claimsRecord, types.ActionEVM)
 	if err != nil {
 		k.Logger(ctx).Error(
 			"failed to claim EVM action",
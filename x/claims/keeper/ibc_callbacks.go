@@ -70,7 +70,7 @@ func (k Keeper) OnAcknowledgementPacket(
 	}
 
 	// claim IBC transfer action
-	_, err = k.ClaimCoinsForAction(ctx, sender, claimsRecord, types.ActionIBCTransfer, params)
+	_, err = k.ClaimCoinsForAction(ctx, sender, claimsRecord, types.ActionIBCTransfer)
 	if err != nil {
 		return err
 	}
@@ -173,7 +173,7 @@ func (k Keeper) OnRecvPacket(
 		// case 1: both sender and recipient are distinct and have a claims record
 		// -> merge sender's record with the recipient's record and claim actions that
 		// have already been claimed by one or the other
-		recipientClaimsRecord, err = k.MergeClaimsRecords(ctx, recipient, senderClaimsRecord, recipientClaimsRecord, params)
+		recipientClaimsRecord, err = k.MergeClaimsRecords(ctx, recipient, senderClaimsRecord, recipientClaimsRecord)
 		if err != nil {
 			return channeltypes.NewErrorAcknowledgement(err)
 		}
@@ -193,7 +193,7 @@ func (k Keeper) OnRecvPacket(
 		// -> migrate the sender record to the recipient address and claim IBC action
 
 		claimedAmt := sdk.ZeroInt() //nolint
-		claimedAmt, err = k.ClaimCoinsForAction(ctx, recipient, senderClaimsRecord, types.ActionIBCTransfer, params)
+		claimedAmt, err = k.ClaimCoinsForAction(ctx, recipient, senderClaimsRecord, types.ActionIBCTransfer)
 
 		// if the transfer fails or the claimable amount is 0 (eg: action already
 		// completed), don't perform a state migration
@@ -215,7 +215,7 @@ func (k Keeper) OnRecvPacket(
 	case !senderRecordFound && recipientRecordFound,
 		sameAddress && fromEVMChain && recipientRecordFound:
 		// case 3: only the recipient has a claims record -> only claim IBC transfer action
-		_, err = k.ClaimCoinsForAction(ctx, recipient, recipientClaimsRecord, types.ActionIBCTransfer, params)
+		_, err = k.ClaimCoinsForAction(ctx, recipient, recipientClaimsRecord, types.ActionIBCTransfer)
 	case !senderRecordFound && !recipientRecordFound:
 		// case 4: neither the sender or recipient have a claims record
 		// -> perform a no-op by returning the original success acknowledgement
@@ -229,3 +229,38 @@ func (k Keeper) OnRecvPacket(
 	// return the original success acknowledgement
 	return ack
 }
+
+// OnAcknowledgementPacketCampaigns claims the IBC transfer action of the
+// active partner campaigns for the sender of a successfully acknowledged
+// transfer.
+func (k Keeper) OnAcknowledgementPacketCampaigns(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		return
+	}
+
+	sender, _, _, _, err := ibc.GetTransferSenderRecipient(packet)
+	if err != nil {
+		return
+	}
+
+	k.ClaimCampaignsAction(ctx, sender, types.ActionIBCTransfer)
+}
+
+// OnRecvPacketCampaigns claims the IBC transfer action of the active partner
+// campaigns for the recipient of a successfully received transfer.
+func (k Keeper) OnRecvPacketCampaigns(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) {
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(packet)
+	if err != nil {
+		return
+	}
+
+	k.ClaimCampaignsAction(ctx, recipient, types.ActionIBCTransfer)
+}
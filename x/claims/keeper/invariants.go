@@ -27,6 +27,7 @@ import (
 // RegisterInvariants registers the claims module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "claims-invariant", k.ClaimsInvariant())
+	ir.RegisterRoute(types.ModuleName, "campaigns-invariant", k.CampaignsInvariant())
 }
 
 // ClaimsInvariant checks that the total amount of all unclaimed coins held in
@@ -71,3 +72,27 @@ func (k Keeper) ClaimsInvariant() sdk.Invariant {
 		return msg, isInvariantBroken
 	}
 }
+
+// CampaignsInvariant checks that the escrowed balance of every active partner
+// campaign covers the allocated amount that hasn't been claimed yet
+func (k Keeper) CampaignsInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (msg string, broken bool) {
+		var brokenCampaigns string
+		for _, campaign := range k.GetActiveCampaigns(ctx) {
+			escrowAddr := sdk.MustAccAddressFromBech32(campaign.EscrowAddress)
+			balance := k.bankKeeper.GetBalance(ctx, escrowAddr, campaign.Denom)
+			unclaimed := campaign.Unclaimed()
+
+			if balance.Amount.LT(unclaimed) {
+				broken = true
+				brokenCampaigns += fmt.Sprintf(
+					"\tcampaign %d: unclaimed amount %s, escrowed balance %s\n",
+					campaign.ID, unclaimed, balance.Amount,
+				)
+			}
+		}
+
+		msg = sdk.FormatInvariant(types.ModuleName, "campaigns", brokenCampaigns)
+		return msg, broken
+	}
+}
@@ -27,6 +27,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/evmos/evmos/v11/x/claims/types"
 )
 
@@ -41,6 +42,8 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 	distrKeeper   types.DistrKeeper
 	ics4Wrapper   porttypes.ICS4Wrapper
+	// router executes the claiming action messages submitted with a claim proof
+	router *baseapp.MsgServiceRouter
 }
 
 // NewKeeper returns keeper
@@ -52,6 +55,7 @@ func NewKeeper(
 	bk types.BankKeeper,
 	sk types.StakingKeeper,
 	dk types.DistrKeeper,
+	router *baseapp.MsgServiceRouter,
 ) *Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -66,6 +70,7 @@ func NewKeeper(
 		bankKeeper:    bk,
 		stakingKeeper: sk,
 		distrKeeper:   dk,
+		router:        router,
 	}
 }
 
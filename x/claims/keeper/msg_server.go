@@ -18,10 +18,13 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/claims/types"
 )
 
@@ -41,3 +44,288 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// CreateCampaign implements the gRPC MsgServer interface. It creates a new
+// partner airdrop campaign, transfers its funds from the creator to the
+// campaign escrow account and pays the campaign creation fee to the community
+// pool.
+func (k *Keeper) CreateCampaign(goCtx context.Context, msg *types.MsgCreateCampaign) (*types.MsgCreateCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if k.GetActiveCampaignsCount(ctx) >= params.MaxActiveCampaigns {
+		return nil, errorsmod.Wrapf(types.ErrMaxActiveCampaigns, "%d", params.MaxActiveCampaigns)
+	}
+
+	if !msg.StartTime.After(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidCampaign,
+			"start time %s must be after the block time %s", msg.StartTime, ctx.BlockTime(),
+		)
+	}
+
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	if !params.CampaignCreationFee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.CampaignCreationFee, creator); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to pay campaign creation fee")
+		}
+	}
+
+	campaignID := k.GetNextCampaignID(ctx)
+
+	campaign := types.NewCampaign(
+		campaignID,
+		msg.Name,
+		creator,
+		msg.Amount.Denom,
+		msg.StartTime,
+		msg.DurationUntilDecay,
+		msg.DurationOfDecay,
+		msg.Actions,
+		msg.ContractActions,
+		msg.ClawbackAddress,
+	)
+
+	if len(msg.MerkleRoot) != 0 {
+		campaign.MerkleRoot = msg.MerkleRoot
+		campaign.TotalAllocated = msg.MerkleTotal
+	}
+
+	escrowAddr := sdk.MustAccAddressFromBech32(campaign.EscrowAddress)
+	k.setCampaignEscrowAccount(ctx, escrowAddr)
+	if err := k.bankKeeper.SendCoins(ctx, creator, escrowAddr, sdk.Coins{msg.Amount}); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to fund campaign escrow")
+	}
+
+	k.SetCampaign(ctx, campaign)
+	k.SetNextCampaignID(ctx, campaignID+1)
+
+	defer func() {
+		denomLabel := evmostelemetry.NewDenomLabel(msg.Amount.Denom)
+		evmostelemetry.IncrCounter(types.MetricKeyCreateCampaign, denomLabel)
+		evmostelemetry.IncrAmount(types.MetricKeyCreateCampaignAmount, msg.Amount.Amount, denomLabel)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaignID, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyEscrowAddress, campaign.EscrowAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgCreateCampaignResponse{CampaignID: campaignID}, nil
+}
+
+// AddClaimsRecords implements the gRPC MsgServer interface. It allocates the
+// escrowed funds of a partner campaign to a list of recipients. Only the
+// campaign creator can add claims records and only before the campaign
+// starts.
+func (k *Keeper) AddClaimsRecords(goCtx context.Context, msg *types.MsgAddClaimsRecords) (*types.MsgAddClaimsRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCampaignNotFound, "campaign %d", msg.CampaignID)
+	}
+
+	if campaign.Creator != msg.Creator {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the creator of campaign %d", msg.Creator, msg.CampaignID)
+	}
+
+	if campaign.IsMerkle() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCampaign, "campaign %d claims records are created with merkle proofs", msg.CampaignID)
+	}
+
+	if !campaign.Enabled || !ctx.BlockTime().Before(campaign.StartTime) {
+		return nil, errorsmod.Wrapf(types.ErrCampaignStarted, "campaign %d", msg.CampaignID)
+	}
+
+	total := campaign.TotalAllocated
+	recipients := make([]sdk.AccAddress, len(msg.Allocations))
+
+	for i, allocation := range msg.Allocations {
+		addr := sdk.MustAccAddressFromBech32(allocation.Address)
+		if k.bankKeeper.BlockedAddr(addr) {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", allocation.Address)
+		}
+		if k.HasCampaignClaimsRecord(ctx, campaign.ID, addr) {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "claims record already exists for %s", allocation.Address)
+		}
+
+		recipients[i] = addr
+		total = total.Add(allocation.Amount)
+	}
+
+	escrowAddr := sdk.MustAccAddressFromBech32(campaign.EscrowAddress)
+	escrowBalance := k.bankKeeper.GetBalance(ctx, escrowAddr, campaign.Denom)
+	if escrowBalance.Amount.LT(total) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientFunds,
+			"total allocated %s%s exceeds the escrowed %s", total, campaign.Denom, escrowBalance,
+		)
+	}
+
+	for i, allocation := range msg.Allocations {
+		k.SetCampaignClaimsRecord(ctx, campaign.ID, recipients[i], types.NewCampaignClaimsRecord(campaign, allocation.Amount))
+	}
+
+	campaign.TotalAllocated = total
+	k.SetCampaign(ctx, campaign)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddClaimsRecords,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecords, strconv.Itoa(len(msg.Allocations))),
+		),
+	)
+
+	return &types.MsgAddClaimsRecordsResponse{}, nil
+}
+
+// SubmitClaimProof implements the gRPC MsgServer interface. It verifies the
+// Merkle proof of the sender allocation on a Merkle campaign, creates the
+// sender claims record and executes the claiming action messages of the sender,
+// which claim the record through the campaign hooks. The proof of an allocation
+// can only be submitted once, even if the claims record is later transferred.
+func (k *Keeper) SubmitClaimProof(goCtx context.Context, msg *types.MsgSubmitClaimProof) (*types.MsgSubmitClaimProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCampaignNotFound, "campaign %d", msg.CampaignID)
+	}
+
+	if !campaign.IsMerkle() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCampaign, "campaign %d is not a merkle campaign", msg.CampaignID)
+	}
+
+	if !campaign.Enabled || !ctx.BlockTime().Before(campaign.EndTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCampaign, "campaign %d has ended", msg.CampaignID)
+	}
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	// the claiming action messages are checked again as the validation of the
+	// message is skipped when it is nested in an authz MsgExec
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "the proof must be submitted with a claiming action message")
+	}
+
+	for _, actionMsg := range msgs {
+		if err := types.ValidateClaimingActionMsg(msg.Sender, actionMsg); err != nil {
+			return nil, err
+		}
+	}
+
+	if k.HasUsedClaimProof(ctx, campaign.ID, sender) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "proof already submitted for %s on campaign %d", msg.Sender, campaign.ID)
+	}
+
+	if k.bankKeeper.BlockedAddr(sender) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", msg.Sender)
+	}
+
+	leaf := types.MerkleLeaf(sender, msg.Amount)
+	if !types.VerifyMerkleProof(campaign.MerkleRoot, leaf, msg.Proof) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "allocation of %s%s to %s", msg.Amount, campaign.Denom, msg.Sender)
+	}
+
+	claimsRecord := types.NewCampaignClaimsRecord(campaign, msg.Amount)
+
+	// merge the allocation with the claims record transferred to the sender
+	// before it submitted its own proof
+	if transferredRecord, found := k.GetCampaignClaimsRecord(ctx, campaign.ID, sender); found {
+		mergedRecord, claimedAmt, remainderAmt := k.mergeClaimsRecords(ctx, campaign, claimsRecord, transferredRecord, false)
+		if !claimedAmt.IsZero() {
+			if err := k.sendMergedClaim(ctx, campaign, sender, claimedAmt, remainderAmt); err != nil {
+				return nil, err
+			}
+		}
+		claimsRecord = mergedRecord
+	}
+
+	k.SetCampaignClaimsRecord(ctx, campaign.ID, sender, claimsRecord)
+	k.SetUsedClaimProof(ctx, campaign.ID, sender)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitClaimProof,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	if err := k.executeClaimingActions(ctx, msgs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitClaimProofResponse{}, nil
+}
+
+// executeClaimingActions routes the claiming action messages submitted with a
+// claim proof to their module handlers
+func (k Keeper) executeClaimingActions(ctx sdk.Context, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized message route %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to execute claiming action message %d", i)
+		}
+
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
+
+// TransferClaimsRecord implements the gRPC MsgServer interface. It moves the
+// claims record of the sender to the recipient, merging it with the recipient
+// claims record if it exists.
+func (k *Keeper) TransferClaimsRecord(goCtx context.Context, msg *types.MsgTransferClaimsRecord) (*types.MsgTransferClaimsRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCampaignNotFound, "campaign %d", msg.CampaignID)
+	}
+
+	if !campaign.Enabled || !ctx.BlockTime().Before(campaign.EndTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCampaign, "campaign %d has ended", msg.CampaignID)
+	}
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+
+	if _, err := k.TransferCampaignClaimsRecord(ctx, campaign, sender, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferClaimsRecord,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		),
+	)
+
+	return &types.MsgTransferClaimsRecordResponse{}, nil
+}
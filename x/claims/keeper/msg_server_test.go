@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/testutil"
 	"github.com/evmos/evmos/v11/x/claims/types"
 )
 
@@ -39,3 +45,167 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTransferClaimsRecord() {
+	var (
+		sender    sdk.AccAddress
+		recipient sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgTransferClaimsRecord
+		expErr   error
+		postFn   func()
+	}{
+		{
+			"fail - campaign not found",
+			func() *types.MsgTransferClaimsRecord {
+				return &types.MsgTransferClaimsRecord{Sender: sender.String(), Recipient: recipient.String(), CampaignID: 100}
+			},
+			types.ErrCampaignNotFound,
+			nil,
+		},
+		{
+			"fail - claims disabled",
+			func() *types.MsgTransferClaimsRecord {
+				params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+				params.EnableClaims = false
+				suite.Require().NoError(suite.app.ClaimsKeeper.SetParams(suite.ctx, params))
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, sender, types.NewClaimsRecord(sdk.NewInt(400)))
+
+				return &types.MsgTransferClaimsRecord{Sender: sender.String(), Recipient: recipient.String()}
+			},
+			types.ErrInvalidCampaign,
+			nil,
+		},
+		{
+			"fail - sender without claims record",
+			func() *types.MsgTransferClaimsRecord {
+				return &types.MsgTransferClaimsRecord{Sender: sender.String(), Recipient: recipient.String()}
+			},
+			types.ErrClaimsRecordNotFound,
+			nil,
+		},
+		{
+			"pass - move claims record",
+			func() *types.MsgTransferClaimsRecord {
+				record := types.NewClaimsRecord(sdk.NewInt(400))
+				record.MarkClaimed(types.ActionVote)
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, sender, record)
+
+				return &types.MsgTransferClaimsRecord{Sender: sender.String(), Recipient: recipient.String()}
+			},
+			nil,
+			func() {
+				suite.Require().False(suite.app.ClaimsKeeper.HasClaimsRecord(suite.ctx, sender))
+
+				record, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, recipient)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewInt(400), record.InitialClaimableAmount)
+				suite.Require().Equal([]bool{true, false, false, false}, record.ActionsCompleted)
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, recipient, types.DefaultClaimsDenom).IsZero())
+			},
+		},
+		{
+			"pass - merge claims records",
+			func() *types.MsgTransferClaimsRecord {
+				senderRecord := types.NewClaimsRecord(sdk.NewInt(400))
+				senderRecord.MarkClaimed(types.ActionVote)
+				senderRecord.MarkClaimed(types.ActionEVM)
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, sender, senderRecord)
+
+				recipientRecord := types.NewClaimsRecord(sdk.NewInt(400))
+				recipientRecord.MarkClaimed(types.ActionDelegate)
+				recipientRecord.MarkClaimed(types.ActionEVM)
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, recipient, recipientRecord)
+
+				return &types.MsgTransferClaimsRecord{Sender: sender.String(), Recipient: recipient.String()}
+			},
+			nil,
+			func() {
+				suite.Require().False(suite.app.ClaimsKeeper.HasClaimsRecord(suite.ctx, sender))
+
+				record, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, recipient)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewInt(800), record.InitialClaimableAmount)
+				suite.Require().Equal([]bool{true, true, true, false}, record.ActionsCompleted)
+
+				// the vote action of the recipient and the delegate action of the sender are claimed
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, recipient, types.DefaultClaimsDenom)
+				suite.Require().Equal(sdk.NewInt(200), balance.Amount)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			sender = sdk.AccAddress(tests.GenerateAddress().Bytes())
+			recipient = sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+			coins := sdk.Coins{sdk.NewCoin(types.DefaultClaimsDenom, sdk.NewInt(1000))}
+			suite.Require().NoError(testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins))
+
+			_, err := suite.app.ClaimsKeeper.TransferClaimsRecord(sdk.WrapSDKContext(suite.ctx), tc.malleate())
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.postFn()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferCampaignClaimsRecord() {
+	suite.SetupTest()
+
+	creator := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoin("atest", sdk.NewInt(1000))
+
+	campaignID := suite.createCampaign(creator, amount, []types.Action{types.ActionVote, types.ActionDelegate}, "")
+	_, err := suite.app.ClaimsKeeper.AddClaimsRecords(sdk.WrapSDKContext(suite.ctx), &types.MsgAddClaimsRecords{
+		Creator:    creator.String(),
+		CampaignID: campaignID,
+		Allocations: []types.ClaimsAllocation{
+			{Address: sender.String(), Amount: sdk.NewInt(500)},
+			{Address: recipient.String(), Amount: sdk.NewInt(500)},
+		},
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, sender)
+	suite.Require().Equal(sdk.NewInt(250), suite.app.BankKeeper.GetBalance(suite.ctx, sender, amount.Denom).Amount)
+
+	_, err = suite.app.ClaimsKeeper.TransferClaimsRecord(sdk.WrapSDKContext(suite.ctx), &types.MsgTransferClaimsRecord{
+		Sender:     sender.String(),
+		Recipient:  recipient.String(),
+		CampaignID: campaignID,
+	})
+	suite.Require().NoError(err)
+
+	// the vote action of the recipient is claimed with the merge
+	suite.Require().Equal(sdk.NewInt(250), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).Amount)
+	suite.Require().False(suite.app.ClaimsKeeper.HasCampaignClaimsRecord(suite.ctx, campaignID, sender))
+	// only the transfers of Merkle campaign records leave a used proof marker
+	suite.Require().False(suite.app.ClaimsKeeper.HasUsedClaimProof(suite.ctx, campaignID, sender))
+
+	record, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, campaignID, recipient)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000), record.InitialClaimableAmount)
+	suite.Require().True(record.HasClaimedAction(types.ActionVote))
+	suite.Require().False(record.HasClaimedAction(types.ActionDelegate))
+
+	campaign, _ := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaignID)
+	suite.Require().Equal(sdk.NewInt(500), campaign.TotalClaimed)
+
+	// the delegate action releases the remaining allocation
+	suite.app.ClaimsKeeper.ClaimCampaignsAction(suite.ctx, recipient, types.ActionDelegate)
+	suite.Require().Equal(sdk.NewInt(750), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, amount.Denom).Amount)
+}
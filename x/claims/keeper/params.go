@@ -34,7 +34,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
-// SetParams sets the claim parameters to the param space.
+// SetParams sets the claim parameters to the param space and updates the
+// Evmos airdrop campaign, which is governed by them.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
@@ -43,6 +44,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	}
 
 	store.Set(types.ParamsKey, bz)
+	k.setEvmosCampaign(ctx, params)
 
 	return nil
 }
@@ -0,0 +1,107 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+// MigrateStore migrates the x/claims module state from the consensus version 3 to
+// version 4. Specifically, it:
+//   - moves the existing claims records, which are keyed by address, under the
+//     Evmos airdrop campaign (campaign 0)
+//   - stores the Evmos airdrop campaign with the totals of its claims records
+//   - sets the campaign creation fee and maximum number of active campaigns
+//     parameters to their default values
+//   - initializes the identifier of the next partner campaign
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var (
+		keys   [][]byte
+		values [][]byte
+	)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixClaimsRecords)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	totalAllocated := sdk.ZeroInt()
+	totalClaimed := sdk.ZeroInt()
+	numActions := sdk.NewInt(int64(len(types.DefaultActions)))
+
+	// NOTE: we cannot modify the store while iterating over it
+	for i, key := range keys {
+		var claimsRecord types.ClaimsRecord
+		if err := cdc.Unmarshal(values[i], &claimsRecord); err != nil {
+			return err
+		}
+
+		initialClaimablePerAction := claimsRecord.InitialClaimableAmount.Quo(numActions)
+		totalAllocated = totalAllocated.Add(claimsRecord.InitialClaimableAmount)
+		for _, actionCompleted := range claimsRecord.ActionsCompleted {
+			if actionCompleted {
+				totalClaimed = totalClaimed.Add(initialClaimablePerAction)
+			}
+		}
+
+		addr := sdk.AccAddress(key[len(types.KeyPrefixClaimsRecords):])
+		store.Delete(key)
+		store.Set(types.GetClaimsRecordKey(types.EvmosCampaignID, addr), values[i])
+	}
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); len(bz) > 0 {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.CampaignCreationFee = types.DefaultCampaignCreationFee
+	params.MaxActiveCampaigns = types.DefaultMaxActiveCampaigns
+
+	paramsBz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, paramsBz)
+
+	evmosCampaign := types.NewEvmosCampaign(params, authtypes.NewModuleAddress(types.ModuleName))
+	evmosCampaign.TotalAllocated = totalAllocated
+	evmosCampaign.TotalClaimed = totalClaimed
+
+	campaignBz, err := cdc.Marshal(&evmosCampaign)
+	if err != nil {
+		return err
+	}
+	store.Set(append(types.KeyPrefixCampaigns, types.GetCampaignIDBytes(types.EvmosCampaignID)...), campaignBz)
+
+	store.Set(types.KeyNextCampaignID, types.GetCampaignIDBytes(types.EvmosCampaignID+1))
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/app"
+	v4 "github.com/evmos/evmos/v11/x/claims/migrations/v4"
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	addr1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	addr2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	cr1 := types.NewClaimsRecord(sdk.NewInt(100))
+	cr2 := types.NewClaimsRecord(sdk.NewInt(200))
+	cr2.MarkClaimed(types.ActionVote)
+
+	// legacy claims records are keyed by address only
+	kvStore.Set(append(types.KeyPrefixClaimsRecords, addr1...), cdc.MustMarshal(&cr1))
+	kvStore.Set(append(types.KeyPrefixClaimsRecords, addr2...), cdc.MustMarshal(&cr2))
+
+	params := types.DefaultParams()
+	params.CampaignCreationFee = nil
+	params.MaxActiveCampaigns = 0
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	require.False(t, kvStore.Has(append(types.KeyPrefixClaimsRecords, addr1...)))
+	require.False(t, kvStore.Has(append(types.KeyPrefixClaimsRecords, addr2...)))
+
+	for addr, expRecord := range map[string]types.ClaimsRecord{addr1.String(): cr1, addr2.String(): cr2} {
+		bz := kvStore.Get(types.GetClaimsRecordKey(types.EvmosCampaignID, sdk.MustAccAddressFromBech32(addr)))
+		require.NotNil(t, bz)
+
+		var record types.ClaimsRecord
+		cdc.MustUnmarshal(bz, &record)
+		require.Equal(t, expRecord, record)
+	}
+
+	require.Equal(t, uint64(1), sdk.BigEndianToUint64(kvStore.Get(types.KeyNextCampaignID)))
+
+	var migratedParams types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.ParamsKey), &migratedParams)
+	require.Equal(t, types.DefaultParams(), migratedParams)
+
+	var evmosCampaign types.Campaign
+	cdc.MustUnmarshal(kvStore.Get(append(types.KeyPrefixCampaigns, types.GetCampaignIDBytes(types.EvmosCampaignID)...)), &evmosCampaign)
+	require.Equal(t, types.DefaultParams().ClaimsDenom, evmosCampaign.Denom)
+	require.Equal(t, sdk.NewInt(300), evmosCampaign.TotalAllocated)
+	require.Equal(t, sdk.NewInt(50), evmosCampaign.TotalClaimed)
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// value to the corresponding claims type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixClaimsRecords):
+			var claimsRecordA, claimsRecordB types.ClaimsRecord
+			cdc.MustUnmarshal(kvA.Value, &claimsRecordA)
+			cdc.MustUnmarshal(kvB.Value, &claimsRecordB)
+			return fmt.Sprintf("%v\n%v", claimsRecordA, claimsRecordB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCampaigns):
+			var campaignA, campaignB types.Campaign
+			cdc.MustUnmarshal(kvA.Value, &campaignA)
+			cdc.MustUnmarshal(kvB.Value, &campaignB)
+			return fmt.Sprintf("%v\n%v", campaignA, campaignB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixActiveCampaigns),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixUsedClaimProofs):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key, types.KeyNextCampaignID):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		default:
+			panic(fmt.Sprintf("invalid claims key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/claims/simulation"
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	claimsRecord := types.NewClaimsRecord(sdk.NewInt(1000))
+	campaign := types.NewCampaign(1, "partner airdrop", addr, "acoin", time.Unix(0, 0).UTC(), time.Hour, time.Hour, []types.Action{types.ActionVote}, nil, "")
+	params := types.DefaultParams()
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetClaimsRecordKey(1, addr), Value: cdc.MustMarshal(&claimsRecord)},
+			{Key: append(types.KeyPrefixCampaigns, types.GetCampaignIDBytes(1)...), Value: cdc.MustMarshal(&campaign)},
+			{Key: types.KeyNextCampaignID, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: append(types.GetUsedClaimProofsPrefix(1), addr.Bytes()...), Value: []byte{1}},
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"ClaimsRecord", fmt.Sprintf("%v\n%v", claimsRecord, claimsRecord)},
+		{"Campaign", fmt.Sprintf("%v\n%v", campaign, campaign)},
+		{"NextCampaignID", "2\n2"},
+		{"UsedClaimProof", "[1]\n[1]"},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"other", ""},
+	}
+	for i, tc := range testCases {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			switch i {
+			case len(testCases) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tc.name)
+			default:
+				require.Equal(t, tc.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+// Simulation parameter constants
+const (
+	EnableClaims        = "enable_claims"
+	DurationUntilDecay  = "duration_until_decay"
+	DurationOfDecay     = "duration_of_decay"
+	CampaignCreationFee = "campaign_creation_fee"
+	MaxActiveCampaigns  = "max_active_campaigns"
+)
+
+// GenEnableClaims randomizes whether the Evmos airdrop claims are enabled
+func GenEnableClaims(r *rand.Rand) bool {
+	return r.Int63n(101) <= 50 // 50% chance of the claims being enabled
+}
+
+// GenDurationUntilDecay randomizes the duration of the Evmos airdrop before
+// the claimable amounts start to decay, between 1 hour and 30 days
+func GenDurationUntilDecay(r *rand.Rand) time.Duration {
+	return time.Duration(r.Int63n(30*24)+1) * time.Hour
+}
+
+// GenDurationOfDecay randomizes the duration of the Evmos airdrop decay
+// period, between 1 hour and 60 days
+func GenDurationOfDecay(r *rand.Rand) time.Duration {
+	return time.Duration(r.Int63n(60*24)+1) * time.Hour
+}
+
+// GenCampaignCreationFee randomizes the fee paid to create a partner campaign,
+// between 0 and 1000 units of the bond denom
+func GenCampaignCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(r.Int63n(1001))))
+}
+
+// GenMaxActiveCampaigns randomizes the maximum number of active partner
+// campaigns, between 1 and 20
+func GenMaxActiveCampaigns(r *rand.Rand) uint32 {
+	return uint32(r.Int63n(20) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for the claims module.
+// The Evmos airdrop doesn't contain any claims record as its escrow isn't
+// funded in the bank genesis; partner campaigns are created by the simulation
+// operations instead.
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableClaims bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableClaims, &enableClaims, simState.Rand,
+		func(r *rand.Rand) { enableClaims = GenEnableClaims(r) },
+	)
+
+	var durationUntilDecay time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DurationUntilDecay, &durationUntilDecay, simState.Rand,
+		func(r *rand.Rand) { durationUntilDecay = GenDurationUntilDecay(r) },
+	)
+
+	var durationOfDecay time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DurationOfDecay, &durationOfDecay, simState.Rand,
+		func(r *rand.Rand) { durationOfDecay = GenDurationOfDecay(r) },
+	)
+
+	var campaignCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CampaignCreationFee, &campaignCreationFee, simState.Rand,
+		func(r *rand.Rand) { campaignCreationFee = GenCampaignCreationFee(r) },
+	)
+
+	var maxActiveCampaigns uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxActiveCampaigns, &maxActiveCampaigns, simState.Rand,
+		func(r *rand.Rand) { maxActiveCampaigns = GenMaxActiveCampaigns(r) },
+	)
+
+	params := types.NewParams(
+		enableClaims,
+		sdk.DefaultBondDenom,
+		simState.GenTimestamp,
+		durationUntilDecay,
+		durationOfDecay,
+		types.DefaultAuthorizedChannels,
+		types.DefaultEVMChannels,
+		campaignCreationFee,
+		maxActiveCampaigns,
+	)
+
+	claimsGenesis := types.GenesisState{
+		Params:        params,
+		ClaimsRecords: []types.ClaimsRecordAddress{},
+		Campaigns:     []types.Campaign{},
+	}
+
+	bz, err := json.MarshalIndent(&claimsGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated claims parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&claimsGenesis)
+}
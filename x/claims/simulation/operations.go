@@ -0,0 +1,306 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/evmos/evmos/v11/x/claims/keeper"
+	"github.com/evmos/evmos/v11/x/claims/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateCampaign            = "op_weight_msg_create_campaign"        //nolint:gosec
+	OpWeightMsgAddClaimsRecords          = "op_weight_msg_add_claims_records"     //nolint:gosec
+	OpWeightMsgTransferClaimsRecord      = "op_weight_msg_transfer_claims_record" //nolint:gosec
+	DefaultWeightMsgCreateCampaign       = 10
+	DefaultWeightMsgAddClaimsRecords     = 30
+	DefaultWeightMsgTransferClaimsRecord = 10
+)
+
+// maxAllocations is the maximum number of claims records added by a single
+// MsgAddClaimsRecords
+const maxAllocations = 5
+
+// WeightedOperations returns all the claims module operations with their
+// respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgCreateCampaign, weightMsgAddClaimsRecords, weightMsgTransferClaimsRecord int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateCampaign, &weightMsgCreateCampaign, nil,
+		func(_ *rand.Rand) { weightMsgCreateCampaign = DefaultWeightMsgCreateCampaign },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddClaimsRecords, &weightMsgAddClaimsRecords, nil,
+		func(_ *rand.Rand) { weightMsgAddClaimsRecords = DefaultWeightMsgAddClaimsRecords },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferClaimsRecord, &weightMsgTransferClaimsRecord, nil,
+		func(_ *rand.Rand) { weightMsgTransferClaimsRecord = DefaultWeightMsgTransferClaimsRecord },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateCampaign,
+			SimulateMsgCreateCampaign(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddClaimsRecords,
+			SimulateMsgAddClaimsRecords(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferClaimsRecord,
+			SimulateMsgTransferClaimsRecord(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateCampaign generates a MsgCreateCampaign that escrows a
+// random amount of one of the creator coins in a partner campaign starting in
+// the future
+func SimulateMsgCreateCampaign(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		if k.GetActiveCampaignsCount(ctx) >= params.MaxActiveCampaigns {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateCampaign, "maximum number of active campaigns reached"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// NOTE: the creation fee is paid on top of the campaign funds
+		spendable, hasNeg := bk.SpendableCoins(ctx, simAccount.Address).SafeSub(params.CampaignCreationFee...)
+		if hasNeg || spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateCampaign, "insufficient balance"), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateCampaign, "unable to generate amount"), nil, err
+		}
+
+		var clawbackAddress string
+		if r.Intn(2) == 0 {
+			clawbackAcc, _ := simtypes.RandomAcc(r, accs)
+			clawbackAddress = clawbackAcc.Address.String()
+		}
+
+		msg := &types.MsgCreateCampaign{
+			Creator:            simAccount.Address.String(),
+			Name:               simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxCampaignNameLength+1)),
+			Amount:             sdk.NewCoin(coin.Denom, amount),
+			StartTime:          ctx.BlockTime().Add(randomDuration(r, 7*24*time.Hour)),
+			DurationUntilDecay: randomDuration(r, 7*24*time.Hour),
+			DurationOfDecay:    randomDuration(r, 7*24*time.Hour),
+			Actions:            randomActions(r),
+			ClawbackAddress:    clawbackAddress,
+			MerkleTotal:        math.ZeroInt(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: params.CampaignCreationFee.Add(msg.Amount),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgAddClaimsRecords generates a MsgAddClaimsRecords that allocates
+// the unallocated escrow of a partner campaign that hasn't started yet to
+// random simulation accounts
+func SimulateMsgAddClaimsRecords(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var campaigns []types.Campaign
+		k.IterateCampaigns(ctx, func(campaign types.Campaign) (stop bool) {
+			if campaign.ID != types.EvmosCampaignID && campaign.Enabled && !campaign.IsMerkle() && ctx.BlockTime().Before(campaign.StartTime) {
+				campaigns = append(campaigns, campaign)
+			}
+			return false
+		})
+		if len(campaigns) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddClaimsRecords, "no campaigns pending to start"), nil, nil
+		}
+
+		campaign := campaigns[r.Intn(len(campaigns))]
+		simAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(campaign.Creator))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddClaimsRecords, "campaign creator not found"), nil, nil
+		}
+
+		escrowAddr := sdk.MustAccAddressFromBech32(campaign.EscrowAddress)
+		unallocated := bk.GetBalance(ctx, escrowAddr, campaign.Denom).Amount.Sub(campaign.TotalAllocated)
+
+		numAllocations := simtypes.RandIntBetween(r, 1, maxAllocations+1)
+		if numAllocations > len(accs) {
+			numAllocations = len(accs)
+		}
+
+		var allocations []types.ClaimsAllocation
+		for _, i := range r.Perm(len(accs))[:numAllocations] {
+			if !unallocated.IsPositive() {
+				break
+			}
+
+			recipient := accs[i].Address
+			if k.HasCampaignClaimsRecord(ctx, campaign.ID, recipient) {
+				continue
+			}
+
+			amount, err := simtypes.RandPositiveInt(r, unallocated)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddClaimsRecords, "unable to generate amount"), nil, err
+			}
+
+			allocations = append(allocations, types.ClaimsAllocation{
+				Address: recipient.String(),
+				Amount:  amount,
+			})
+			unallocated = unallocated.Sub(amount)
+		}
+		if len(allocations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddClaimsRecords, "no claims records to add"), nil, nil
+		}
+
+		msg := &types.MsgAddClaimsRecords{
+			Creator:     campaign.Creator,
+			CampaignID:  campaign.ID,
+			Allocations: allocations,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgTransferClaimsRecord generates a MsgTransferClaimsRecord that
+// moves the claims record of a simulation account on an ongoing partner
+// campaign to another simulation account
+func SimulateMsgTransferClaimsRecord(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			campaigns []types.Campaign
+			senders   []simtypes.Account
+		)
+		// NOTE: the campaigns are read before iterating over their claims
+		// records as nested store iterators are not supported
+		for _, campaign := range k.GetCampaigns(ctx) {
+			if !campaign.Enabled || !ctx.BlockTime().Before(campaign.EndTime()) {
+				continue
+			}
+
+			k.IterateCampaignClaimsRecords(ctx, campaign.ID, func(addr sdk.AccAddress, _ types.ClaimsRecord) (stop bool) {
+				if simAccount, found := simtypes.FindAccount(accs, addr); found {
+					campaigns = append(campaigns, campaign)
+					senders = append(senders, simAccount)
+				}
+				return false
+			})
+		}
+		if len(senders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferClaimsRecord, "no claims records found"), nil, nil
+		}
+
+		i := r.Intn(len(senders))
+		simAccount := senders[i]
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		if recipient.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferClaimsRecord, "sender and recipient are the same"), nil, nil
+		}
+
+		msg := &types.MsgTransferClaimsRecord{
+			Sender:     simAccount.Address.String(),
+			Recipient:  recipient.Address.String(),
+			CampaignID: campaigns[i].ID,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomDuration returns a random duration between one second and the given
+// maximum
+func randomDuration(r *rand.Rand, maxDuration time.Duration) time.Duration {
+	return time.Duration(r.Int63n(int64(maxDuration/time.Second))+1) * time.Second
+}
+
+// randomActions returns a random non-empty subset of the claims actions
+func randomActions(r *rand.Rand) []types.Action {
+	actions := make([]types.Action, simtypes.RandIntBetween(r, 1, len(types.DefaultActions)+1))
+	for i, j := range r.Perm(len(types.DefaultActions))[:len(actions)] {
+		actions[i] = types.DefaultActions[j]
+	}
+	return actions
+}
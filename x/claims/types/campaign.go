@@ -0,0 +1,246 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// EvmosCampaignID is the identifier of the Evmos airdrop campaign, whose
+	// denom, schedule and status are governed by the module parameters
+	EvmosCampaignID uint64 = 0
+	// EvmosCampaignName is the name of the Evmos airdrop campaign
+	EvmosCampaignName = "Evmos Rektdrop"
+	// MaxCampaignNameLength is the maximum length of a campaign name
+	MaxCampaignNameLength = 140
+)
+
+// DefaultActions is the list of the qualifying actions of the Evmos airdrop
+var DefaultActions = []Action{ActionVote, ActionDelegate, ActionEVM, ActionIBCTransfer}
+
+// NewCampaign creates a new partner airdrop campaign instance. The campaign
+// funds are held by an escrow account derived from the campaign identifier.
+func NewCampaign(
+	id uint64,
+	name string,
+	creator sdk.AccAddress,
+	denom string,
+	startTime time.Time,
+	durationUntilDecay,
+	durationOfDecay time.Duration,
+	actions []Action,
+	contractActions []ContractAction,
+	clawbackAddress string,
+) Campaign {
+	return Campaign{
+		ID:                 id,
+		Name:               name,
+		Creator:            creator.String(),
+		Denom:              denom,
+		EscrowAddress:      GetCampaignEscrowAddress(id).String(),
+		StartTime:          startTime,
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+		Actions:            actions,
+		ContractActions:    contractActions,
+		ClawbackAddress:    clawbackAddress,
+		Enabled:            true,
+		TotalAllocated:     math.ZeroInt(),
+		TotalClaimed:       math.ZeroInt(),
+	}
+}
+
+// NewEvmosCampaign returns the Evmos airdrop campaign (campaign 0) with the
+// denom, schedule and status of the module parameters. Its funds are held by
+// the module account and the unclaimed tokens are clawed back to the community
+// pool.
+func NewEvmosCampaign(params Params, escrow sdk.AccAddress) Campaign {
+	return Campaign{
+		ID:                 EvmosCampaignID,
+		Name:               EvmosCampaignName,
+		Denom:              params.ClaimsDenom,
+		EscrowAddress:      escrow.String(),
+		StartTime:          params.AirdropStartTime,
+		DurationUntilDecay: params.DurationUntilDecay,
+		DurationOfDecay:    params.DurationOfDecay,
+		Actions:            DefaultActions,
+		Enabled:            params.EnableClaims,
+		TotalAllocated:     math.ZeroInt(),
+		TotalClaimed:       math.ZeroInt(),
+	}
+}
+
+// NewCampaignClaimsRecord creates a new claims record instance for the
+// campaign, with a completed flag for each of its contract actions
+func NewCampaignClaimsRecord(campaign Campaign, initialClaimableAmt math.Int) ClaimsRecord {
+	claimsRecord := NewClaimsRecord(initialClaimableAmt)
+	if len(campaign.ContractActions) > 0 {
+		claimsRecord.ContractActionsCompleted = make([]bool, len(campaign.ContractActions))
+	}
+	return claimsRecord
+}
+
+// GetCampaignEscrowAddress returns the escrow account address of a partner
+// campaign
+func GetCampaignEscrowAddress(campaignID uint64) sdk.AccAddress {
+	return address.Module(ModuleName, GetCampaignIDBytes(campaignID))
+}
+
+// Validate performs a stateless validation of the fields
+func (c Campaign) Validate() error {
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		return errors.New("campaign name cannot be blank")
+	}
+	if len(name) > MaxCampaignNameLength {
+		return fmt.Errorf("campaign name length exceeds %d characters", MaxCampaignNameLength)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.EscrowAddress); err != nil {
+		return fmt.Errorf("invalid escrow address: %w", err)
+	}
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	if c.StartTime.IsZero() {
+		return errors.New("start time cannot be zero")
+	}
+	if c.DurationUntilDecay <= 0 {
+		return fmt.Errorf("duration until decay must be positive: %d", c.DurationUntilDecay)
+	}
+	if c.DurationOfDecay <= 0 {
+		return fmt.Errorf("duration of decay must be positive: %d", c.DurationOfDecay)
+	}
+	if c.ActionsCount() == 0 {
+		return errors.New("campaign actions cannot be empty")
+	}
+	if err := ValidateActions(c.Actions); err != nil {
+		return err
+	}
+	if err := ValidateContractActions(c.ContractActions); err != nil {
+		return err
+	}
+	if c.ClawbackAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(c.ClawbackAddress); err != nil {
+			return fmt.Errorf("invalid clawback address: %w", err)
+		}
+	}
+	if c.TotalAllocated.IsNil() || c.TotalAllocated.IsNegative() {
+		return fmt.Errorf("invalid total allocated amount: %s", c.TotalAllocated)
+	}
+	if c.TotalClaimed.IsNil() || c.TotalClaimed.IsNegative() || c.TotalClaimed.GT(c.TotalAllocated) {
+		return fmt.Errorf("invalid total claimed amount: %s", c.TotalClaimed)
+	}
+	if len(c.MerkleRoot) != 0 && len(c.MerkleRoot) != MerkleHashLength {
+		return fmt.Errorf("invalid merkle root length, expected %d, got %d", MerkleHashLength, len(c.MerkleRoot))
+	}
+	return nil
+}
+
+// ValidateEvmosCampaign performs a stateless validation of the Evmos airdrop
+// campaign against the module parameters that govern it
+func (c Campaign) ValidateEvmosCampaign(params Params) error {
+	if _, err := sdk.AccAddressFromBech32(c.EscrowAddress); err != nil {
+		return fmt.Errorf("invalid escrow address: %w", err)
+	}
+	if c.Denom != params.ClaimsDenom {
+		return fmt.Errorf("evmos campaign denom %s differs from the claims denom %s", c.Denom, params.ClaimsDenom)
+	}
+	if len(c.ContractActions) != 0 || c.IsMerkle() || c.ClawbackAddress != "" {
+		return errors.New("evmos campaign cannot have contract actions, a merkle root or a clawback address")
+	}
+	if c.TotalAllocated.IsNil() || c.TotalAllocated.IsNegative() {
+		return fmt.Errorf("invalid total allocated amount: %s", c.TotalAllocated)
+	}
+	if c.TotalClaimed.IsNil() || c.TotalClaimed.IsNegative() {
+		return fmt.Errorf("invalid total claimed amount: %s", c.TotalClaimed)
+	}
+	return ValidateActions(c.Actions)
+}
+
+// IsMerkle returns true if the claims records of the campaign are created by
+// the recipients with a Merkle proof
+func (c Campaign) IsMerkle() bool {
+	return len(c.MerkleRoot) != 0
+}
+
+// Unclaimed returns the allocated amount that hasn't been released yet
+func (c Campaign) Unclaimed() math.Int {
+	return c.TotalAllocated.Sub(c.TotalClaimed)
+}
+
+// DecayStartTime returns the time at which the Decay period starts
+func (c Campaign) DecayStartTime() time.Time {
+	return c.StartTime.Add(c.DurationUntilDecay)
+}
+
+// EndTime returns the time at which no further claims will be processed.
+func (c Campaign) EndTime() time.Time {
+	return c.StartTime.Add(c.DurationUntilDecay).Add(c.DurationOfDecay)
+}
+
+// IsActive returns true if the campaign is enabled and the block time is
+// within the campaign start and end times
+func (c Campaign) IsActive(blockTime time.Time) bool {
+	if !c.Enabled || blockTime.Before(c.StartTime) || blockTime.After(c.EndTime()) {
+		return false
+	}
+	return true
+}
+
+// HasAction returns true if the action is a qualifying action of the campaign
+func (c Campaign) HasAction(action Action) bool {
+	for _, a := range c.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionsCount returns the number of qualifying actions of the campaign,
+// including the contract actions
+func (c Campaign) ActionsCount() int {
+	return len(c.Actions) + len(c.ContractActions)
+}
+
+// ValidateActions checks that the list of actions contains valid and unique
+// actions
+func ValidateActions(actions []Action) error {
+	seenActions := make(map[Action]bool)
+	for _, action := range actions {
+		if action == ActionUnspecified || int(action) > len(Action_value)-1 {
+			return errorsmod.Wrapf(ErrInvalidAction, "%d", action)
+		}
+		if seenActions[action] {
+			return fmt.Errorf("duplicated campaign action %s", action)
+		}
+		seenActions[action] = true
+	}
+	return nil
+}
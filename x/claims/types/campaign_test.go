@@ -0,0 +1,245 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaignValidate(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name     string
+		campaign Campaign
+		expError bool
+	}{
+		{
+			"valid campaign",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, DefaultActions, nil, ""),
+			false,
+		},
+		{
+			"valid campaign - clawback address",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, []Action{ActionEVM}, nil, addr.String()),
+			false,
+		},
+		{
+			"valid campaign - contract actions only",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, nil, []ContractAction{NewContractAction(tests.GenerateAddress(), "")}, ""),
+			false,
+		},
+		{
+			"invalid contract action",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, nil, []ContractAction{{Contract: "invalid"}}, ""),
+			true,
+		},
+		{
+			"blank name",
+			NewCampaign(1, " ", addr, "atest", now, time.Hour, time.Hour, DefaultActions, nil, ""),
+			true,
+		},
+		{
+			"invalid denom",
+			NewCampaign(1, "partner", addr, "", now, time.Hour, time.Hour, DefaultActions, nil, ""),
+			true,
+		},
+		{
+			"zero start time",
+			NewCampaign(1, "partner", addr, "atest", time.Time{}, time.Hour, time.Hour, DefaultActions, nil, ""),
+			true,
+		},
+		{
+			"non-positive duration until decay",
+			NewCampaign(1, "partner", addr, "atest", now, 0, time.Hour, DefaultActions, nil, ""),
+			true,
+		},
+		{
+			"non-positive duration of decay",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, -1, DefaultActions, nil, ""),
+			true,
+		},
+		{
+			"empty actions",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, []Action{}, nil, ""),
+			true,
+		},
+		{
+			"unspecified action",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, []Action{ActionUnspecified}, nil, ""),
+			true,
+		},
+		{
+			"duplicated action",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, []Action{ActionVote, ActionVote}, nil, ""),
+			true,
+		},
+		{
+			"invalid clawback address",
+			NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, DefaultActions, nil, "evmos1invalid"),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.campaign.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestCampaignIsActive(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	now := time.Now().UTC()
+	campaign := NewCampaign(1, "partner", addr, "atest", now, time.Hour, time.Hour, []Action{ActionVote}, nil, "")
+
+	require.False(t, campaign.IsActive(now.Add(-time.Second)))
+	require.True(t, campaign.IsActive(now))
+	require.True(t, campaign.IsActive(campaign.EndTime()))
+	require.False(t, campaign.IsActive(campaign.EndTime().Add(time.Second)))
+
+	campaign.Enabled = false
+	require.False(t, campaign.IsActive(now))
+
+	require.True(t, campaign.HasAction(ActionVote))
+	require.False(t, campaign.HasAction(ActionEVM))
+}
+
+func TestMsgAddClaimsRecordsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		msg      MsgAddClaimsRecords
+		expError bool
+	}{
+		{
+			"valid msg",
+			MsgAddClaimsRecords{addr.String(), 1, []ClaimsAllocation{{recipient.String(), sdk.NewInt(1)}}},
+			false,
+		},
+		{
+			"Evmos campaign",
+			MsgAddClaimsRecords{addr.String(), EvmosCampaignID, []ClaimsAllocation{{recipient.String(), sdk.NewInt(1)}}},
+			true,
+		},
+		{
+			"empty allocations",
+			MsgAddClaimsRecords{addr.String(), 1, []ClaimsAllocation{}},
+			true,
+		},
+		{
+			"duplicated allocation",
+			MsgAddClaimsRecords{addr.String(), 1, []ClaimsAllocation{{recipient.String(), sdk.NewInt(1)}, {recipient.String(), sdk.NewInt(2)}}},
+			true,
+		},
+		{
+			"non-positive amount",
+			MsgAddClaimsRecords{addr.String(), 1, []ClaimsAllocation{{recipient.String(), sdk.ZeroInt()}}},
+			true,
+		},
+		{
+			"invalid creator",
+			MsgAddClaimsRecords{"invalid", 1, []ClaimsAllocation{{recipient.String(), sdk.NewInt(1)}}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSubmitClaimProofValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	valAddr := sdk.ValAddress(tests.GenerateAddress().Bytes())
+	proof := [][]byte{make([]byte, MerkleHashLength)}
+	delegation := sdk.NewCoin("aevmos", sdk.NewInt(1))
+
+	testCases := []struct {
+		name     string
+		sender   sdk.AccAddress
+		proof    [][]byte
+		msgs     []sdk.Msg
+		expError bool
+	}{
+		{
+			"valid - submitted with a delegation",
+			addr,
+			proof,
+			[]sdk.Msg{stakingtypes.NewMsgDelegate(addr, valAddr, delegation)},
+			false,
+		},
+		{
+			"valid - submitted with a vote",
+			addr,
+			proof,
+			[]sdk.Msg{govv1.NewMsgVote(addr, 1, govv1.OptionYes, "")},
+			false,
+		},
+		{
+			"no claiming action",
+			addr,
+			proof,
+			[]sdk.Msg{},
+			true,
+		},
+		{
+			"not a claiming action",
+			addr,
+			proof,
+			[]sdk.Msg{banktypes.NewMsgSend(addr, other, sdk.NewCoins(delegation))},
+			true,
+		},
+		{
+			"claiming action of another address",
+			addr,
+			proof,
+			[]sdk.Msg{stakingtypes.NewMsgDelegate(other, valAddr, delegation)},
+			true,
+		},
+		{
+			"invalid claiming action",
+			addr,
+			proof,
+			[]sdk.Msg{stakingtypes.NewMsgDelegate(addr, valAddr, sdk.Coin{Denom: "aevmos", Amount: sdk.ZeroInt()})},
+			true,
+		},
+		{
+			"invalid proof node",
+			addr,
+			[][]byte{{1, 2}},
+			[]sdk.Msg{stakingtypes.NewMsgDelegate(addr, valAddr, delegation)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg, err := NewMsgSubmitClaimProof(tc.sender, 1, sdk.NewInt(1), tc.proof, tc.msgs)
+		require.NoError(t, err, tc.name)
+
+		err = msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -8,15 +8,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -131,6 +136,12 @@ type ClaimsRecordAddress struct {
 	InitialClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=initial_claimable_amount,json=initialClaimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_claimable_amount"`
 	// actions_completed is a slice that describes which actions were completed
 	ActionsCompleted []bool `protobuf:"varint,3,rep,packed,name=actions_completed,json=actionsCompleted,proto3" json:"actions_completed,omitempty"`
+	// campaign_id is the identifier of the airdrop campaign the record belongs to.
+	// The Evmos airdrop is campaign 0.
+	CampaignID uint64 `protobuf:"varint,4,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// contract_actions_completed is a slice that describes which contract actions
+	// of the campaign were completed
+	ContractActionsCompleted []bool `protobuf:"varint,5,rep,packed,name=contract_actions_completed,json=contractActionsCompleted,proto3" json:"contract_actions_completed,omitempty"`
 }
 
 func (m *ClaimsRecordAddress) Reset()         { *m = ClaimsRecordAddress{} }
@@ -180,6 +191,20 @@ func (m *ClaimsRecordAddress) GetActionsCompleted() []bool {
 	return nil
 }
 
+func (m *ClaimsRecordAddress) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *ClaimsRecordAddress) GetContractActionsCompleted() []bool {
+	if m != nil {
+		return m.ContractActionsCompleted
+	}
+	return nil
+}
+
 // ClaimsRecord defines the initial claimable airdrop amount and the list of
 // completed actions to claim the tokens.
 type ClaimsRecord struct {
@@ -187,6 +212,9 @@ type ClaimsRecord struct {
 	InitialClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=initial_claimable_amount,json=initialClaimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_claimable_amount"`
 	// actions_completed is a slice that describes which actions were completed
 	ActionsCompleted []bool `protobuf:"varint,2,rep,packed,name=actions_completed,json=actionsCompleted,proto3" json:"actions_completed,omitempty"`
+	// contract_actions_completed is a slice that describes which contract actions
+	// of the campaign were completed
+	ContractActionsCompleted []bool `protobuf:"varint,3,rep,packed,name=contract_actions_completed,json=contractActionsCompleted,proto3" json:"contract_actions_completed,omitempty"`
 }
 
 func (m *ClaimsRecord) Reset()         { *m = ClaimsRecord{} }
@@ -229,49 +257,419 @@ func (m *ClaimsRecord) GetActionsCompleted() []bool {
 	return nil
 }
 
+func (m *ClaimsRecord) GetContractActionsCompleted() []bool {
+	if m != nil {
+		return m.ContractActionsCompleted
+	}
+	return nil
+}
+
+// Campaign defines an airdrop campaign that distributes the tokens held in its
+// escrow account to the recipients that complete the qualifying actions.
+type Campaign struct {
+	// id is the unique identifier of the campaign. The Evmos airdrop is campaign 0.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is a human readable description of the campaign
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// creator is the address of the account that created and funded the campaign
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// denom is the denomination of the airdropped coin
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// escrow_address is the account that holds the funds of the campaign
+	EscrowAddress string `protobuf:"bytes,5,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	// start_time defines the timestamp of the campaign start
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration_until_decay of claimable tokens begin
+	DurationUntilDecay time.Duration `protobuf:"bytes,7,opt,name=duration_until_decay,json=durationUntilDecay,proto3,stdduration" json:"duration_until_decay"`
+	// duration_of_decay for token claim decay period
+	DurationOfDecay time.Duration `protobuf:"bytes,8,opt,name=duration_of_decay,json=durationOfDecay,proto3,stdduration" json:"duration_of_decay"`
+	// actions is the list of qualifying actions to claim the campaign tokens
+	Actions []Action `protobuf:"varint,9,rep,packed,name=actions,proto3,enum=evmos.claims.v1.Action" json:"actions,omitempty"`
+	// clawback_address is the recipient of the unclaimed tokens once the campaign
+	// ends. If empty, the tokens are sent to the community pool.
+	ClawbackAddress string `protobuf:"bytes,10,opt,name=clawback_address,json=clawbackAddress,proto3" json:"clawback_address,omitempty"`
+	// enabled is true while the campaign has not ended
+	Enabled bool `protobuf:"varint,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// total_allocated is the sum of the initial claimable amounts of the campaign
+	// claims records. For Merkle campaigns, it is the total declared for the root.
+	TotalAllocated github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=total_allocated,json=totalAllocated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_allocated"`
+	// contract_actions is the list of qualifying EVM contract interactions to
+	// claim the campaign tokens
+	ContractActions []ContractAction `protobuf:"bytes,13,rep,name=contract_actions,json=contractActions,proto3" json:"contract_actions"`
+	// merkle_root is the root of the Merkle tree of the campaign allocations. If
+	// set, the claims records are created by the recipients with a Merkle proof.
+	MerkleRoot []byte `protobuf:"bytes,14,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// total_claimed is the amount released from the allocations, including the
+	// amount lost to the decay
+	TotalClaimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=total_claimed,json=totalClaimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_claimed"`
+}
+
+func (m *Campaign) Reset()         { *m = Campaign{} }
+func (m *Campaign) String() string { return proto.CompactTextString(m) }
+func (*Campaign) ProtoMessage()    {}
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{3}
+}
+func (m *Campaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Campaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Campaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Campaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Campaign.Merge(m, src)
+}
+func (m *Campaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *Campaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_Campaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Campaign proto.InternalMessageInfo
+
+func (m *Campaign) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Campaign) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Campaign) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Campaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Campaign) GetEscrowAddress() string {
+	if m != nil {
+		return m.EscrowAddress
+	}
+	return ""
+}
+
+func (m *Campaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Campaign) GetDurationUntilDecay() time.Duration {
+	if m != nil {
+		return m.DurationUntilDecay
+	}
+	return 0
+}
+
+func (m *Campaign) GetDurationOfDecay() time.Duration {
+	if m != nil {
+		return m.DurationOfDecay
+	}
+	return 0
+}
+
+func (m *Campaign) GetActions() []Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *Campaign) GetClawbackAddress() string {
+	if m != nil {
+		return m.ClawbackAddress
+	}
+	return ""
+}
+
+func (m *Campaign) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Campaign) GetContractActions() []ContractAction {
+	if m != nil {
+		return m.ContractActions
+	}
+	return nil
+}
+
+func (m *Campaign) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+// ContractAction defines a qualifying action that is completed by an EVM
+// transaction that interacts with a contract.
+type ContractAction struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// event_signature is the optional event signature (e.g.
+	// "Transfer(address,address,uint256)") that the contract must emit. If empty,
+	// any transaction sent to the contract or that emits a log from it completes
+	// the action.
+	EventSignature string `protobuf:"bytes,2,opt,name=event_signature,json=eventSignature,proto3" json:"event_signature,omitempty"`
+}
+
+func (m *ContractAction) Reset()         { *m = ContractAction{} }
+func (m *ContractAction) String() string { return proto.CompactTextString(m) }
+func (*ContractAction) ProtoMessage()    {}
+func (*ContractAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{4}
+}
+func (m *ContractAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAction.Merge(m, src)
+}
+func (m *ContractAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAction proto.InternalMessageInfo
+
+func (m *ContractAction) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractAction) GetEventSignature() string {
+	if m != nil {
+		return m.EventSignature
+	}
+	return ""
+}
+
+// ContractClaim defines the contract action, completed flag and the remaining
+// claimable amount for a given user. This is only used during client queries.
+type ContractClaim struct {
+	// contract_action of the campaign
+	ContractAction ContractAction `protobuf:"bytes,1,opt,name=contract_action,json=contractAction,proto3" json:"contract_action"`
+	// completed is true if the action has been completed
+	Completed bool `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	// claimable_amount of tokens for the action. Zero if completed
+	ClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=claimable_amount,json=claimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable_amount"`
+}
+
+func (m *ContractClaim) Reset()         { *m = ContractClaim{} }
+func (m *ContractClaim) String() string { return proto.CompactTextString(m) }
+func (*ContractClaim) ProtoMessage()    {}
+func (*ContractClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{5}
+}
+func (m *ContractClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractClaim.Merge(m, src)
+}
+func (m *ContractClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractClaim proto.InternalMessageInfo
+
+func (m *ContractClaim) GetContractAction() ContractAction {
+	if m != nil {
+		return m.ContractAction
+	}
+	return ContractAction{}
+}
+
+func (m *ContractClaim) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+// ClaimsAllocation defines the initial claimable amount of a campaign recipient.
+type ClaimsAllocation struct {
+	// address of the recipient in bech32 format
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the initial claimable amount of the recipient
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ClaimsAllocation) Reset()         { *m = ClaimsAllocation{} }
+func (m *ClaimsAllocation) String() string { return proto.CompactTextString(m) }
+func (*ClaimsAllocation) ProtoMessage()    {}
+func (*ClaimsAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{6}
+}
+func (m *ClaimsAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimsAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimsAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimsAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimsAllocation.Merge(m, src)
+}
+func (m *ClaimsAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimsAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimsAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimsAllocation proto.InternalMessageInfo
+
+func (m *ClaimsAllocation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("evmos.claims.v1.Action", Action_name, Action_value)
 	proto.RegisterType((*Claim)(nil), "evmos.claims.v1.Claim")
 	proto.RegisterType((*ClaimsRecordAddress)(nil), "evmos.claims.v1.ClaimsRecordAddress")
 	proto.RegisterType((*ClaimsRecord)(nil), "evmos.claims.v1.ClaimsRecord")
+	proto.RegisterType((*Campaign)(nil), "evmos.claims.v1.Campaign")
+	proto.RegisterType((*ContractAction)(nil), "evmos.claims.v1.ContractAction")
+	proto.RegisterType((*ContractClaim)(nil), "evmos.claims.v1.ContractClaim")
+	proto.RegisterType((*ClaimsAllocation)(nil), "evmos.claims.v1.ClaimsAllocation")
 }
 
 func init() { proto.RegisterFile("evmos/claims/v1/claims.proto", fileDescriptor_a7153f2307523893) }
 
 var fileDescriptor_a7153f2307523893 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xc7, 0x3d, 0x49, 0x09, 0xcd, 0x00, 0x89, 0x3b, 0xe5, 0x23, 0xb2, 0x8a, 0x63, 0x65, 0x01,
-	0x01, 0x54, 0x9b, 0xc0, 0x09, 0x1c, 0xc7, 0x45, 0x96, 0x68, 0x8a, 0x5c, 0x37, 0x12, 0x6c, 0x2c,
-	0xc7, 0x9e, 0xa6, 0x16, 0xb6, 0x27, 0xf2, 0x4c, 0x23, 0xb8, 0x01, 0xca, 0x8a, 0x0b, 0x64, 0x85,
-	0x38, 0x00, 0x97, 0x40, 0x5d, 0x76, 0x85, 0x10, 0x8b, 0x0a, 0x25, 0x17, 0x41, 0x9d, 0x71, 0x68,
-	0xf8, 0x10, 0x0b, 0xa4, 0x6e, 0xec, 0x37, 0xef, 0xff, 0x9b, 0xa7, 0xff, 0x7b, 0xa3, 0x07, 0xb7,
-	0xf0, 0x24, 0x25, 0xd4, 0x08, 0x93, 0x20, 0x4e, 0xa9, 0x31, 0xe9, 0x14, 0x91, 0x3e, 0xce, 0x09,
-	0x23, 0xa8, 0xce, 0x55, 0xbd, 0xc8, 0x4d, 0x3a, 0xca, 0xcd, 0x11, 0x19, 0x11, 0xae, 0x19, 0xe7,
-	0x91, 0xc0, 0x5a, 0x9f, 0x00, 0xbc, 0x62, 0x9d, 0x33, 0xc8, 0x80, 0x95, 0x20, 0x64, 0x31, 0xc9,
-	0x1a, 0x40, 0x03, 0xed, 0xda, 0x93, 0x3b, 0xfa, 0x6f, 0x15, 0x74, 0x93, 0xcb, 0x6e, 0x81, 0xa1,
-	0x2d, 0x58, 0x0d, 0x49, 0x3a, 0x4e, 0x30, 0xc3, 0x51, 0xa3, 0xa4, 0x81, 0xf6, 0xba, 0x7b, 0x91,
-	0x40, 0x2f, 0xa1, 0xcc, 0x6f, 0x06, 0xc3, 0x04, 0xfb, 0x41, 0x4a, 0x8e, 0x33, 0xd6, 0x28, 0x6b,
-	0xa0, 0x5d, 0xed, 0xea, 0x27, 0x67, 0x4d, 0xe9, 0xdb, 0x59, 0xf3, 0xde, 0x28, 0x66, 0x47, 0xc7,
-	0x43, 0x3d, 0x24, 0xa9, 0x11, 0x12, 0xca, 0x7b, 0xe1, 0xbf, 0x6d, 0x1a, 0xbd, 0x36, 0xd8, 0xdb,
-	0x31, 0xa6, 0xba, 0x93, 0x31, 0xb7, 0xfe, 0xb3, 0x8e, 0xc9, 0xcb, 0xb4, 0x3e, 0x03, 0xb8, 0xc9,
-	0x3d, 0x53, 0x17, 0x87, 0x24, 0x8f, 0xcc, 0x28, 0xca, 0x31, 0xa5, 0xa8, 0x01, 0xaf, 0x06, 0x22,
-	0xe4, 0x2d, 0x54, 0xdd, 0xe5, 0x11, 0x1d, 0xc1, 0x46, 0x9c, 0xc5, 0x2c, 0x0e, 0x12, 0xff, 0x0f,
-	0x53, 0xa5, 0xff, 0x32, 0x75, 0xbb, 0xa8, 0x67, 0xfd, 0xea, 0x0d, 0x3d, 0x82, 0x1b, 0x62, 0x3c,
-	0xd4, 0xbf, 0x18, 0x4e, 0x59, 0x2b, 0xb7, 0xd7, 0x5d, 0xb9, 0x10, 0xac, 0x65, 0xbe, 0xf5, 0x11,
-	0xc0, 0xeb, 0xab, 0x8d, 0xfc, 0xd3, 0x27, 0xb8, 0x7c, 0x9f, 0xa5, 0xbf, 0xfb, 0x7c, 0xf8, 0x05,
-	0xc0, 0x8a, 0x78, 0x7c, 0xb4, 0x0d, 0x91, 0x69, 0x79, 0xce, 0x5e, 0xdf, 0x3f, 0xe8, 0xef, 0xbf,
-	0xb0, 0x2d, 0x67, 0xc7, 0xb1, 0x7b, 0xb2, 0xa4, 0xdc, 0x9a, 0xce, 0xb4, 0x0d, 0xc1, 0x1c, 0x64,
-	0x74, 0x8c, 0xc3, 0xf8, 0x30, 0xc6, 0x11, 0x6a, 0xc2, 0x6b, 0x05, 0x3e, 0xd8, 0xf3, 0x6c, 0x19,
-	0x28, 0xb5, 0xe9, 0x4c, 0x83, 0x82, 0x1b, 0x10, 0x86, 0xd1, 0x7d, 0x58, 0x2f, 0x80, 0x9e, 0xfd,
-	0xdc, 0x7e, 0x66, 0x7a, 0xb6, 0x5c, 0x52, 0xd0, 0x74, 0xa6, 0xd5, 0x04, 0xd4, 0xc3, 0x09, 0x1e,
-	0x05, 0x0c, 0xa3, 0xbb, 0x10, 0x16, 0xa0, 0x3d, 0xd8, 0x95, 0xcb, 0xca, 0x8d, 0xe9, 0x4c, 0xab,
-	0x0a, 0xc6, 0x1e, 0xec, 0x22, 0x1d, 0x6e, 0x16, 0xb2, 0xd3, 0xb5, 0x7c, 0xcf, 0x35, 0xfb, 0xfb,
-	0x3b, 0xb6, 0x2b, 0xaf, 0xad, 0x1a, 0x73, 0xba, 0x96, 0x97, 0x07, 0x19, 0x3d, 0xc4, 0xb9, 0xb2,
-	0xf6, 0xee, 0x83, 0x2a, 0x75, 0xad, 0x93, 0xb9, 0x0a, 0x4e, 0xe7, 0x2a, 0xf8, 0x3e, 0x57, 0xc1,
-	0xfb, 0x85, 0x2a, 0x9d, 0x2e, 0x54, 0xe9, 0xeb, 0x42, 0x95, 0x5e, 0x3d, 0x58, 0x99, 0xaf, 0xd8,
-	0x33, 0xf1, 0x9d, 0x74, 0x1e, 0x1b, 0x6f, 0x96, 0x3b, 0xc7, 0xc7, 0x3c, 0xac, 0xf0, 0x4d, 0x7a,
-	0xfa, 0x23, 0x00, 0x00, 0xff, 0xff, 0x45, 0x87, 0x73, 0x57, 0x90, 0x03, 0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0x6c, 0xc7, 0xb5, 0x5f, 0x12, 0xd9, 0x61, 0xb3, 0x4e, 0x13, 0x3a, 0x5b, 0x08, 0xb0,
+	0xcd, 0xdd, 0x50, 0x09, 0xc9, 0xae, 0xbb, 0xd8, 0xb2, 0x33, 0x18, 0x58, 0x93, 0x42, 0x71, 0x32,
+	0x6c, 0x17, 0x81, 0x96, 0x68, 0x57, 0x88, 0x24, 0x1a, 0x12, 0xed, 0xae, 0xff, 0x60, 0xc8, 0xa9,
+	0xc0, 0x2e, 0xbb, 0xe4, 0xb4, 0x5f, 0xb0, 0xc3, 0xfe, 0x43, 0x8f, 0x3d, 0x0d, 0xc5, 0x0e, 0xd9,
+	0xe0, 0xfc, 0x91, 0x41, 0xa4, 0xe8, 0x26, 0x0e, 0x9a, 0x15, 0x19, 0x86, 0x5d, 0x6c, 0xf2, 0xbd,
+	0x8f, 0x1f, 0xdf, 0xfb, 0xf8, 0x1e, 0x29, 0x78, 0x48, 0xe6, 0x11, 0x4d, 0x2d, 0x2f, 0xc4, 0x41,
+	0x94, 0x5a, 0xf3, 0xdd, 0x7c, 0x64, 0x4e, 0x13, 0xca, 0x28, 0xaa, 0x73, 0xaf, 0x99, 0xdb, 0xe6,
+	0xbb, 0xfa, 0xf6, 0x84, 0x4e, 0x28, 0xf7, 0x59, 0xd9, 0x48, 0xc0, 0xf4, 0xe6, 0x84, 0xd2, 0x49,
+	0x48, 0x2c, 0x3e, 0x1b, 0xcd, 0xc6, 0x96, 0x3f, 0x4b, 0x30, 0x0b, 0x68, 0x9c, 0xfb, 0x5b, 0xab,
+	0x7e, 0x16, 0x44, 0x24, 0x65, 0x38, 0x9a, 0x0a, 0xc0, 0xce, 0xaf, 0x0a, 0xac, 0xd9, 0xd9, 0x26,
+	0xc8, 0x82, 0x0a, 0xf6, 0xb2, 0xa5, 0x9a, 0x62, 0x28, 0x6d, 0x75, 0xef, 0x43, 0x73, 0x25, 0x04,
+	0xb3, 0xc3, 0xdd, 0x4e, 0x0e, 0x43, 0x0f, 0xa1, 0xe6, 0xd1, 0x68, 0x1a, 0x12, 0x46, 0x7c, 0xad,
+	0x68, 0x28, 0xed, 0xaa, 0xf3, 0xd6, 0x80, 0xbe, 0x83, 0x06, 0x5f, 0x89, 0x47, 0x21, 0x71, 0x71,
+	0x44, 0x67, 0x31, 0xd3, 0x4a, 0x86, 0xd2, 0xae, 0x75, 0xcd, 0x57, 0x17, 0xad, 0xc2, 0x1f, 0x17,
+	0xad, 0x4f, 0x27, 0x01, 0x7b, 0x36, 0x1b, 0x99, 0x1e, 0x8d, 0x2c, 0x8f, 0xa6, 0x5c, 0x0c, 0xfe,
+	0xf7, 0x38, 0xf5, 0x4f, 0x2d, 0xf6, 0x62, 0x4a, 0x52, 0x73, 0x10, 0x33, 0xa7, 0xbe, 0xe4, 0xe9,
+	0x70, 0x9a, 0x9d, 0xdf, 0x8a, 0x70, 0x9f, 0xc7, 0x9c, 0x3a, 0xc4, 0xa3, 0x89, 0xdf, 0xf1, 0xfd,
+	0x84, 0xa4, 0x29, 0xd2, 0xe0, 0x1e, 0x16, 0x43, 0x9e, 0x42, 0xcd, 0x91, 0x53, 0xf4, 0x0c, 0xb4,
+	0x20, 0x0e, 0x58, 0x80, 0x43, 0xf7, 0x46, 0x50, 0xc5, 0x3b, 0x05, 0xf5, 0x20, 0xe7, 0xb3, 0xaf,
+	0xc7, 0x86, 0xbe, 0x80, 0x2d, 0x21, 0x4f, 0xea, 0xbe, 0x15, 0xa7, 0x64, 0x94, 0xda, 0x55, 0xa7,
+	0x91, 0x3b, 0xec, 0xa5, 0x46, 0x16, 0xac, 0x7b, 0x38, 0x9a, 0xe2, 0x60, 0x12, 0xbb, 0x81, 0xaf,
+	0x95, 0x0d, 0xa5, 0x5d, 0xee, 0xaa, 0x8b, 0x8b, 0x16, 0xd8, 0xb9, 0x79, 0xd0, 0x73, 0x40, 0x42,
+	0x06, 0x3e, 0xfa, 0x0a, 0x74, 0x8f, 0xc6, 0x2c, 0xc1, 0x1e, 0x73, 0x6f, 0x6e, 0xb3, 0xc6, 0xb7,
+	0xd1, 0x24, 0xa2, 0xb3, 0xb2, 0xdd, 0xce, 0xa5, 0x02, 0x1b, 0x57, 0x75, 0xbb, 0x55, 0x16, 0xe5,
+	0xbf, 0x97, 0xa5, 0xf8, 0x0e, 0x59, 0x6e, 0xcf, 0xb2, 0xf4, 0x0f, 0x59, 0xfe, 0x54, 0x81, 0xaa,
+	0x94, 0x0f, 0x3d, 0x80, 0x62, 0xe0, 0xf3, 0x5c, 0xca, 0xdd, 0xca, 0xe2, 0xa2, 0x55, 0x1c, 0xf4,
+	0x9c, 0x62, 0xe0, 0x23, 0x04, 0xe5, 0x18, 0x47, 0x44, 0x1c, 0xbe, 0xc3, 0xc7, 0x59, 0xf9, 0x78,
+	0x09, 0xc1, 0x8c, 0x26, 0xa2, 0x50, 0x1d, 0x39, 0x45, 0xdb, 0xb0, 0xe6, 0x93, 0x98, 0x46, 0xfc,
+	0x84, 0x6a, 0x8e, 0x98, 0xa0, 0x4f, 0x40, 0x25, 0xa9, 0x97, 0xd0, 0xe7, 0xae, 0xac, 0xba, 0x35,
+	0xee, 0xde, 0x14, 0x56, 0x59, 0x95, 0x36, 0x40, 0xca, 0x70, 0xc2, 0xdc, 0xac, 0xf5, 0xb4, 0x8a,
+	0xa1, 0xb4, 0xd7, 0xf7, 0x74, 0x53, 0xf4, 0xa5, 0x29, 0xfb, 0xd2, 0x1c, 0xca, 0xbe, 0xec, 0x56,
+	0x33, 0xc9, 0x5f, 0xfe, 0xd9, 0x52, 0x9c, 0x1a, 0x5f, 0x97, 0x79, 0xd0, 0x31, 0x6c, 0xcb, 0xce,
+	0x76, 0x67, 0x31, 0x0b, 0x42, 0xd7, 0x27, 0x1e, 0x7e, 0xa1, 0xdd, 0xe3, 0x74, 0x1f, 0xdd, 0xa0,
+	0xeb, 0xe5, 0x60, 0xc1, 0xf6, 0x73, 0xc6, 0x86, 0x24, 0xc1, 0x71, 0xb6, 0xbe, 0x97, 0x2d, 0x47,
+	0x87, 0xb0, 0xb5, 0xa4, 0xa5, 0xe3, 0x9c, 0xb3, 0xfa, 0xfe, 0x9c, 0x75, 0xb9, 0xfa, 0x70, 0x2c,
+	0x08, 0x77, 0xe1, 0x5e, 0x7e, 0x62, 0x5a, 0xcd, 0x28, 0xdd, 0x76, 0x8b, 0x48, 0x1c, 0x7a, 0xc4,
+	0x2f, 0x8a, 0xe7, 0x23, 0xec, 0x9d, 0x2e, 0x85, 0x04, 0x2e, 0x64, 0x5d, 0xda, 0xaf, 0x34, 0x38,
+	0x89, 0xb3, 0xaa, 0xf2, 0xb5, 0x75, 0x7e, 0xdf, 0xc8, 0x29, 0xfa, 0x16, 0xea, 0x8c, 0x32, 0x1c,
+	0xba, 0x38, 0x0c, 0xa9, 0x87, 0xb3, 0x3a, 0xd9, 0xb8, 0x53, 0x01, 0xab, 0x9c, 0xa6, 0x23, 0x59,
+	0xd0, 0x53, 0x68, 0xac, 0xd6, 0xa2, 0xb6, 0x69, 0x94, 0xda, 0xeb, 0x7b, 0xad, 0x1b, 0x99, 0xd9,
+	0xd7, 0x4a, 0xb2, 0x5b, 0xce, 0xb6, 0x76, 0xea, 0x2b, 0x85, 0x8a, 0x5a, 0xb0, 0x1e, 0x91, 0xe4,
+	0x34, 0x24, 0x6e, 0x42, 0x29, 0xd3, 0x54, 0x43, 0x69, 0x6f, 0x38, 0x20, 0x4c, 0x0e, 0xa5, 0x0c,
+	0x1d, 0xc1, 0xa6, 0xc8, 0x85, 0x33, 0x13, 0x5f, 0xab, 0xdf, 0x29, 0x93, 0x0d, 0x4e, 0x62, 0x0b,
+	0x8e, 0x9d, 0x63, 0x50, 0xaf, 0x87, 0x87, 0x74, 0xa8, 0xca, 0xd0, 0xf2, 0xeb, 0x72, 0x39, 0x47,
+	0x9f, 0x41, 0x9d, 0xcc, 0x49, 0xcc, 0xdc, 0x34, 0x98, 0xc4, 0x98, 0xcd, 0x12, 0xd9, 0x29, 0x2a,
+	0x37, 0x1f, 0x49, 0xeb, 0xce, 0x1b, 0x05, 0x36, 0x25, 0xaf, 0x78, 0x46, 0x0e, 0xa0, 0xbe, 0x22,
+	0x18, 0x67, 0x7f, 0x6f, 0xbd, 0xd4, 0xeb, 0x7a, 0xfd, 0x7f, 0xaf, 0x0c, 0x83, 0x86, 0xb8, 0x2c,
+	0xf3, 0x62, 0xc8, 0x82, 0x79, 0xf7, 0x0b, 0xb3, 0x0f, 0x95, 0x7f, 0xf5, 0x9e, 0xe4, 0xab, 0x3f,
+	0xff, 0x5d, 0x81, 0x4a, 0x9e, 0xf9, 0x63, 0x40, 0x1d, 0x7b, 0x38, 0x38, 0x3c, 0x70, 0x8f, 0x0f,
+	0x8e, 0x9e, 0xf6, 0xed, 0xc1, 0xfe, 0xa0, 0xdf, 0x6b, 0x14, 0xf4, 0x0f, 0xce, 0xce, 0x8d, 0x2d,
+	0x81, 0x39, 0x8e, 0xd3, 0x29, 0xf1, 0x82, 0x71, 0x40, 0xfc, 0xac, 0xae, 0x72, 0xf8, 0xc9, 0xe1,
+	0xb0, 0xdf, 0x50, 0x74, 0xf5, 0xec, 0xdc, 0x00, 0x81, 0x3b, 0xa1, 0x8c, 0x64, 0x87, 0x9a, 0x03,
+	0x7a, 0xfd, 0x6f, 0xfa, 0x5f, 0x77, 0x86, 0xfd, 0x46, 0x51, 0x47, 0x67, 0xe7, 0x86, 0x2a, 0x40,
+	0x3d, 0x12, 0x92, 0x09, 0x66, 0x04, 0x7d, 0x0c, 0x90, 0x03, 0xfb, 0x27, 0x4f, 0x1a, 0x25, 0x7d,
+	0xf3, 0xec, 0xdc, 0xa8, 0x09, 0x4c, 0xff, 0xe4, 0x09, 0x32, 0xe1, 0x7e, 0xee, 0x1e, 0x74, 0x6d,
+	0x77, 0xe8, 0x74, 0x0e, 0x8e, 0xf6, 0xfb, 0x4e, 0xa3, 0x7c, 0x35, 0xb0, 0x41, 0xd7, 0x1e, 0x26,
+	0x38, 0x4e, 0xc7, 0x24, 0xd1, 0xcb, 0x3f, 0xfe, 0xd2, 0x2c, 0x74, 0xed, 0x57, 0x8b, 0xa6, 0xf2,
+	0x7a, 0xd1, 0x54, 0xfe, 0x5a, 0x34, 0x95, 0x97, 0x97, 0xcd, 0xc2, 0xeb, 0xcb, 0x66, 0xe1, 0xcd,
+	0x65, 0xb3, 0xf0, 0xfd, 0xa3, 0x2b, 0x12, 0x89, 0x6f, 0x22, 0xf1, 0x3b, 0xdf, 0xdd, 0xb5, 0x7e,
+	0x90, 0xdf, 0x47, 0x5c, 0xa9, 0x51, 0x85, 0x5f, 0x46, 0x5f, 0xfe, 0x3d, 0x00, 0xf6, 0xcf, 0x00,
+	0xd3, 0x3c, 0x09, 0x00, 0x00,
 }
 
 func (m *Claim) Marshal() (dAtA []byte, err error) {
@@ -342,6 +740,24 @@ func (m *ClaimsRecordAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractActionsCompleted) > 0 {
+		for iNdEx := len(m.ContractActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ContractActionsCompleted[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.ContractActionsCompleted)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ActionsCompleted) > 0 {
 		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
 			i--
@@ -395,6 +811,19 @@ func (m *ClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractActionsCompleted) > 0 {
+		for iNdEx := len(m.ContractActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ContractActionsCompleted[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.ContractActionsCompleted)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ActionsCompleted) > 0 {
 		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
 			i--
@@ -421,49 +850,341 @@ func (m *ClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
-	offset -= sovClaims(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Campaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Claim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Action != 0 {
-		n += 1 + sovClaims(uint64(m.Action))
-	}
-	if m.Completed {
-		n += 2
-	}
-	l = m.ClaimableAmount.Size()
-	n += 1 + l + sovClaims(uint64(l))
-	return n
+
+func (m *Campaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimsRecordAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovClaims(uint64(l))
+	{
+		size := m.TotalClaimed.Size()
+		i -= size
+		if _, err := m.TotalClaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ContractActions) > 0 {
+		for iNdEx := len(m.ContractActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.TotalAllocated.Size()
+		i -= size
+		if _, err := m.TotalAllocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ClawbackAddress) > 0 {
+		i -= len(m.ClawbackAddress)
+		copy(dAtA[i:], m.ClawbackAddress)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.ClawbackAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Actions) > 0 {
+		dAtA2 := make([]byte, len(m.Actions)*10)
+		var j1 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintClaims(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintClaims(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintClaims(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintClaims(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventSignature) > 0 {
+		i -= len(m.EventSignature)
+		copy(dAtA[i:], m.EventSignature)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.EventSignature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimableAmount.Size()
+		i -= size
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ContractAction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimsAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimsAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaims(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovClaims(uint64(m.Action))
+	}
+	if m.Completed {
+		n += 2
+	}
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *ClaimsRecordAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
 	}
 	l = m.InitialClaimableAmount.Size()
 	n += 1 + l + sovClaims(uint64(l))
 	if len(m.ActionsCompleted) > 0 {
 		n += 1 + sovClaims(uint64(len(m.ActionsCompleted))) + len(m.ActionsCompleted)*1
 	}
+	if m.CampaignID != 0 {
+		n += 1 + sovClaims(uint64(m.CampaignID))
+	}
+	if len(m.ContractActionsCompleted) > 0 {
+		n += 1 + sovClaims(uint64(len(m.ContractActionsCompleted))) + len(m.ContractActionsCompleted)*1
+	}
 	return n
 }
 
@@ -478,16 +1199,1304 @@ func (m *ClaimsRecord) Size() (n int) {
 	if len(m.ActionsCompleted) > 0 {
 		n += 1 + sovClaims(uint64(len(m.ActionsCompleted))) + len(m.ActionsCompleted)*1
 	}
+	if len(m.ContractActionsCompleted) > 0 {
+		n += 1 + sovClaims(uint64(len(m.ContractActionsCompleted))) + len(m.ContractActionsCompleted)*1
+	}
 	return n
 }
 
-func sovClaims(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Campaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovClaims(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovClaims(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay)
+	n += 1 + l + sovClaims(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay)
+	n += 1 + l + sovClaims(uint64(l))
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovClaims(uint64(e))
+		}
+		n += 1 + sovClaims(uint64(l)) + l
+	}
+	l = len(m.ClawbackAddress)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.TotalAllocated.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	if len(m.ContractActions) > 0 {
+		for _, e := range m.ContractActions {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
 }
-func sozClaims(x uint64) (n int) {
-	return sovClaims(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ContractAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.EventSignature)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractAction.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	if m.Completed {
+		n += 2
+	}
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *ClaimsAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func sovClaims(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaims(x uint64) (n int) {
+	return sovClaims(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Claim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimsRecordAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimsRecordAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
+					m.ActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ContractActionsCompleted = append(m.ContractActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ContractActionsCompleted) == 0 {
+					m.ContractActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ContractActionsCompleted = append(m.ContractActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractActionsCompleted", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
+					m.ActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ContractActionsCompleted = append(m.ContractActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ContractActionsCompleted) == 0 {
+					m.ContractActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ContractActionsCompleted = append(m.ContractActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractActionsCompleted", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Campaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Campaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Campaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationUntilDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationUntilDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationOfDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationOfDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAllocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractActions = append(m.ContractActions, ContractAction{})
+			if err := m.ContractActions[len(m.ContractActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Claim) Unmarshal(dAtA []byte) error {
+func (m *ContractAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -510,17 +2519,17 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.Action = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -530,34 +2539,27 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Action |= Action(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClaims
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
 			}
-			m.Completed = bool(v != 0)
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -585,9 +2587,7 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -610,7 +2610,7 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
+func (m *ContractClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -633,17 +2633,17 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimsRecordAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimsRecordAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -653,27 +2653,48 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthClaims
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthClaims
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.ContractAction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -701,80 +2722,10 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClaims
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthClaims
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
-					m.ActionsCompleted = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClaims
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaims(dAtA[iNdEx:])
@@ -796,7 +2747,7 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
+func (m *ClaimsAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -819,15 +2770,15 @@ func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimsAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -855,80 +2806,42 @@ func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClaims
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthClaims
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
-					m.ActionsCompleted = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClaims
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaims(dAtA[iNdEx:])
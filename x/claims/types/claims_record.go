@@ -82,6 +82,24 @@ func (cr ClaimsRecord) HasClaimedAction(action Action) bool {
 	}
 }
 
+// MarkContractActionClaimed marks the contract action at the given campaign
+// index as completed. It performs a no-op if the index is out of range.
+func (cr *ClaimsRecord) MarkContractActionClaimed(index int) {
+	if index < 0 || index >= len(cr.ContractActionsCompleted) {
+		return
+	}
+	cr.ContractActionsCompleted[index] = true
+}
+
+// HasClaimedContractAction checks if the user has claimed the contract action
+// at the given campaign index. It returns false if the index is out of range.
+func (cr ClaimsRecord) HasClaimedContractAction(index int) bool {
+	if index < 0 || index >= len(cr.ContractActionsCompleted) {
+		return false
+	}
+	return cr.ContractActionsCompleted[index]
+}
+
 // HasClaimedAny returns true if the user has claimed at least one reward from
 // the available actions
 func (cr ClaimsRecord) HasClaimedAny() bool {
@@ -21,6 +21,10 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 )
 
 var (
@@ -35,12 +39,24 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "evmos/claims/MsgUpdateParams"
+	updateParamsName     = "evmos/claims/MsgUpdateParams"
+	createCampaignName   = "evmos/claims/MsgCreateCampaign"
+	addClaimsRecordsName = "evmos/claims/MsgAddClaimsRecords"
+	submitClaimProofName = "evmos/claims/MsgSubmitClaimProof"
+	transferRecordName   = "evmos/claims/MsgTransferClaimsRecord"
 )
 
 // NOTE: This is required for the GetSignBytes function
 func init() {
 	RegisterLegacyAminoCodec(amino)
+	// the claiming action messages are signed within the MsgSubmitClaimProof
+	// sign bytes
+	amino.RegisterConcrete(&govv1beta1.MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	amino.RegisterConcrete(&govv1beta1.MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	amino.RegisterConcrete(&govv1.MsgVote{}, "cosmos-sdk/v1/MsgVote", nil)
+	amino.RegisterConcrete(&govv1.MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted", nil)
+	amino.RegisterConcrete(&stakingtypes.MsgDelegate{}, "cosmos-sdk/MsgDelegate", nil)
+	amino.RegisterConcrete(&transfertypes.MsgTransfer{}, "cosmos-sdk/MsgTransfer", nil)
 	amino.Seal()
 }
 
@@ -49,6 +65,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCreateCampaign{},
+		&MsgAddClaimsRecords{},
+		&MsgSubmitClaimProof{},
+		&MsgTransferClaimsRecord{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -56,4 +76,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgCreateCampaign{}, createCampaignName, nil)
+	cdc.RegisterConcrete(&MsgAddClaimsRecords{}, addClaimsRecordsName, nil)
+	cdc.RegisterConcrete(&MsgSubmitClaimProof{}, submitClaimProofName, nil)
+	cdc.RegisterConcrete(&MsgTransferClaimsRecord{}, transferRecordName, nil)
 }
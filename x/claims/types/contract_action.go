@@ -0,0 +1,121 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxContractActions is the maximum number of contract actions of a campaign
+const MaxContractActions = 10
+
+// NewContractAction creates a new contract action instance
+func NewContractAction(contract common.Address, eventSignature string) ContractAction {
+	return ContractAction{
+		Contract:       contract.Hex(),
+		EventSignature: eventSignature,
+	}
+}
+
+// Validate performs a stateless validation of the fields
+func (ca ContractAction) Validate() error {
+	if !common.IsHexAddress(ca.Contract) {
+		return fmt.Errorf("invalid contract address %s", ca.Contract)
+	}
+
+	if ca.EventSignature == "" {
+		return nil
+	}
+
+	open := strings.Index(ca.EventSignature, "(")
+	if open <= 0 || !strings.HasSuffix(ca.EventSignature, ")") || strings.ContainsAny(ca.EventSignature, " \t\n") {
+		return fmt.Errorf("invalid event signature %s, expected format Name(type1,type2)", ca.EventSignature)
+	}
+
+	return nil
+}
+
+// EventTopic returns the log topic of the event signature
+func (ca ContractAction) EventTopic() common.Hash {
+	return crypto.Keccak256Hash([]byte(ca.EventSignature))
+}
+
+// Name returns a human readable identifier of the contract action
+func (ca ContractAction) Name() string {
+	if ca.EventSignature == "" {
+		return fmt.Sprintf("contract:%s", common.HexToAddress(ca.Contract).Hex())
+	}
+	return fmt.Sprintf("contract:%s:%s", common.HexToAddress(ca.Contract).Hex(), ca.EventSignature)
+}
+
+// IsCompletedBy returns true if the EVM transaction sent to the given
+// recipient with the given receipt completes the contract action:
+//   - without event signature, the transaction must be sent to the contract or
+//     the contract must emit a log
+//   - with event signature, the contract must emit a log for the event
+func (ca ContractAction) IsCompletedBy(to *common.Address, receipt *ethtypes.Receipt) bool {
+	contract := common.HexToAddress(ca.Contract)
+
+	if ca.EventSignature == "" && to != nil && *to == contract {
+		return true
+	}
+
+	if receipt == nil {
+		return false
+	}
+
+	topic := ca.EventTopic()
+	for _, log := range receipt.Logs {
+		if log == nil || log.Address != contract {
+			continue
+		}
+		if ca.EventSignature == "" {
+			return true
+		}
+		if len(log.Topics) > 0 && log.Topics[0] == topic {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateContractActions checks that the list of contract actions contains
+// valid and unique actions
+func ValidateContractActions(contractActions []ContractAction) error {
+	if len(contractActions) > MaxContractActions {
+		return fmt.Errorf("campaign contract actions exceed the maximum of %d", MaxContractActions)
+	}
+
+	seenActions := make(map[string]bool)
+	for _, contractAction := range contractActions {
+		if err := contractAction.Validate(); err != nil {
+			return err
+		}
+		name := contractAction.Name()
+		if seenActions[name] {
+			return fmt.Errorf("duplicated campaign contract action %s", name)
+		}
+		seenActions[name] = true
+	}
+	return nil
+}
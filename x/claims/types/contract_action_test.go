@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractActionValidate(t *testing.T) {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		action   ContractAction
+		expError bool
+	}{
+		{"valid - contract only", NewContractAction(contract, ""), false},
+		{"valid - with event signature", NewContractAction(contract, "Transfer(address,address,uint256)"), false},
+		{"invalid contract address", ContractAction{Contract: "0xinvalid"}, true},
+		{"invalid event signature - no parenthesis", NewContractAction(contract, "Transfer"), true},
+		{"invalid event signature - no name", NewContractAction(contract, "(address)"), true},
+		{"invalid event signature - whitespace", NewContractAction(contract, "Transfer(address, address)"), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.action.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	err := ValidateContractActions([]ContractAction{NewContractAction(contract, ""), NewContractAction(contract, "")})
+	require.Error(t, err, "duplicated contract action")
+}
+
+func TestContractActionIsCompletedBy(t *testing.T) {
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	signature := "Transfer(address,address,uint256)"
+	topic := NewContractAction(contract, signature).EventTopic()
+
+	testCases := []struct {
+		name     string
+		action   ContractAction
+		to       *common.Address
+		receipt  *ethtypes.Receipt
+		expMatch bool
+	}{
+		{
+			"contract only - tx sent to the contract",
+			NewContractAction(contract, ""),
+			&contract,
+			&ethtypes.Receipt{},
+			true,
+		},
+		{
+			"contract only - log emitted by the contract",
+			NewContractAction(contract, ""),
+			&other,
+			&ethtypes.Receipt{Logs: []*ethtypes.Log{{Address: contract}}},
+			true,
+		},
+		{
+			"contract only - unrelated tx",
+			NewContractAction(contract, ""),
+			&other,
+			&ethtypes.Receipt{Logs: []*ethtypes.Log{{Address: other}}},
+			false,
+		},
+		{
+			"event - tx sent to the contract without the event",
+			NewContractAction(contract, signature),
+			&contract,
+			&ethtypes.Receipt{},
+			false,
+		},
+		{
+			"event - event emitted by the contract",
+			NewContractAction(contract, signature),
+			&other,
+			&ethtypes.Receipt{Logs: []*ethtypes.Log{{Address: contract, Topics: []common.Hash{topic}}}},
+			true,
+		},
+		{
+			"event - event emitted by another contract",
+			NewContractAction(contract, signature),
+			&contract,
+			&ethtypes.Receipt{Logs: []*ethtypes.Log{{Address: other, Topics: []common.Hash{topic}}}},
+			false,
+		},
+		{
+			"event - other event emitted by the contract",
+			NewContractAction(contract, signature),
+			&contract,
+			&ethtypes.Receipt{Logs: []*ethtypes.Log{{Address: contract, Topics: []common.Hash{{1}}}}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, tc.action.IsCompletedBy(tc.to, tc.receipt), tc.name)
+	}
+}
@@ -24,4 +24,10 @@ import (
 var (
 	ErrClaimsRecordNotFound = errorsmod.Register(ModuleName, 2, "claims record not found")
 	ErrInvalidAction        = errorsmod.Register(ModuleName, 3, "invalid claim action type")
+	ErrCampaignNotFound     = errorsmod.Register(ModuleName, 4, "campaign not found")
+	ErrInvalidCampaign      = errorsmod.Register(ModuleName, 5, "invalid campaign")
+	ErrCampaignStarted      = errorsmod.Register(ModuleName, 6, "campaign already started")
+	ErrInsufficientFunds    = errorsmod.Register(ModuleName, 7, "insufficient campaign funds")
+	ErrInvalidProof         = errorsmod.Register(ModuleName, 8, "invalid merkle proof")
+	ErrMaxActiveCampaigns   = errorsmod.Register(ModuleName, 9, "maximum number of active campaigns reached")
 )
@@ -18,11 +18,21 @@ package types
 
 // claim module event types
 const (
-	EventTypeClaim              = "claim"
-	EventTypeMergeClaimsRecords = "merge_claims_records"
+	EventTypeClaim                = "claim"
+	EventTypeMergeClaimsRecords   = "merge_claims_records"
+	EventTypeCreateCampaign       = "create_campaign"
+	EventTypeAddClaimsRecords     = "add_claims_records"
+	EventTypeEndCampaign          = "end_campaign"
+	EventTypeSubmitClaimProof     = "submit_claim_proof"
+	EventTypeTransferClaimsRecord = "transfer_claims_record"
 
 	AttributeKeyActionType             = "action"
 	AttributeKeyRecipient              = "recipient"
 	AttributeKeyClaimedCoins           = "claimed_coins"
 	AttributeKeyFundCommunityPoolCoins = "fund_community_pool_coins"
+	AttributeKeyCampaignID             = "campaign_id"
+	AttributeKeyCreator                = "creator"
+	AttributeKeyEscrowAddress          = "escrow_address"
+	AttributeKeyRecords                = "records"
+	AttributeKeyClawbackCoins          = "clawback_coins"
 )
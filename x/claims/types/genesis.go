@@ -21,13 +21,16 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default claims module genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		ClaimsRecords: []ClaimsRecordAddress{},
+		Params:          DefaultParams(),
+		ClaimsRecords:   []ClaimsRecordAddress{},
+		Campaigns:       []Campaign{},
+		UsedClaimProofs: []UsedClaimProof{},
 	}
 }
 
@@ -46,16 +49,64 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	seenClaims := make(map[string]bool)
+	seenCampaigns := make(map[uint64]bool)
+	contractActionsCount := make(map[uint64]int)
+
+	for _, campaign := range gs.Campaigns {
+		if seenCampaigns[campaign.ID] {
+			return fmt.Errorf("duplicated campaign %d", campaign.ID)
+		}
+		if campaign.ID == EvmosCampaignID {
+			if err := campaign.ValidateEvmosCampaign(gs.Params); err != nil {
+				return err
+			}
+		} else if err := campaign.Validate(); err != nil {
+			return err
+		}
+		seenCampaigns[campaign.ID] = true
+		contractActionsCount[campaign.ID] = len(campaign.ContractActions)
+	}
+
+	seenClaims := make(map[uint64]map[string]bool)
 
 	for _, claimsRecord := range gs.ClaimsRecords {
-		if seenClaims[claimsRecord.Address] {
+		if claimsRecord.CampaignID != EvmosCampaignID && !seenCampaigns[claimsRecord.CampaignID] {
+			return fmt.Errorf("claims record %s references unknown campaign %d", claimsRecord.Address, claimsRecord.CampaignID)
+		}
+		if seenClaims[claimsRecord.CampaignID] == nil {
+			seenClaims[claimsRecord.CampaignID] = make(map[string]bool)
+		}
+		if seenClaims[claimsRecord.CampaignID][claimsRecord.Address] {
 			return fmt.Errorf("duplicated claims record entry %s", claimsRecord.Address)
 		}
 		if err := claimsRecord.Validate(); err != nil {
 			return err
 		}
-		seenClaims[claimsRecord.Address] = true
+		if len(claimsRecord.ContractActionsCompleted) != contractActionsCount[claimsRecord.CampaignID] {
+			return fmt.Errorf(
+				"contract action length mismatch for claims record %s, expected %d, got %d",
+				claimsRecord.Address, contractActionsCount[claimsRecord.CampaignID], len(claimsRecord.ContractActionsCompleted),
+			)
+		}
+		seenClaims[claimsRecord.CampaignID][claimsRecord.Address] = true
+	}
+
+	seenUsedProofs := make(map[uint64]map[string]bool)
+
+	for _, usedProof := range gs.UsedClaimProofs {
+		if !seenCampaigns[usedProof.CampaignID] {
+			return fmt.Errorf("used claim proof %s references unknown campaign %d", usedProof.Address, usedProof.CampaignID)
+		}
+		if seenUsedProofs[usedProof.CampaignID] == nil {
+			seenUsedProofs[usedProof.CampaignID] = make(map[string]bool)
+		}
+		if seenUsedProofs[usedProof.CampaignID][usedProof.Address] {
+			return fmt.Errorf("duplicated used claim proof entry %s", usedProof.Address)
+		}
+		if _, err := sdk.AccAddressFromBech32(usedProof.Address); err != nil {
+			return fmt.Errorf("invalid used claim proof address %s: %w", usedProof.Address, err)
+		}
+		seenUsedProofs[usedProof.CampaignID][usedProof.Address] = true
 	}
 
 	return gs.Params.Validate()
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -34,6 +36,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// claims_records is a list of claim records with the corresponding airdrop recipient
 	ClaimsRecords []ClaimsRecordAddress `protobuf:"bytes,2,rep,name=claims_records,json=claimsRecords,proto3" json:"claims_records"`
+	// campaigns is the list of airdrop campaigns, including the Evmos airdrop
+	// (campaign 0), whose schedule is kept in sync with the module parameters.
+	Campaigns []Campaign `protobuf:"bytes,3,rep,name=campaigns,proto3" json:"campaigns"`
+	// used_claim_proofs is the list of Merkle campaign allocations whose proof
+	// has already been submitted
+	UsedClaimProofs []UsedClaimProof `protobuf:"bytes,4,rep,name=used_claim_proofs,json=usedClaimProofs,proto3" json:"used_claim_proofs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,6 +91,77 @@ func (m *GenesisState) GetClaimsRecords() []ClaimsRecordAddress {
 	return nil
 }
 
+func (m *GenesisState) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsedClaimProofs() []UsedClaimProof {
+	if m != nil {
+		return m.UsedClaimProofs
+	}
+	return nil
+}
+
+// UsedClaimProof defines the allocation of an address on a Merkle campaign
+// whose proof has already been submitted. It's kept after the claims record is
+// deleted or transferred so that the proof can't be submitted again.
+type UsedClaimProof struct {
+	// campaign_id is the identifier of the campaign
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// address of the allocation recipient
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *UsedClaimProof) Reset()         { *m = UsedClaimProof{} }
+func (m *UsedClaimProof) String() string { return proto.CompactTextString(m) }
+func (*UsedClaimProof) ProtoMessage()    {}
+func (*UsedClaimProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2f8f1d6f18af278, []int{1}
+}
+func (m *UsedClaimProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsedClaimProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsedClaimProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsedClaimProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsedClaimProof.Merge(m, src)
+}
+func (m *UsedClaimProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsedClaimProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsedClaimProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsedClaimProof proto.InternalMessageInfo
+
+func (m *UsedClaimProof) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *UsedClaimProof) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // Params defines the claims module's parameters.
 type Params struct {
 	// enable_claims is the parameter to enable the claiming process
@@ -100,13 +179,19 @@ type Params struct {
 	AuthorizedChannels []string `protobuf:"bytes,6,rep,name=authorized_channels,json=authorizedChannels,proto3" json:"authorized_channels,omitempty"`
 	// evm_channels is the list of channel identifiers from EVM compatible chains
 	EVMChannels []string `protobuf:"bytes,7,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// campaign_creation_fee is the fee paid to the community pool by the creator
+	// of a partner campaign, on top of the campaign funds
+	CampaignCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=campaign_creation_fee,json=campaignCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"campaign_creation_fee"`
+	// max_active_campaigns is the maximum number of partner campaigns that can
+	// be active at the same time
+	MaxActiveCampaigns uint32 `protobuf:"varint,9,opt,name=max_active_campaigns,json=maxActiveCampaigns,proto3" json:"max_active_campaigns,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f2f8f1d6f18af278, []int{1}
+	return fileDescriptor_f2f8f1d6f18af278, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,46 +269,74 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetCampaignCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CampaignCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxActiveCampaigns() uint32 {
+	if m != nil {
+		return m.MaxActiveCampaigns
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.claims.v1.GenesisState")
+	proto.RegisterType((*UsedClaimProof)(nil), "evmos.claims.v1.UsedClaimProof")
 	proto.RegisterType((*Params)(nil), "evmos.claims.v1.Params")
 }
 
 func init() { proto.RegisterFile("evmos/claims/v1/genesis.proto", fileDescriptor_f2f8f1d6f18af278) }
 
 var fileDescriptor_f2f8f1d6f18af278 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
-	0x14, 0xc6, 0xe3, 0xa6, 0x84, 0x76, 0x9c, 0x12, 0x18, 0x2a, 0x61, 0x22, 0x70, 0x42, 0x61, 0x11,
-	0x36, 0x36, 0x09, 0xe2, 0x00, 0x24, 0x41, 0xac, 0x50, 0xc1, 0xa5, 0x2c, 0xd8, 0x58, 0x13, 0x7b,
-	0xe2, 0x58, 0xf2, 0x78, 0xac, 0x99, 0xb1, 0x45, 0x39, 0x45, 0x97, 0xbd, 0x06, 0xb7, 0xe8, 0xb2,
-	0x4b, 0x56, 0x05, 0x25, 0x17, 0x41, 0xf3, 0x8f, 0xa2, 0x74, 0xc3, 0xc6, 0x1a, 0xbf, 0xef, 0xf7,
-	0x7d, 0xf3, 0xde, 0xd3, 0x80, 0xa7, 0xb8, 0x21, 0x94, 0x87, 0x49, 0x81, 0x72, 0xc2, 0xc3, 0x66,
-	0x1c, 0x66, 0xb8, 0xc4, 0x3c, 0xe7, 0x41, 0xc5, 0xa8, 0xa0, 0xb0, 0xa7, 0xe4, 0x40, 0xcb, 0x41,
-	0x33, 0xee, 0x3f, 0xd9, 0xe6, 0x8d, 0xa4, 0xf0, 0xfe, 0x61, 0x46, 0x33, 0xaa, 0x8e, 0xa1, 0x3c,
-	0x99, 0xaa, 0x9f, 0x51, 0x9a, 0x15, 0x38, 0x54, 0x7f, 0x8b, 0x7a, 0x19, 0xa6, 0x35, 0x43, 0x22,
-	0xa7, 0xa5, 0xd1, 0x07, 0xdb, 0xba, 0xc8, 0x09, 0xe6, 0x02, 0x91, 0x4a, 0x03, 0x47, 0x17, 0x0e,
-	0xe8, 0xbe, 0xd7, 0x7d, 0x9d, 0x08, 0x24, 0x30, 0x7c, 0x03, 0x3a, 0x15, 0x62, 0x88, 0x70, 0xcf,
-	0x19, 0x3a, 0x23, 0x77, 0xf2, 0x28, 0xd8, 0xea, 0x33, 0xf8, 0xa8, 0xe4, 0xe9, 0xee, 0xe5, 0xf5,
-	0xa0, 0x15, 0x19, 0x18, 0x7e, 0x02, 0xf7, 0x34, 0x11, 0x33, 0x9c, 0x50, 0x96, 0x72, 0x6f, 0x67,
-	0xd8, 0x1e, 0xb9, 0x93, 0x17, 0xb7, 0xec, 0x33, 0x75, 0x8a, 0x14, 0xf5, 0x36, 0x4d, 0x19, 0xe6,
-	0x36, 0xeb, 0x20, 0xf9, 0x47, 0xe2, 0x47, 0x3f, 0xda, 0xa0, 0xa3, 0xef, 0x82, 0xcf, 0xc1, 0x01,
-	0x2e, 0xd1, 0xa2, 0xc0, 0xb1, 0x46, 0x54, 0x6f, 0x7b, 0x51, 0x57, 0x17, 0x75, 0x22, 0x8c, 0x00,
-	0x44, 0x39, 0x4b, 0x19, 0xad, 0x62, 0x2e, 0x10, 0x13, 0xb1, 0x9c, 0xd5, 0xdb, 0x51, 0x53, 0xf4,
-	0x03, 0xbd, 0x88, 0xc0, 0x2e, 0x22, 0xf8, 0x6c, 0x17, 0x31, 0xdd, 0x93, 0x97, 0x9f, 0xff, 0x1a,
-	0x38, 0xd1, 0x7d, 0xe3, 0x3f, 0x91, 0x76, 0x09, 0xc0, 0x53, 0x70, 0x68, 0x37, 0x1a, 0xd7, 0xa5,
-	0xc8, 0x8b, 0x38, 0xc5, 0x09, 0x3a, 0xf3, 0xda, 0x2a, 0xf5, 0xf1, 0xad, 0xd4, 0xb9, 0x81, 0x75,
-	0xe8, 0x85, 0x0c, 0x85, 0x36, 0xe0, 0x54, 0xfa, 0xe7, 0xd2, 0x0e, 0x8f, 0xc1, 0x83, 0xbf, 0xb1,
-	0x74, 0x69, 0x32, 0x77, 0xff, 0x3f, 0xb3, 0x67, 0xdd, 0xc7, 0x4b, 0x1d, 0xf8, 0x0c, 0x74, 0xcd,
-	0xfa, 0x53, 0x5c, 0x52, 0xe2, 0xdd, 0x19, 0x3a, 0xa3, 0xfd, 0xc8, 0xd5, 0xb5, 0xb9, 0x2c, 0xc1,
-	0x10, 0x3c, 0x44, 0xb5, 0x58, 0x51, 0x96, 0x7f, 0xc7, 0x69, 0x9c, 0xac, 0x50, 0x59, 0xe2, 0x82,
-	0x7b, 0x9d, 0x61, 0x7b, 0xb4, 0x1f, 0xc1, 0x1b, 0x69, 0x66, 0x14, 0x38, 0x01, 0x5d, 0xdc, 0x90,
-	0x1b, 0xf2, 0xae, 0x24, 0xa7, 0xbd, 0xf5, 0xf5, 0xc0, 0x7d, 0xf7, 0xe5, 0x83, 0xc5, 0x22, 0x17,
-	0x37, 0xc4, 0xfe, 0x4c, 0x67, 0x97, 0x6b, 0xdf, 0xb9, 0x5a, 0xfb, 0xce, 0xef, 0xb5, 0xef, 0x9c,
-	0x6f, 0xfc, 0xd6, 0xd5, 0xc6, 0x6f, 0xfd, 0xdc, 0xf8, 0xad, 0xaf, 0x2f, 0xb3, 0x5c, 0xac, 0xea,
-	0x45, 0x90, 0x50, 0x12, 0xea, 0x87, 0xae, 0xbf, 0xcd, 0xf8, 0x55, 0xf8, 0xcd, 0x3e, 0x7a, 0x71,
-	0x56, 0x61, 0xbe, 0xe8, 0xa8, 0xd1, 0x5f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x17, 0x29, 0xc9,
-	0xb8, 0x41, 0x03, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x53, 0xd3, 0x4e,
+	0x18, 0xc7, 0x1b, 0xda, 0x5f, 0xa1, 0xdb, 0x42, 0x7f, 0x2c, 0x38, 0x06, 0x46, 0xd3, 0x8a, 0x1e,
+	0xea, 0xc1, 0x84, 0xd6, 0xf1, 0xe8, 0x81, 0xb6, 0xea, 0x70, 0x70, 0x80, 0x20, 0x1e, 0xf4, 0x90,
+	0xd9, 0x26, 0xdb, 0x90, 0xb1, 0xc9, 0x66, 0xb2, 0x9b, 0x0c, 0x78, 0xf1, 0x2d, 0x70, 0xf4, 0x35,
+	0xf0, 0x4a, 0x38, 0x72, 0xf4, 0x04, 0x4e, 0xf1, 0x85, 0x38, 0xfb, 0x8f, 0x02, 0xbd, 0x78, 0x69,
+	0x37, 0xfb, 0x7c, 0xbf, 0x9f, 0x7d, 0x76, 0x9f, 0x67, 0x17, 0x3c, 0xc5, 0x45, 0x4c, 0xa8, 0xe3,
+	0x4f, 0x50, 0x14, 0x53, 0xa7, 0xe8, 0x3a, 0x21, 0x4e, 0x30, 0x8d, 0xa8, 0x9d, 0x66, 0x84, 0x11,
+	0xd8, 0x14, 0x61, 0x5b, 0x86, 0xed, 0xa2, 0xbb, 0x69, 0xf9, 0x84, 0x72, 0xc3, 0x08, 0x51, 0xec,
+	0x14, 0xdd, 0x11, 0x66, 0xa8, 0xeb, 0xf8, 0x24, 0x4a, 0xa4, 0x61, 0xf3, 0xc9, 0x43, 0x9e, 0xb2,
+	0xca, 0xe8, 0x7a, 0x48, 0x42, 0x22, 0x86, 0x0e, 0x1f, 0xa9, 0x59, 0x2b, 0x24, 0x24, 0x9c, 0x60,
+	0x47, 0x7c, 0x8d, 0xf2, 0xb1, 0x13, 0xe4, 0x19, 0x62, 0x11, 0xd1, 0xcc, 0xd6, 0xc3, 0x38, 0x8b,
+	0x62, 0x4c, 0x19, 0x8a, 0x53, 0x29, 0xd8, 0x3a, 0x5f, 0x00, 0x8d, 0x0f, 0x32, 0xef, 0x43, 0x86,
+	0x18, 0x86, 0x6f, 0x40, 0x35, 0x45, 0x19, 0x8a, 0xa9, 0x69, 0xb4, 0x8d, 0x4e, 0xbd, 0xf7, 0xd8,
+	0x7e, 0xb0, 0x0f, 0x7b, 0x5f, 0x84, 0xfb, 0x95, 0x8b, 0xab, 0x56, 0xc9, 0x55, 0x62, 0x78, 0x00,
+	0x56, 0xa4, 0xc2, 0xcb, 0xb0, 0x4f, 0xb2, 0x80, 0x9a, 0x0b, 0xed, 0x72, 0xa7, 0xde, 0x7b, 0x31,
+	0x67, 0x1f, 0x88, 0x91, 0x2b, 0x54, 0x3b, 0x41, 0x90, 0x61, 0xaa, 0x59, 0xcb, 0xfe, 0x9d, 0x10,
+	0x85, 0x6f, 0x41, 0xcd, 0x47, 0x71, 0x8a, 0xa2, 0x30, 0xa1, 0x66, 0x59, 0xd0, 0x36, 0xe6, 0x69,
+	0x4a, 0xa1, 0x10, 0x33, 0x07, 0x3c, 0x00, 0xab, 0x39, 0xc5, 0x81, 0x27, 0xb4, 0x5e, 0x9a, 0x11,
+	0x32, 0xa6, 0x66, 0x45, 0x60, 0x5a, 0x73, 0x98, 0x23, 0x8a, 0x03, 0x91, 0xd8, 0x3e, 0xd7, 0x29,
+	0x58, 0x33, 0xbf, 0x37, 0x4b, 0xb7, 0xbe, 0x82, 0x95, 0xfb, 0x42, 0xe8, 0x80, 0xba, 0x5e, 0xd1,
+	0x8b, 0x02, 0x71, 0x64, 0x95, 0xfe, 0xca, 0xf4, 0xaa, 0x05, 0x74, 0x62, 0xbb, 0x43, 0x17, 0x68,
+	0xc9, 0x6e, 0x00, 0x4d, 0xb0, 0x88, 0xe4, 0xa6, 0xcd, 0x85, 0xb6, 0xd1, 0xa9, 0xb9, 0xfa, 0x73,
+	0xeb, 0x4f, 0x05, 0x54, 0xe5, 0xd1, 0xc2, 0xe7, 0x60, 0x19, 0x27, 0x68, 0x34, 0xc1, 0x32, 0x79,
+	0x59, 0x8a, 0x25, 0xb7, 0x21, 0x27, 0xe5, 0x01, 0x42, 0x17, 0x40, 0x14, 0x65, 0x41, 0x46, 0x52,
+	0x8f, 0x32, 0x94, 0x31, 0x8f, 0x97, 0x56, 0x40, 0xeb, 0xbd, 0x4d, 0x5b, 0xd6, 0xdd, 0xd6, 0x75,
+	0xb7, 0x3f, 0xe9, 0xba, 0xf7, 0x97, 0xf8, 0xde, 0xce, 0xae, 0x5b, 0x86, 0xfb, 0xbf, 0xf2, 0x1f,
+	0x72, 0x3b, 0x17, 0xc0, 0x23, 0xb0, 0xae, 0x1b, 0xc8, 0xcb, 0x13, 0x16, 0x4d, 0xbc, 0x00, 0xfb,
+	0xe8, 0xd4, 0x2c, 0x0b, 0xea, 0xc6, 0x1c, 0x75, 0xa8, 0xc4, 0x12, 0xfa, 0x93, 0x43, 0xa1, 0x06,
+	0x1c, 0x71, 0xff, 0x90, 0xdb, 0xe1, 0x1e, 0x58, 0xbd, 0xc5, 0x92, 0xb1, 0x62, 0x56, 0xfe, 0x9d,
+	0xd9, 0xd4, 0xee, 0xbd, 0xb1, 0x04, 0x3e, 0x03, 0x0d, 0xd5, 0x6d, 0x01, 0x4e, 0x48, 0x6c, 0xfe,
+	0x27, 0x8e, 0xb2, 0x2e, 0xe7, 0x86, 0x7c, 0x0a, 0x3a, 0x60, 0x0d, 0xe5, 0xec, 0x98, 0x64, 0xd1,
+	0x77, 0xde, 0x04, 0xc7, 0x28, 0x49, 0xf0, 0x84, 0x9a, 0xd5, 0x76, 0xb9, 0x53, 0x73, 0xe1, 0x2c,
+	0x34, 0x50, 0x11, 0xd8, 0x03, 0x0d, 0x5c, 0xc4, 0x33, 0xe5, 0x22, 0x57, 0xf6, 0x9b, 0xd3, 0xab,
+	0x56, 0xfd, 0xdd, 0xe7, 0x8f, 0x5a, 0xe6, 0xd6, 0x71, 0x11, 0xdf, 0x7a, 0x7e, 0x80, 0x47, 0xb7,
+	0xe5, 0xf7, 0x33, 0x2c, 0x77, 0x38, 0xc6, 0xd8, 0x5c, 0x52, 0xed, 0x2a, 0xaf, 0xbc, 0xcd, 0xaf,
+	0xbc, 0xad, 0xae, 0xbc, 0x3d, 0x20, 0x51, 0xd2, 0xdf, 0xe6, 0x9b, 0x3b, 0xbf, 0x6e, 0x75, 0xc2,
+	0x88, 0x1d, 0xe7, 0x23, 0xdb, 0x27, 0xb1, 0xa3, 0xde, 0x07, 0xf9, 0xf7, 0x8a, 0x06, 0xdf, 0x1c,
+	0x76, 0x9a, 0x62, 0x2a, 0x0c, 0xd4, 0x5d, 0xd3, 0x2b, 0x0d, 0xd4, 0x42, 0xef, 0x31, 0x86, 0xdb,
+	0x60, 0x3d, 0x46, 0x27, 0x1e, 0xf2, 0x59, 0x54, 0x60, 0x6f, 0x76, 0x5d, 0x6a, 0x6d, 0xa3, 0xb3,
+	0xec, 0xc2, 0x18, 0x9d, 0xec, 0x88, 0x90, 0xee, 0x46, 0xda, 0x1f, 0x5c, 0x4c, 0x2d, 0xe3, 0x72,
+	0x6a, 0x19, 0xbf, 0xa7, 0x96, 0x71, 0x76, 0x63, 0x95, 0x2e, 0x6f, 0xac, 0xd2, 0xaf, 0x1b, 0xab,
+	0xf4, 0xe5, 0xe5, 0x9d, 0x54, 0xe4, 0x53, 0x24, 0x7f, 0x8b, 0x6e, 0xd7, 0x39, 0xd1, 0xcf, 0x92,
+	0xc8, 0x68, 0x54, 0x15, 0xd5, 0x7a, 0xfd, 0x77, 0x00, 0xf9, 0x6f, 0x5b, 0x73, 0x03, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +359,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UsedClaimProofs) > 0 {
+		for iNdEx := len(m.UsedClaimProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedClaimProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ClaimsRecords) > 0 {
 		for iNdEx := len(m.ClaimsRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -273,6 +414,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UsedClaimProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsedClaimProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsedClaimProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -293,6 +469,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxActiveCampaigns != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxActiveCampaigns))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CampaignCreationFee) > 0 {
+		for iNdEx := len(m.CampaignCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.EVMChannels) > 0 {
 		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EVMChannels[iNdEx])
@@ -380,6 +575,34 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedClaimProofs) > 0 {
+		for _, e := range m.UsedClaimProofs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UsedClaimProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovGenesis(uint64(m.CampaignID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -414,6 +637,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CampaignCreationFee) > 0 {
+		for _, e := range m.CampaignCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxActiveCampaigns != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxActiveCampaigns))
+	}
 	return n
 }
 
@@ -519,6 +751,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedClaimProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedClaimProofs = append(m.UsedClaimProofs, UsedClaimProof{})
+			if err := m.UsedClaimProofs[len(m.UsedClaimProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsedClaimProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsedClaimProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsedClaimProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -784,6 +1185,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignCreationFee = append(m.CampaignCreationFee, types.Coin{})
+			if err := m.CampaignCreationFee[len(m.CampaignCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveCampaigns", wireType)
+			}
+			m.MaxActiveCampaigns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveCampaigns |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
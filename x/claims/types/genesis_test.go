@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/ethermint/tests"
@@ -21,6 +22,7 @@ func TestGenesisTestSuite(t *testing.T) {
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	campaign := NewCampaign(1, "partner", addr, "atest", time.Now().UTC(), time.Hour, time.Hour, []Action{ActionVote}, nil, "")
 
 	testCases := []struct {
 		name     string
@@ -73,7 +75,127 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
-
+		{
+			name: "valid genesis - with campaign claims records",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				Campaigns: []Campaign{campaign},
+				ClaimsRecords: []ClaimsRecordAddress{
+					{
+						Address:                addr.String(),
+						InitialClaimableAmount: sdk.NewInt(1),
+						ActionsCompleted:       []bool{true, true, false, false},
+					},
+					{
+						Address:                addr.String(),
+						InitialClaimableAmount: sdk.NewInt(1),
+						ActionsCompleted:       []bool{false, false, false, false},
+						CampaignID:             campaign.ID,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - claims record of unknown campaign",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ClaimsRecords: []ClaimsRecordAddress{
+					{
+						Address:                addr.String(),
+						InitialClaimableAmount: sdk.NewInt(1),
+						ActionsCompleted:       []bool{false, false, false, false},
+						CampaignID:             campaign.ID,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - contract actions length mismatch",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				Campaigns: []Campaign{campaign},
+				ClaimsRecords: []ClaimsRecordAddress{
+					{
+						Address:                  addr.String(),
+						InitialClaimableAmount:   sdk.NewInt(1),
+						ActionsCompleted:         []bool{false, false, false, false},
+						CampaignID:               campaign.ID,
+						ContractActionsCompleted: []bool{false},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated campaign",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				Campaigns: []Campaign{campaign, campaign},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - Evmos campaign",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				Campaigns: []Campaign{NewEvmosCampaign(DefaultParams(), addr)},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - Evmos campaign denom differs from the params",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Campaigns: []Campaign{
+					func() Campaign {
+						evmosCampaign := NewEvmosCampaign(DefaultParams(), addr)
+						evmosCampaign.Denom = "uatom"
+						return evmosCampaign
+					}(),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - used claim proofs",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				Campaigns:       []Campaign{campaign},
+				UsedClaimProofs: []UsedClaimProof{{CampaignID: campaign.ID, Address: addr.String()}},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - used claim proof of unknown campaign",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				UsedClaimProofs: []UsedClaimProof{{CampaignID: campaign.ID, Address: addr.String()}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated used claim proof",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				Campaigns: []Campaign{campaign},
+				UsedClaimProofs: []UsedClaimProof{
+					{CampaignID: campaign.ID, Address: addr.String()},
+					{CampaignID: campaign.ID, Address: addr.String()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid used claim proof address",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				Campaigns:       []Campaign{campaign},
+				UsedClaimProofs: []UsedClaimProof{{CampaignID: campaign.ID, Address: "invalid"}},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis - invalid address",
 			genState: &GenesisState{
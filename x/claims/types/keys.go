@@ -16,6 +16,12 @@
 
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "claims"
@@ -30,9 +36,54 @@ const (
 // prefix bytes for the claims module's persistent store
 const (
 	prefixClaimsRecords = iota + 1
+	prefixCampaigns
+	prefixActiveCampaigns
+	prefixNextCampaignID
+	prefixUsedClaimProofs
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixClaimsRecords = []byte{prefixClaimsRecords}
+	KeyPrefixClaimsRecords   = []byte{prefixClaimsRecords}
+	KeyPrefixCampaigns       = []byte{prefixCampaigns}
+	KeyPrefixActiveCampaigns = []byte{prefixActiveCampaigns}
+	KeyNextCampaignID        = []byte{prefixNextCampaignID}
+	KeyPrefixUsedClaimProofs = []byte{prefixUsedClaimProofs}
 )
+
+// GetCampaignIDBytes returns the byte representation of a campaign identifier
+func GetCampaignIDBytes(campaignID uint64) []byte {
+	return sdk.Uint64ToBigEndian(campaignID)
+}
+
+// GetClaimsRecordsPrefix returns the store prefix of the claims records of a
+// campaign
+func GetClaimsRecordsPrefix(campaignID uint64) []byte {
+	return append(KeyPrefixClaimsRecords, GetCampaignIDBytes(campaignID)...)
+}
+
+// GetClaimsRecordKey returns the store key of the claims record of an address
+// for a campaign
+func GetClaimsRecordKey(campaignID uint64, addr sdk.AccAddress) []byte {
+	return append(GetClaimsRecordsPrefix(campaignID), addr.Bytes()...)
+}
+
+// GetUsedClaimProofsPrefix returns the store prefix of the used claim proofs of
+// a campaign
+func GetUsedClaimProofsPrefix(campaignID uint64) []byte {
+	return append(KeyPrefixUsedClaimProofs, GetCampaignIDBytes(campaignID)...)
+}
+
+// SplitClaimsRecordKey returns the campaign identifier and the address of a
+// claims record store key
+func SplitClaimsRecordKey(key []byte) (uint64, sdk.AccAddress) {
+	key = key[len(KeyPrefixClaimsRecords):]
+	return binary.BigEndian.Uint64(key[:8]), sdk.AccAddress(key[8:])
+}
+
+// SplitUsedClaimProofKey returns the campaign identifier and the address of a
+// used claim proof store key
+func SplitUsedClaimProofKey(key []byte) (uint64, sdk.AccAddress) {
+	key = key[len(KeyPrefixUsedClaimProofs):]
+	return binary.BigEndian.Uint64(key[:8]), sdk.AccAddress(key[8:])
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MerkleHashLength is the length of the Merkle tree hashes
+const MerkleHashLength = sha256.Size
+
+// prefixes used for the domain separation of the Merkle tree leaves and nodes
+const (
+	merkleLeafPrefix = byte(0)
+	merkleNodePrefix = byte(1)
+)
+
+// MerkleLeaf returns the Merkle tree leaf of an allocation, computed as
+// sha256(0x00 || address bytes || decimal amount)
+func MerkleLeaf(addr sdk.AccAddress, amount math.Int) []byte {
+	bz := append([]byte{merkleLeafPrefix}, addr.Bytes()...)
+	bz = append(bz, []byte(amount.String())...)
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// merkleNode returns the parent of two Merkle tree nodes, computed as
+// sha256(0x01 || min(a, b) || max(a, b)). Sorting the pair removes the need of
+// the leaf index on the proof.
+func merkleNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	bz := append([]byte{merkleNodePrefix}, a...)
+	bz = append(bz, b...)
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// VerifyMerkleProof returns true if the proof of the given leaf resolves to
+// the Merkle root
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		if len(sibling) != MerkleHashLength {
+			return false
+		}
+		node = merkleNode(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+// MerkleRoot returns the root of the Merkle tree of the given leaves. The last
+// node of a level with an odd number of nodes is promoted to the next level.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+	return level[0]
+}
+
+// MerkleProof returns the proof of the leaf at the given index of the Merkle
+// tree of the given leaves
+func MerkleProof(leaves [][]byte, index int) [][]byte {
+	if index < 0 || index >= len(leaves) {
+		return nil
+	}
+
+	proof := [][]byte{}
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextMerkleLevel(level)
+		index /= 2
+	}
+	return proof
+}
+
+// nextMerkleLevel hashes the pairs of nodes of a Merkle tree level
+func nextMerkleLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, merkleNode(level[i], level[i+1]))
+	}
+	return next
+}
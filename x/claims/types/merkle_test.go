@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleProof(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 4, 7} {
+		leaves := make([][]byte, size)
+		for i := range leaves {
+			addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			leaves[i] = MerkleLeaf(addr, sdk.NewInt(int64(i+1)))
+		}
+
+		root := MerkleRoot(leaves)
+		require.Len(t, root, MerkleHashLength)
+
+		for i, leaf := range leaves {
+			proof := MerkleProof(leaves, i)
+			require.True(t, VerifyMerkleProof(root, leaf, proof), "size %d, leaf %d", size, i)
+		}
+	}
+}
+
+func TestVerifyMerkleProofInvalid(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	leaves := [][]byte{
+		MerkleLeaf(addr, sdk.NewInt(100)),
+		MerkleLeaf(other, sdk.NewInt(200)),
+		MerkleLeaf(sdk.AccAddress(tests.GenerateAddress().Bytes()), sdk.NewInt(300)),
+	}
+	root := MerkleRoot(leaves)
+	proof := MerkleProof(leaves, 0)
+
+	require.True(t, VerifyMerkleProof(root, MerkleLeaf(addr, sdk.NewInt(100)), proof))
+	require.False(t, VerifyMerkleProof(root, MerkleLeaf(addr, sdk.NewInt(101)), proof))
+	require.False(t, VerifyMerkleProof(root, MerkleLeaf(other, sdk.NewInt(100)), proof))
+	require.False(t, VerifyMerkleProof(root, leaves[0], proof[1:]))
+	require.False(t, VerifyMerkleProof(root, leaves[0], [][]byte{{1, 2, 3}}))
+	require.Nil(t, MerkleProof(leaves, 3))
+}
@@ -17,11 +17,43 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreateCampaign{}
+	_ sdk.Msg = &MsgAddClaimsRecords{}
+	_ sdk.Msg = &MsgSubmitClaimProof{}
+	_ sdk.Msg = &MsgTransferClaimsRecord{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitClaimProof{}
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+const (
+	TypeMsgUpdateParams         = "update_params"
+	TypeMsgCreateCampaign       = "create_campaign"
+	TypeMsgAddClaimsRecords     = "add_claims_records"
+	TypeMsgSubmitClaimProof     = "submit_claim_proof"
+	TypeMsgTransferClaimsRecord = "transfer_claims_record"
+)
+
+// Route returns the message route for a MsgUpdateParams message.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams message.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -46,3 +78,272 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// Route returns the message route for a MsgCreateCampaign message.
+func (m MsgCreateCampaign) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateCampaign message.
+func (m MsgCreateCampaign) Type() string { return TypeMsgCreateCampaign }
+
+// GetSigners returns the expected signers for a MsgCreateCampaign message.
+func (m *MsgCreateCampaign) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateCampaign) ValidateBasic() error {
+	creator, err := sdk.AccAddressFromBech32(m.Creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid campaign amount %s", m.Amount)
+	}
+
+	campaign := NewCampaign(
+		0,
+		m.Name,
+		creator,
+		m.Amount.Denom,
+		m.StartTime,
+		m.DurationUntilDecay,
+		m.DurationOfDecay,
+		m.Actions,
+		m.ContractActions,
+		m.ClawbackAddress,
+	)
+	campaign.MerkleRoot = m.MerkleRoot
+
+	if err := campaign.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidCampaign, err.Error())
+	}
+
+	switch {
+	case campaign.IsMerkle() && (m.MerkleTotal.IsNil() || !m.MerkleTotal.IsPositive()):
+		return errorsmod.Wrap(ErrInvalidCampaign, "merkle total must be positive")
+	case campaign.IsMerkle() && m.MerkleTotal.GT(m.Amount.Amount):
+		return errorsmod.Wrapf(ErrInsufficientFunds, "merkle total %s exceeds the campaign amount %s", m.MerkleTotal, m.Amount)
+	case !campaign.IsMerkle() && !m.MerkleTotal.IsNil() && !m.MerkleTotal.IsZero():
+		return errorsmod.Wrap(ErrInvalidCampaign, "merkle total requires a merkle root")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateCampaign) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns the message route for a MsgAddClaimsRecords message.
+func (m MsgAddClaimsRecords) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAddClaimsRecords message.
+func (m MsgAddClaimsRecords) Type() string { return TypeMsgAddClaimsRecords }
+
+// GetSigners returns the expected signers for a MsgAddClaimsRecords message.
+func (m *MsgAddClaimsRecords) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddClaimsRecords) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	if m.CampaignID == EvmosCampaignID {
+		return errorsmod.Wrap(ErrInvalidCampaign, "cannot add claims records to the Evmos airdrop")
+	}
+
+	if len(m.Allocations) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "allocations cannot be empty")
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, allocation := range m.Allocations {
+		if seenAddresses[allocation.Address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated allocation for address %s", allocation.Address)
+		}
+		if err := allocation.Validate(); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+		seenAddresses[allocation.Address] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddClaimsRecords) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgSubmitClaimProof creates a new instance of MsgSubmitClaimProof that
+// executes the claiming action messages of the sender with the proof.
+func NewMsgSubmitClaimProof(
+	sender sdk.AccAddress,
+	campaignID uint64,
+	amount math.Int,
+	proof [][]byte,
+	msgs []sdk.Msg,
+) (*MsgSubmitClaimProof, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitClaimProof{
+		Sender:     sender.String(),
+		CampaignID: campaignID,
+		Amount:     amount,
+		Proof:      proof,
+		Msgs:       anys,
+	}, nil
+}
+
+// Route returns the message route for a MsgSubmitClaimProof message.
+func (m MsgSubmitClaimProof) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgSubmitClaimProof message.
+func (m MsgSubmitClaimProof) Type() string { return TypeMsgSubmitClaimProof }
+
+// GetSigners returns the expected signers for a MsgSubmitClaimProof message.
+func (m *MsgSubmitClaimProof) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSubmitClaimProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.CampaignID == EvmosCampaignID {
+		return errorsmod.Wrap(ErrInvalidCampaign, "the Evmos airdrop is not a merkle campaign")
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "amount is not positive, %s", m.Amount)
+	}
+
+	for _, node := range m.Proof {
+		if len(node) != MerkleHashLength {
+			return errorsmod.Wrapf(ErrInvalidProof, "invalid proof node length, expected %d, got %d", MerkleHashLength, len(node))
+		}
+	}
+
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "the proof must be submitted with a claiming action message")
+	}
+
+	for _, msg := range msgs {
+		if err := ValidateClaimingActionMsg(m.Sender, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateClaimingActionMsg checks that the message submitted with a claim
+// proof is a claiming action message signed by the proof sender only.
+func ValidateClaimingActionMsg(sender string, msg sdk.Msg) error {
+	if !IsClaimingActionMsg(msg) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s is not a claiming action message", sdk.MsgTypeURL(msg))
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 || signers[0].String() != sender {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s must be signed by the sender only", sdk.MsgTypeURL(msg))
+	}
+
+	return msg.ValidateBasic()
+}
+
+// GetMessages returns the cached claiming action messages of the
+// MsgSubmitClaimProof.
+func (m MsgSubmitClaimProof) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Msgs, "claim proof")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitClaimProof) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Msgs)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSubmitClaimProof) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// IsClaimingActionMsg returns true if the message performs a claiming action
+// that can be executed with a claim proof: a governance vote, a delegation or
+// an IBC transfer.
+func IsClaimingActionMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *govv1beta1.MsgVote, *govv1beta1.MsgVoteWeighted,
+		*govv1.MsgVote, *govv1.MsgVoteWeighted,
+		*stakingtypes.MsgDelegate,
+		*transfertypes.MsgTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Route returns the message route for a MsgTransferClaimsRecord message.
+func (m MsgTransferClaimsRecord) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgTransferClaimsRecord message.
+func (m MsgTransferClaimsRecord) Type() string { return TypeMsgTransferClaimsRecord }
+
+// GetSigners returns the expected signers for a MsgTransferClaimsRecord message.
+func (m *MsgTransferClaimsRecord) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgTransferClaimsRecord) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	if sender.Equals(recipient) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "sender and recipient are the same address %s", m.Sender)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTransferClaimsRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// Validate performs a stateless validation of the fields
+func (a ClaimsAllocation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return err
+	}
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return fmt.Errorf("allocation amount is not positive, %s", a.Amount)
+	}
+	return nil
+}
@@ -17,6 +17,7 @@
 package types
 
 import (
+	"errors"
 	fmt "fmt"
 	"time"
 
@@ -45,6 +46,11 @@ var (
 	}
 	DefaultEnableClaims     = true
 	DefaultAirdropStartTime = time.Time{}
+	// DefaultCampaignCreationFee is 100 EVMOS
+	DefaultCampaignCreationFee = sdk.NewCoins(sdk.NewCoin(DefaultClaimsDenom, sdk.NewInt(100).Mul(sdk.NewInt(1e18))))
+	// DefaultMaxActiveCampaigns bounds the partner campaigns iterated by the
+	// claims hooks on every vote, delegation, IBC transfer and EVM transaction
+	DefaultMaxActiveCampaigns = uint32(20)
 )
 
 // ParamsKey store key for params
@@ -59,15 +65,19 @@ func NewParams(
 	durationOfDecay time.Duration,
 	authorizedChannels,
 	evmChannels []string,
+	campaignCreationFee sdk.Coins,
+	maxActiveCampaigns uint32,
 ) Params {
 	return Params{
-		EnableClaims:       enableClaim,
-		ClaimsDenom:        claimsDenom,
-		AirdropStartTime:   airdropStartTime,
-		DurationUntilDecay: durationUntilDecay,
-		DurationOfDecay:    durationOfDecay,
-		AuthorizedChannels: authorizedChannels,
-		EVMChannels:        evmChannels,
+		EnableClaims:        enableClaim,
+		ClaimsDenom:         claimsDenom,
+		AirdropStartTime:    airdropStartTime,
+		DurationUntilDecay:  durationUntilDecay,
+		DurationOfDecay:     durationOfDecay,
+		AuthorizedChannels:  authorizedChannels,
+		EVMChannels:         evmChannels,
+		CampaignCreationFee: campaignCreationFee,
+		MaxActiveCampaigns:  maxActiveCampaigns,
 	}
 }
 
@@ -75,13 +85,15 @@ func NewParams(
 // for the claims module.
 func DefaultParams() Params {
 	return Params{
-		EnableClaims:       DefaultEnableClaims,
-		ClaimsDenom:        DefaultClaimsDenom,
-		AirdropStartTime:   DefaultAirdropStartTime,
-		DurationUntilDecay: DefaultDurationUntilDecay,
-		DurationOfDecay:    DefaultDurationOfDecay,
-		AuthorizedChannels: DefaultAuthorizedChannels,
-		EVMChannels:        DefaultEVMChannels,
+		EnableClaims:        DefaultEnableClaims,
+		ClaimsDenom:         DefaultClaimsDenom,
+		AirdropStartTime:    DefaultAirdropStartTime,
+		DurationUntilDecay:  DefaultDurationUntilDecay,
+		DurationOfDecay:     DefaultDurationOfDecay,
+		AuthorizedChannels:  DefaultAuthorizedChannels,
+		EVMChannels:         DefaultEVMChannels,
+		CampaignCreationFee: DefaultCampaignCreationFee,
+		MaxActiveCampaigns:  DefaultMaxActiveCampaigns,
 	}
 }
 
@@ -116,7 +128,16 @@ func (p Params) Validate() error {
 	if err := ValidateChannels(p.AuthorizedChannels); err != nil {
 		return err
 	}
-	return ValidateChannels(p.EVMChannels)
+	if err := ValidateChannels(p.EVMChannels); err != nil {
+		return err
+	}
+	if err := p.CampaignCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid campaign creation fee: %w", err)
+	}
+	if p.MaxActiveCampaigns == 0 {
+		return errors.New("max active campaigns cannot be 0")
+	}
+	return nil
 }
 
 // DecayStartTime returns the time at which the Decay period starts
@@ -64,6 +64,26 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"fail - invalid campaign creation fee",
+			Params{
+				DurationOfDecay:     DefaultDurationOfDecay,
+				DurationUntilDecay:  DefaultDurationUntilDecay,
+				ClaimsDenom:         DefaultClaimsDenom,
+				CampaignCreationFee: sdk.Coins{{Denom: DefaultClaimsDenom, Amount: sdk.NewInt(-1)}},
+				MaxActiveCampaigns:  DefaultMaxActiveCampaigns,
+			},
+			true,
+		},
+		{
+			"fail - max active campaigns is 0",
+			Params{
+				DurationOfDecay:    DefaultDurationOfDecay,
+				DurationUntilDecay: DefaultDurationUntilDecay,
+				ClaimsDenom:        DefaultClaimsDenom,
+			},
+			true,
+		},
 		{
 			"success - default params",
 			DefaultParams(),
@@ -77,12 +97,13 @@ func TestParamsValidate(t *testing.T) {
 				ClaimsDenom:        "tevmos",
 				AuthorizedChannels: DefaultAuthorizedChannels,
 				EVMChannels:        DefaultEVMChannels,
+				MaxActiveCampaigns: DefaultMaxActiveCampaigns,
 			},
 			false,
 		},
 		{
 			"success - constructor",
-			NewParams(true, "tevmos", time.Unix(0, 0), DefaultDurationOfDecay, DefaultDurationUntilDecay, DefaultAuthorizedChannels, DefaultEVMChannels),
+			NewParams(true, "tevmos", time.Unix(0, 0), DefaultDurationOfDecay, DefaultDurationUntilDecay, DefaultAuthorizedChannels, DefaultEVMChannels, DefaultCampaignCreationFee, DefaultMaxActiveCampaigns),
 			false,
 		},
 	}
@@ -205,6 +205,9 @@ func (m *QueryParamsResponse) GetParams() Params {
 type QueryClaimsRecordsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// campaign_id defines the campaign to query the claims records for. Defaults
+	// to the Evmos airdrop (campaign 0).
+	CampaignID uint64 `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
 func (m *QueryClaimsRecordsRequest) Reset()         { *m = QueryClaimsRecordsRequest{} }
@@ -247,6 +250,13 @@ func (m *QueryClaimsRecordsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryClaimsRecordsRequest) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
 // QueryClaimsRecordsResponse is the response type for the Query/ClaimsRecords
 // RPC method.
 type QueryClaimsRecordsResponse struct {
@@ -308,6 +318,9 @@ func (m *QueryClaimsRecordsResponse) GetPagination() *query.PageResponse {
 type QueryClaimsRecordRequest struct {
 	// address defines the user to query claims record for
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// campaign_id defines the campaign to query the claims record for. Defaults
+	// to the Evmos airdrop (campaign 0).
+	CampaignID uint64 `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
 func (m *QueryClaimsRecordRequest) Reset()         { *m = QueryClaimsRecordRequest{} }
@@ -350,6 +363,13 @@ func (m *QueryClaimsRecordRequest) GetAddress() string {
 	return ""
 }
 
+func (m *QueryClaimsRecordRequest) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
 // QueryClaimsRecordResponse is the response type for the Query/ClaimsRecord RPC
 // method.
 type QueryClaimsRecordResponse struct {
@@ -357,6 +377,8 @@ type QueryClaimsRecordResponse struct {
 	InitialClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=initial_claimable_amount,json=initialClaimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_claimable_amount"`
 	// claims of the user
 	Claims []Claim `protobuf:"bytes,2,rep,name=claims,proto3" json:"claims"`
+	// contract_claims of the user
+	ContractClaims []ContractClaim `protobuf:"bytes,3,rep,name=contract_claims,json=contractClaims,proto3" json:"contract_claims"`
 }
 
 func (m *QueryClaimsRecordResponse) Reset()         { *m = QueryClaimsRecordResponse{} }
@@ -399,6 +421,189 @@ func (m *QueryClaimsRecordResponse) GetClaims() []Claim {
 	return nil
 }
 
+func (m *QueryClaimsRecordResponse) GetContractClaims() []ContractClaim {
+	if m != nil {
+		return m.ContractClaims
+	}
+	return nil
+}
+
+// QueryCampaignsRequest is the request type for the Query/Campaigns RPC method.
+type QueryCampaignsRequest struct {
+}
+
+func (m *QueryCampaignsRequest) Reset()         { *m = QueryCampaignsRequest{} }
+func (m *QueryCampaignsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsRequest) ProtoMessage()    {}
+func (*QueryCampaignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{8}
+}
+func (m *QueryCampaignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsRequest.Merge(m, src)
+}
+func (m *QueryCampaignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignsRequest proto.InternalMessageInfo
+
+// QueryCampaignsResponse is the response type for the Query/Campaigns RPC
+// method.
+type QueryCampaignsResponse struct {
+	// campaigns defines all airdrop campaigns
+	Campaigns []Campaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns"`
+}
+
+func (m *QueryCampaignsResponse) Reset()         { *m = QueryCampaignsResponse{} }
+func (m *QueryCampaignsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsResponse) ProtoMessage()    {}
+func (*QueryCampaignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{9}
+}
+func (m *QueryCampaignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsResponse.Merge(m, src)
+}
+func (m *QueryCampaignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignsResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignsResponse) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+// QueryCampaignRequest is the request type for the Query/Campaign RPC method.
+type QueryCampaignRequest struct {
+	// campaign_id is the identifier of the campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *QueryCampaignRequest) Reset()         { *m = QueryCampaignRequest{} }
+func (m *QueryCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignRequest) ProtoMessage()    {}
+func (*QueryCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{10}
+}
+func (m *QueryCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignRequest.Merge(m, src)
+}
+func (m *QueryCampaignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// QueryCampaignResponse is the response type for the Query/Campaign RPC method.
+type QueryCampaignResponse struct {
+	// campaign defines the airdrop campaign
+	Campaign Campaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign"`
+}
+
+func (m *QueryCampaignResponse) Reset()         { *m = QueryCampaignResponse{} }
+func (m *QueryCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignResponse) ProtoMessage()    {}
+func (*QueryCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{11}
+}
+func (m *QueryCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignResponse.Merge(m, src)
+}
+func (m *QueryCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignResponse) GetCampaign() Campaign {
+	if m != nil {
+		return m.Campaign
+	}
+	return Campaign{}
+}
+
 func init() {
 	proto.RegisterType((*QueryTotalUnclaimedRequest)(nil), "evmos.claims.v1.QueryTotalUnclaimedRequest")
 	proto.RegisterType((*QueryTotalUnclaimedResponse)(nil), "evmos.claims.v1.QueryTotalUnclaimedResponse")
@@ -408,54 +613,70 @@ func init() {
 	proto.RegisterType((*QueryClaimsRecordsResponse)(nil), "evmos.claims.v1.QueryClaimsRecordsResponse")
 	proto.RegisterType((*QueryClaimsRecordRequest)(nil), "evmos.claims.v1.QueryClaimsRecordRequest")
 	proto.RegisterType((*QueryClaimsRecordResponse)(nil), "evmos.claims.v1.QueryClaimsRecordResponse")
+	proto.RegisterType((*QueryCampaignsRequest)(nil), "evmos.claims.v1.QueryCampaignsRequest")
+	proto.RegisterType((*QueryCampaignsResponse)(nil), "evmos.claims.v1.QueryCampaignsResponse")
+	proto.RegisterType((*QueryCampaignRequest)(nil), "evmos.claims.v1.QueryCampaignRequest")
+	proto.RegisterType((*QueryCampaignResponse)(nil), "evmos.claims.v1.QueryCampaignResponse")
 }
 
 func init() { proto.RegisterFile("evmos/claims/v1/query.proto", fileDescriptor_f3bf523ec58a3aba) }
 
 var fileDescriptor_f3bf523ec58a3aba = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xb3, 0xa5, 0x0d, 0x62, 0xcb, 0x87, 0xb4, 0x54, 0x6d, 0x9a, 0x16, 0xa7, 0x98, 0xaa,
-	0x4d, 0x5b, 0xf0, 0xd6, 0xa5, 0x3c, 0x40, 0x13, 0x09, 0x84, 0xc4, 0xa1, 0x58, 0x70, 0xe1, 0x12,
-	0x6d, 0x9c, 0x95, 0x6b, 0x91, 0x78, 0x5d, 0xef, 0x26, 0xa2, 0x42, 0x48, 0x88, 0x27, 0x00, 0x21,
-	0x24, 0x5e, 0x01, 0x5e, 0x81, 0x2b, 0x87, 0x1e, 0x2b, 0x71, 0x41, 0x1c, 0x0a, 0x6a, 0x79, 0x10,
-	0xe4, 0xfd, 0x08, 0x71, 0x62, 0x48, 0x2e, 0x89, 0xed, 0x99, 0xf9, 0xcf, 0x6f, 0xfe, 0x9e, 0x35,
-	0x5c, 0xa2, 0xbd, 0x0e, 0xe3, 0xd8, 0x6f, 0x93, 0xb0, 0xc3, 0x71, 0xcf, 0xc5, 0x87, 0x5d, 0x9a,
-	0x1c, 0x39, 0x71, 0xc2, 0x04, 0x43, 0xd7, 0x64, 0xd0, 0x51, 0x41, 0xa7, 0xe7, 0x96, 0x37, 0x7d,
-	0xc6, 0xd3, 0xf4, 0x26, 0xe1, 0x54, 0x65, 0xe2, 0x9e, 0xdb, 0xa4, 0x82, 0xb8, 0x38, 0x26, 0x41,
-	0x18, 0x11, 0x11, 0xb2, 0x48, 0x15, 0x97, 0xad, 0xc1, 0x5c, 0x93, 0xe5, 0xb3, 0xd0, 0xc4, 0x97,
-	0x87, 0x3b, 0xeb, 0x36, 0x2a, 0x7a, 0x63, 0x38, 0x1a, 0xd0, 0x88, 0xf2, 0xd0, 0x84, 0xe7, 0x02,
-	0x16, 0x30, 0x79, 0x89, 0xd3, 0x2b, 0x23, 0x19, 0x30, 0x16, 0xb4, 0x29, 0x26, 0x71, 0x88, 0x49,
-	0x14, 0x31, 0x21, 0x79, 0x74, 0x8d, 0xbd, 0x0c, 0xcb, 0x8f, 0x53, 0xe4, 0x27, 0x4c, 0x90, 0xf6,
-	0xd3, 0x48, 0x4a, 0xd3, 0x96, 0x47, 0x0f, 0xbb, 0x94, 0x0b, 0xfb, 0x35, 0x80, 0x4b, 0xb9, 0x61,
-	0x1e, 0xb3, 0x88, 0x53, 0x44, 0xe0, 0x4c, 0x0a, 0xcf, 0x4b, 0x60, 0xe5, 0x42, 0x75, 0x76, 0x67,
-	0xd1, 0x51, 0xe3, 0x39, 0xe9, 0x78, 0x8e, 0x1e, 0xcf, 0xa9, 0xb3, 0x30, 0xaa, 0x6d, 0x1f, 0x9f,
-	0x56, 0x0a, 0x9f, 0x7f, 0x56, 0xaa, 0x41, 0x28, 0x0e, 0xba, 0x4d, 0xc7, 0x67, 0x1d, 0xac, 0xbd,
-	0x50, 0x7f, 0x77, 0x78, 0xeb, 0x39, 0x16, 0x47, 0x31, 0xe5, 0xb2, 0x80, 0x7b, 0x4a, 0xd9, 0x9e,
-	0x83, 0x48, 0x12, 0xec, 0x93, 0x84, 0x74, 0xb8, 0x01, 0x7b, 0x04, 0xaf, 0x67, 0x9e, 0x6a, 0x9e,
-	0x7b, 0xb0, 0x18, 0xcb, 0x27, 0x25, 0xb0, 0x02, 0xaa, 0xb3, 0x3b, 0x0b, 0xce, 0xd0, 0xcb, 0x72,
-	0x54, 0x41, 0x6d, 0x3a, 0xc5, 0xf1, 0x74, 0xb2, 0xed, 0xc3, 0x45, 0xa9, 0x56, 0x97, 0x69, 0x1e,
-	0xf5, 0x59, 0xd2, 0x32, 0xad, 0xd0, 0x7d, 0x08, 0xff, 0xbe, 0x46, 0xad, 0xbb, 0x96, 0x19, 0x54,
-	0x6d, 0x87, 0x19, 0x77, 0x9f, 0x04, 0x54, 0xd7, 0x7a, 0x03, 0x95, 0xf6, 0x27, 0xa0, 0xad, 0x1e,
-	0xea, 0xa2, 0xd1, 0x6b, 0xb0, 0xa8, 0x28, 0xb5, 0x97, 0xab, 0x23, 0xe8, 0x83, 0x75, 0x7b, 0xad,
-	0x56, 0x42, 0x79, 0x7f, 0x0e, 0x95, 0x84, 0x1e, 0x64, 0x50, 0xa7, 0x24, 0xea, 0xfa, 0x58, 0x54,
-	0x05, 0x90, 0x61, 0xdd, 0x85, 0xa5, 0x11, 0x54, 0xe3, 0x47, 0x09, 0x5e, 0x24, 0xaa, 0xbb, 0x34,
-	0xe3, 0x92, 0x67, 0x6e, 0xed, 0x2f, 0x20, 0xc7, 0xc7, 0xfe, 0x80, 0x07, 0xb0, 0x14, 0x46, 0xa1,
-	0x08, 0x49, 0xbb, 0x21, 0x71, 0x49, 0xb3, 0x4d, 0x1b, 0xa4, 0xc3, 0xba, 0x91, 0x50, 0x42, 0x35,
-	0x27, 0x1d, 0xe6, 0xc7, 0x69, 0x65, 0x6d, 0x82, 0x1d, 0x79, 0x18, 0x09, 0x6f, 0x5e, 0xeb, 0xd5,
-	0x8d, 0xdc, 0x9e, 0x54, 0x43, 0xbb, 0x7d, 0x2b, 0xa7, 0xa4, 0x95, 0xf3, 0xf9, 0x56, 0x66, 0xcd,
-	0xdb, 0xf9, 0x3a, 0x0d, 0x67, 0x24, 0x3d, 0xfa, 0x00, 0xe0, 0xd5, 0xec, 0xc2, 0xa3, 0xad, 0x11,
-	0x89, 0x7f, 0x9f, 0x9a, 0xf2, 0xed, 0xc9, 0x92, 0x95, 0x2f, 0x76, 0xf5, 0xcd, 0xb7, 0xdf, 0xef,
-	0xa7, 0x6c, 0xb4, 0x82, 0x87, 0x4f, 0xb7, 0x48, 0x0b, 0x1a, 0xdd, 0x3e, 0x84, 0x80, 0x45, 0xb5,
-	0xbe, 0xe8, 0x56, 0x7e, 0x87, 0xcc, 0x19, 0x29, 0xaf, 0xfe, 0x3f, 0x49, 0xb7, 0xaf, 0xc8, 0xf6,
-	0x8b, 0x68, 0x61, 0xa4, 0xbd, 0x3a, 0x1c, 0xe8, 0x1d, 0x80, 0x57, 0x32, 0x2b, 0x8b, 0x36, 0xf3,
-	0x85, 0xf3, 0x4e, 0x4f, 0x79, 0x6b, 0xa2, 0x5c, 0xcd, 0xb2, 0x2e, 0x59, 0x6e, 0xa2, 0x0a, 0xce,
-	0xff, 0x0c, 0x36, 0x12, 0x4d, 0xf0, 0x11, 0xc0, 0xcb, 0x83, 0x12, 0x68, 0x63, 0x7c, 0x1b, 0x43,
-	0xb4, 0x39, 0x49, 0xaa, 0x06, 0x72, 0x25, 0xd0, 0x16, 0xda, 0x18, 0x03, 0x84, 0x5f, 0xea, 0x33,
-	0xf0, 0xaa, 0x56, 0x3f, 0x3e, 0xb3, 0xc0, 0xc9, 0x99, 0x05, 0x7e, 0x9d, 0x59, 0xe0, 0xed, 0xb9,
-	0x55, 0x38, 0x39, 0xb7, 0x0a, 0xdf, 0xcf, 0xad, 0xc2, 0xb3, 0x8d, 0x81, 0xb5, 0x56, 0x72, 0xea,
-	0xb7, 0xe7, 0x6e, 0xe3, 0x17, 0x46, 0x5a, 0x6e, 0x77, 0xb3, 0x28, 0x3f, 0xce, 0x77, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x8a, 0x5d, 0x50, 0x72, 0x89, 0x06, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdf, 0x4f, 0xdb, 0x56,
+	0x18, 0x8d, 0x03, 0x64, 0x70, 0xd9, 0x40, 0xba, 0x63, 0x10, 0x0c, 0x73, 0x32, 0x8f, 0x85, 0x10,
+	0x36, 0x5f, 0xc2, 0x36, 0xed, 0x61, 0xda, 0x03, 0xc9, 0xb4, 0x09, 0x69, 0x93, 0x98, 0xc5, 0x34,
+	0x69, 0x2f, 0xd1, 0x8d, 0x73, 0x65, 0xac, 0x25, 0xbe, 0x21, 0x76, 0xa2, 0x21, 0x84, 0x84, 0xfa,
+	0xd8, 0xbe, 0xb4, 0x6a, 0x2b, 0xf5, 0x5f, 0x68, 0xff, 0x12, 0x1e, 0x91, 0xaa, 0x4a, 0x55, 0x1f,
+	0x68, 0x15, 0xfa, 0x87, 0x54, 0xbe, 0x3f, 0x8c, 0xed, 0x18, 0x92, 0xbe, 0x40, 0xf0, 0x77, 0xbe,
+	0x73, 0xce, 0x77, 0xae, 0xef, 0x47, 0xc0, 0x1a, 0x19, 0x74, 0xa8, 0x87, 0xac, 0x36, 0x76, 0x3a,
+	0x1e, 0x1a, 0x54, 0xd1, 0x71, 0x9f, 0xf4, 0x4e, 0x8c, 0x6e, 0x8f, 0xfa, 0x14, 0x2e, 0xb2, 0xa2,
+	0xc1, 0x8b, 0xc6, 0xa0, 0xaa, 0x56, 0x2c, 0xea, 0x05, 0xf0, 0x26, 0xf6, 0x08, 0x47, 0xa2, 0x41,
+	0xb5, 0x49, 0x7c, 0x5c, 0x45, 0x5d, 0x6c, 0x3b, 0x2e, 0xf6, 0x1d, 0xea, 0xf2, 0x66, 0x55, 0x8b,
+	0x62, 0x25, 0xca, 0xa2, 0x8e, 0xac, 0xaf, 0x27, 0x95, 0x85, 0x0c, 0xaf, 0x7e, 0x99, 0xac, 0xda,
+	0xc4, 0x25, 0x9e, 0x23, 0xcb, 0x4b, 0x36, 0xb5, 0x29, 0xfb, 0x88, 0x82, 0x4f, 0x92, 0xd2, 0xa6,
+	0xd4, 0x6e, 0x13, 0x84, 0xbb, 0x0e, 0xc2, 0xae, 0x4b, 0x7d, 0xe6, 0x47, 0xf4, 0xe8, 0xeb, 0x40,
+	0xfd, 0x2b, 0xb0, 0x7c, 0x48, 0x7d, 0xdc, 0xfe, 0xdb, 0x65, 0xd4, 0xa4, 0x65, 0x92, 0xe3, 0x3e,
+	0xf1, 0x7c, 0xfd, 0x5c, 0x01, 0x6b, 0xa9, 0x65, 0xaf, 0x4b, 0x5d, 0x8f, 0x40, 0x0c, 0x66, 0x02,
+	0xf3, 0x5e, 0x5e, 0x29, 0x4e, 0x95, 0xe7, 0x77, 0x57, 0x0d, 0x3e, 0x9e, 0x11, 0x8c, 0x67, 0x88,
+	0xf1, 0x8c, 0x3a, 0x75, 0xdc, 0xda, 0xce, 0xc5, 0x55, 0x21, 0xf3, 0xe2, 0x6d, 0xa1, 0x6c, 0x3b,
+	0xfe, 0x51, 0xbf, 0x69, 0x58, 0xb4, 0x83, 0x44, 0x16, 0xfc, 0xd7, 0x77, 0x5e, 0xeb, 0x3f, 0xe4,
+	0x9f, 0x74, 0x89, 0xc7, 0x1a, 0x3c, 0x93, 0x33, 0xeb, 0x4b, 0x00, 0x32, 0x07, 0x07, 0xb8, 0x87,
+	0x3b, 0x9e, 0x34, 0xf6, 0x07, 0xf8, 0x3c, 0xf6, 0x54, 0xf8, 0xf9, 0x11, 0xe4, 0xba, 0xec, 0x49,
+	0x5e, 0x29, 0x2a, 0xe5, 0xf9, 0xdd, 0x15, 0x23, 0x71, 0x58, 0x06, 0x6f, 0xa8, 0x4d, 0x07, 0x76,
+	0x4c, 0x01, 0xd6, 0x9f, 0x28, 0x60, 0x95, 0xd1, 0xd5, 0x19, 0xce, 0x24, 0x16, 0xed, 0xb5, 0xa4,
+	0x16, 0xfc, 0x0d, 0x80, 0x9b, 0x73, 0x14, 0xc4, 0xa5, 0xd8, 0xa4, 0xfc, 0xf5, 0x90, 0xf3, 0x1e,
+	0x60, 0x9b, 0x88, 0x5e, 0x33, 0xd2, 0x09, 0x11, 0x98, 0xb7, 0x70, 0xa7, 0x8b, 0x1d, 0xdb, 0x6d,
+	0x38, 0xad, 0x7c, 0xb6, 0xa8, 0x94, 0xa7, 0x6b, 0x0b, 0xc3, 0xab, 0x02, 0xa8, 0x8b, 0xc7, 0xfb,
+	0xbf, 0x9a, 0x40, 0x42, 0xf6, 0x5b, 0xfa, 0x73, 0x05, 0xa8, 0x69, 0xb6, 0xc4, 0xb0, 0x35, 0x90,
+	0xe3, 0x73, 0x89, 0xf4, 0x37, 0x46, 0x86, 0x8d, 0xf6, 0xed, 0xb5, 0x5a, 0x3d, 0xe2, 0x85, 0x93,
+	0x73, 0x10, 0xfc, 0x3d, 0x36, 0x5b, 0x96, 0xcd, 0xb6, 0x39, 0x76, 0x36, 0x6e, 0x20, 0x3a, 0x9c,
+	0x4e, 0x40, 0x7e, 0xc4, 0xaa, 0x0c, 0x30, 0x0f, 0x3e, 0xc1, 0x5c, 0x9d, 0xa5, 0x37, 0x67, 0xca,
+	0x3f, 0x3f, 0x3e, 0x92, 0xfb, 0xd9, 0x94, 0x93, 0x0a, 0x13, 0x39, 0x02, 0x79, 0xc7, 0x75, 0x7c,
+	0x07, 0xb7, 0x1b, 0x6c, 0x3e, 0xdc, 0x6c, 0x93, 0x06, 0xee, 0xd0, 0xbe, 0xeb, 0x73, 0xe5, 0x9a,
+	0x11, 0x4c, 0xff, 0xe6, 0xaa, 0x50, 0x9a, 0xe0, 0x35, 0xdc, 0x77, 0x7d, 0x73, 0x59, 0xf0, 0xd5,
+	0x25, 0xdd, 0x1e, 0x63, 0x83, 0x3f, 0x84, 0xd9, 0x67, 0x59, 0xf6, 0xcb, 0xe9, 0xd9, 0x27, 0xd2,
+	0xfe, 0x13, 0x2c, 0x5a, 0xd4, 0xf5, 0x7b, 0xd8, 0xf2, 0x1b, 0xa2, 0x7d, 0x8a, 0xb5, 0x6b, 0xa3,
+	0xed, 0x02, 0x17, 0xa5, 0x59, 0xb0, 0xa2, 0x0f, 0x3d, 0x7d, 0x05, 0x7c, 0xc1, 0xb3, 0x10, 0xf9,
+	0x84, 0xb7, 0xe3, 0x1f, 0xb0, 0x9c, 0x2c, 0x88, 0x84, 0x7e, 0x01, 0x73, 0x32, 0xcd, 0x9b, 0x4b,
+	0x3b, 0xa2, 0x2d, 0x10, 0x42, 0xf6, 0xa6, 0x43, 0xff, 0x09, 0x2c, 0xc5, 0x88, 0xe5, 0x09, 0x17,
+	0xe2, 0xe7, 0x18, 0x64, 0x3d, 0x1d, 0x3b, 0xb7, 0xc3, 0x84, 0xd5, 0xd0, 0xd0, 0xcf, 0x60, 0x56,
+	0xc2, 0xc4, 0xd5, 0x1a, 0xeb, 0x27, 0x6c, 0xd8, 0x7d, 0x95, 0x03, 0x33, 0x8c, 0x16, 0x3e, 0x55,
+	0xc0, 0x42, 0x7c, 0x47, 0xc1, 0xed, 0x11, 0x9e, 0xdb, 0x17, 0x9d, 0xfa, 0xed, 0x64, 0x60, 0x6e,
+	0x5a, 0x2f, 0xdf, 0x7b, 0xf9, 0xfe, 0x71, 0x56, 0x87, 0x45, 0x94, 0x5c, 0xc8, 0x7e, 0xd0, 0xd0,
+	0xe8, 0x87, 0x26, 0x7c, 0x90, 0xe3, 0x1b, 0x07, 0x7e, 0x9d, 0xae, 0x10, 0x5b, 0x6b, 0xea, 0xc6,
+	0xdd, 0x20, 0x21, 0x5f, 0x60, 0xf2, 0xab, 0x70, 0x65, 0x44, 0x9e, 0xef, 0x33, 0xf8, 0x48, 0x01,
+	0x9f, 0xc5, 0x76, 0x06, 0xac, 0xa4, 0x13, 0xa7, 0xed, 0x3b, 0x75, 0x7b, 0x22, 0xac, 0xf0, 0xb2,
+	0xc9, 0xbc, 0x7c, 0x05, 0x0b, 0x28, 0xfd, 0x3f, 0x57, 0xa3, 0x27, 0x1c, 0x3c, 0x53, 0xc0, 0xa7,
+	0x51, 0x0a, 0xb8, 0x35, 0x5e, 0x46, 0x3a, 0xaa, 0x4c, 0x02, 0x15, 0x86, 0xaa, 0xcc, 0xd0, 0x36,
+	0xdc, 0x1a, 0x63, 0x08, 0x9d, 0x8a, 0x25, 0x74, 0x06, 0xcf, 0x15, 0x30, 0x17, 0x5e, 0x15, 0x58,
+	0xba, 0x45, 0x2c, 0x71, 0xc9, 0xd4, 0xcd, 0xb1, 0x38, 0xe1, 0x48, 0x67, 0x8e, 0xd6, 0xa1, 0x3a,
+	0xea, 0x28, 0x14, 0x7d, 0xa0, 0x80, 0x59, 0xd9, 0x09, 0xbf, 0xb9, 0x9b, 0x59, 0x1a, 0x28, 0x8d,
+	0x83, 0x09, 0xfd, 0x1d, 0xa6, 0x5f, 0x81, 0xe5, 0xdb, 0xf5, 0xd1, 0x69, 0xe4, 0xfa, 0x9e, 0xd5,
+	0xea, 0x17, 0x43, 0x4d, 0xb9, 0x1c, 0x6a, 0xca, 0xbb, 0xa1, 0xa6, 0x3c, 0xbc, 0xd6, 0x32, 0x97,
+	0xd7, 0x5a, 0xe6, 0xf5, 0xb5, 0x96, 0xf9, 0x77, 0x2b, 0xb2, 0x37, 0x39, 0x1b, 0xff, 0x39, 0xa8,
+	0x56, 0xd1, 0xff, 0x92, 0x99, 0xad, 0xcf, 0x66, 0x8e, 0x7d, 0xc1, 0xf8, 0xfe, 0xc3, 0x00, 0xa8,
+	0x77, 0x8c, 0x5a, 0x4d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -478,6 +699,11 @@ type QueryClient interface {
 	ClaimsRecords(ctx context.Context, in *QueryClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryClaimsRecordsResponse, error)
 	// ClaimsRecord returns the claims record for a given address
 	ClaimsRecord(ctx context.Context, in *QueryClaimsRecordRequest, opts ...grpc.CallOption) (*QueryClaimsRecordResponse, error)
+	// Campaigns returns all airdrop campaigns, including the Evmos airdrop
+	// (campaign 0)
+	Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error)
+	// Campaign returns the airdrop campaign for a given identifier
+	Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error)
 }
 
 type queryClient struct {
@@ -524,6 +750,24 @@ func (c *queryClient) ClaimsRecord(ctx context.Context, in *QueryClaimsRecordReq
 	return out, nil
 }
 
+func (c *queryClient) Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error) {
+	out := new(QueryCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Campaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error) {
+	out := new(QueryCampaignResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Campaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TotalUnclaimed queries the total unclaimed tokens from the airdrop
@@ -534,6 +778,11 @@ type QueryServer interface {
 	ClaimsRecords(context.Context, *QueryClaimsRecordsRequest) (*QueryClaimsRecordsResponse, error)
 	// ClaimsRecord returns the claims record for a given address
 	ClaimsRecord(context.Context, *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error)
+	// Campaigns returns all airdrop campaigns, including the Evmos airdrop
+	// (campaign 0)
+	Campaigns(context.Context, *QueryCampaignsRequest) (*QueryCampaignsResponse, error)
+	// Campaign returns the airdrop campaign for a given identifier
+	Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -552,6 +801,12 @@ func (*UnimplementedQueryServer) ClaimsRecords(ctx context.Context, req *QueryCl
 func (*UnimplementedQueryServer) ClaimsRecord(ctx context.Context, req *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimsRecord not implemented")
 }
+func (*UnimplementedQueryServer) Campaigns(ctx context.Context, req *QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaigns not implemented")
+}
+func (*UnimplementedQueryServer) Campaign(ctx context.Context, req *QueryCampaignRequest) (*QueryCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -629,6 +884,42 @@ func _Query_ClaimsRecord_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Campaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/Campaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaigns(ctx, req.(*QueryCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/Campaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaign(ctx, req.(*QueryCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.claims.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -649,6 +940,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimsRecord",
 			Handler:    _Query_ClaimsRecord_Handler,
 		},
+		{
+			MethodName: "Campaigns",
+			Handler:    _Query_Campaigns_Handler,
+		},
+		{
+			MethodName: "Campaign",
+			Handler:    _Query_Campaign_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/claims/v1/query.proto",
@@ -790,6 +1089,11 @@ func (m *QueryClaimsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -874,6 +1178,11 @@ func (m *QueryClaimsRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -904,6 +1213,20 @@ func (m *QueryClaimsRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractClaims) > 0 {
+		for iNdEx := len(m.ContractClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Claims) > 0 {
 		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -931,78 +1254,202 @@ func (m *QueryClaimsRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCampaignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTotalUnclaimedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryCampaignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalUnclaimedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCampaignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCampaignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCampaignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimsRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCampaignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimsRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTotalUnclaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalUnclaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimsRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QueryClaimsRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.Claims) > 0 {
@@ -1028,6 +1475,9 @@ func (m *QueryClaimsRecordRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
 	return n
 }
 
@@ -1045,6 +1495,59 @@ func (m *QueryClaimsRecordResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.ContractClaims) > 0 {
+		for _, e := range m.ContractClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCampaignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCampaignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *QueryCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1386,6 +1889,25 @@ func (m *QueryClaimsRecordsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1588,6 +2110,25 @@ func (m *QueryClaimsRecordRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1706,6 +2247,326 @@ func (m *QueryClaimsRecordResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractClaims = append(m.ContractClaims, ContractClaim{})
+			if err := m.ContractClaims[len(m.ContractClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -42,6 +42,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
+		GetCmdHookFailures(),
 	)
 
 	return cmd
@@ -123,3 +124,37 @@ func GetCmdCurrentEpoch() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdHookFailures provides the number of failed hook executions of each
+// hooks receiver module
+func GetCmdHookFailures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook-failures",
+		Short: "Query the number of failed epoch hook executions of each module",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs hook-failures`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HookFailures(cmd.Context(), &types.QueryHookFailuresRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -20,9 +20,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/epochs/types"
 )
 
@@ -30,8 +32,6 @@ import (
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
-	logger := k.Logger(ctx)
-
 	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
 		// Has it not started, and is the block time > initial epoch start time
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
@@ -45,36 +45,102 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		case shouldInitialEpochStart:
 			epochInfo.StartInitialEpoch()
 
-			logger.Info("starting epoch", "identifier", epochInfo.Identifier)
+			k.Logger(ctx).Info("starting epoch", "identifier", epochInfo.Identifier)
 		case shouldEpochEnd:
-			epochInfo.EndEpoch()
-
-			logger.Info("ending epoch", "identifier", epochInfo.Identifier)
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeEpochEnd,
-					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				),
-			)
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+			missedEpochs := epochInfo.MissedEpochs(ctx.BlockTime())
+
+			k.endEpoch(ctx, &epochInfo)
+
+			switch epochInfo.CatchUpPolicy {
+			case types.CatchUpPolicyAllAtOnce:
+				// start and end each of the missed epochs within this block, up
+				// to the per block limit. The epochs left are still missed on
+				// the next block and are caught up then.
+				if missedEpochs > types.MaxCatchUpEpochsPerBlock-1 {
+					missedEpochs = types.MaxCatchUpEpochsPerBlock - 1
+				}
+				for i := int64(0); i < missedEpochs; i++ {
+					k.startEpoch(ctx, epochInfo)
+					k.endEpoch(ctx, &epochInfo)
+				}
+			case types.CatchUpPolicySkipToNow:
+				if missedEpochs > 0 {
+					k.skipEpochs(ctx, &epochInfo, missedEpochs)
+				}
+			}
 		default:
 			// continue
 			return false
 		}
 
-		k.SetEpochInfo(ctx, epochInfo)
-
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeEpochStart,
-				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
-			),
-		)
-
-		k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+		k.startEpoch(ctx, epochInfo)
 
 		return false
 	})
 }
+
+// endEpoch ends the current epoch and executes the AfterEpochEnd hook
+func (k Keeper) endEpoch(ctx sdk.Context, epochInfo *types.EpochInfo) {
+	epochInfo.EndEpoch()
+
+	k.Logger(ctx).Info("ending epoch", "identifier", epochInfo.Identifier)
+
+	defer func() {
+		identifierLabel := telemetry.NewLabel(types.AttributeEpochIdentifier, epochInfo.Identifier)
+		evmostelemetry.IncrCounter(types.MetricKeyEpochEnd, identifierLabel)
+		evmostelemetry.SetGauge(types.MetricKeyCurrentEpoch, float32(epochInfo.CurrentEpoch), identifierLabel)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+		),
+	)
+	k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+}
+
+// startEpoch stores the epoch info and executes the BeforeEpochStart hook
+func (k Keeper) startEpoch(ctx sdk.Context, epochInfo types.EpochInfo) {
+	k.SetEpochInfo(ctx, epochInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
+		),
+	)
+
+	k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+}
+
+// skipEpochs skips the given number of missed epochs without executing the
+// epoch hooks for them and executes the AfterEpochsSkipped hook
+func (k Keeper) skipEpochs(ctx sdk.Context, epochInfo *types.EpochInfo, skippedEpochs int64) {
+	epochInfo.SkipEpochs(skippedEpochs)
+
+	k.Logger(ctx).Info(
+		"skipping missed epochs",
+		"identifier", epochInfo.Identifier,
+		"skipped-epochs", skippedEpochs,
+	)
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			types.MetricKeySkippedEpochs,
+			float32(skippedEpochs),
+			[]metrics.Label{telemetry.NewLabel(types.AttributeEpochIdentifier, epochInfo.Identifier)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochsSkipped,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeSkippedEpochs, strconv.FormatInt(skippedEpochs, 10)),
+		),
+	)
+	k.AfterEpochsSkipped(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, skippedEpochs)
+}
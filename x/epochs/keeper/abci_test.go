@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/evmos/evmos/v11/x/epochs"
 	"github.com/evmos/evmos/v11/x/epochs/types"
 )
@@ -212,3 +214,113 @@ func (suite *KeeperTestSuite) TestEpochStartingOneMonthAfterInitGenesis() {
 	suite.Require().Equal(epochInfo.CurrentEpochStartTime.UTC().String(), now.Add(month).UTC().String())
 	suite.Require().Equal(epochInfo.EpochCountingStarted, true)
 }
+
+func (suite *KeeperTestSuite) TestBeginBlockerCatchUpAllAtOnceLimit() {
+	now := time.Now().UTC()
+	duration := time.Hour
+	halt := 2*types.MaxCatchUpEpochsPerBlock + 50
+
+	suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, types.EpochInfo{
+		Identifier:              "hourly",
+		StartTime:               now,
+		Duration:                duration,
+		CurrentEpoch:            1,
+		CurrentEpochStartTime:   now,
+		EpochCountingStarted:    true,
+		CurrentEpochStartHeight: 1,
+		CatchUpPolicy:           types.CatchUpPolicyAllAtOnce,
+	})
+
+	// chain halted for 250 hours, caught up over three blocks
+	blockTime := now.Add(time.Duration(halt)*duration + time.Minute)
+	for i, expCurrentEpoch := range []int64{101, 201, 251, 251} {
+		suite.ctx = suite.ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(blockTime)
+		suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+
+		epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "hourly")
+		suite.Require().True(found)
+		suite.Require().Equal(expCurrentEpoch, epochInfo.CurrentEpoch, "block %d", i)
+	}
+
+	epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "hourly")
+	suite.Require().True(found)
+	suite.Require().Equal(now.Add(time.Duration(halt)*duration), epochInfo.CurrentEpochStartTime.UTC())
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerCatchUpPolicy() {
+	now := time.Now().UTC()
+	duration := time.Hour
+
+	testCases := []struct {
+		name            string
+		policy          types.CatchUpPolicy
+		expCurrentEpoch int64
+		expStartTime    time.Time
+		expEpochEnds    int
+		expSkipped      bool
+	}{
+		{
+			"one per block",
+			types.CatchUpPolicyOnePerBlock,
+			2,
+			now.Add(duration),
+			1,
+			false,
+		},
+		{
+			"all at once",
+			types.CatchUpPolicyAllAtOnce,
+			11,
+			now.Add(10 * duration),
+			10,
+			false,
+		},
+		{
+			"skip to now",
+			types.CatchUpPolicySkipToNow,
+			11,
+			now.Add(10 * duration),
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, types.EpochInfo{
+				Identifier:              "hourly",
+				StartTime:               now,
+				Duration:                duration,
+				CurrentEpoch:            1,
+				CurrentEpochStartTime:   now,
+				EpochCountingStarted:    true,
+				CurrentEpochStartHeight: 1,
+				CatchUpPolicy:           tc.policy,
+			})
+
+			// chain halted for 10 hours
+			suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(now.Add(10*duration + time.Minute)).
+				WithEventManager(sdk.NewEventManager())
+			suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+
+			epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "hourly")
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expCurrentEpoch, epochInfo.CurrentEpoch)
+			suite.Require().Equal(tc.expStartTime, epochInfo.CurrentEpochStartTime.UTC())
+
+			epochEnds, skipped := 0, false
+			for _, event := range suite.ctx.EventManager().Events() {
+				switch event.Type {
+				case types.EventTypeEpochEnd:
+					epochEnds++
+				case types.EventTypeEpochsSkipped:
+					skipped = true
+				}
+			}
+			suite.Require().Equal(tc.expEpochEnds, epochEnds)
+			suite.Require().Equal(tc.expSkipped, skipped)
+		})
+	}
+}
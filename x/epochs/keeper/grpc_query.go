@@ -82,3 +82,20 @@ func (k Keeper) CurrentEpoch(
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// HookFailures provides the number of failed hook executions of each hooks
+// receiver module
+func (k Keeper) HookFailures(
+	c context.Context,
+	req *types.QueryHookFailuresRequest,
+) (*types.QueryHookFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryHookFailuresResponse{
+		HookFailures: k.GetAllHookFailures(ctx),
+	}, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/epochs/types"
+)
+
+// GetHookFailures returns the number of failed hook executions of the given
+// hooks receiver module
+func (k Keeper) GetHookFailures(ctx sdk.Context, moduleName string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookFailures)
+	bz := store.Get([]byte(moduleName))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementHookFailures increments the number of failed hook executions of the
+// given hooks receiver module and returns the updated value
+func (k Keeper) IncrementHookFailures(ctx sdk.Context, moduleName string) uint64 {
+	count := k.GetHookFailures(ctx, moduleName) + 1
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookFailures)
+	store.Set([]byte(moduleName), sdk.Uint64ToBigEndian(count))
+
+	return count
+}
+
+// GetAllHookFailures returns the failure counters of every hooks receiver
+// module with at least one failed hook execution
+func (k Keeper) GetAllHookFailures(ctx sdk.Context) []types.HookFailure {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHookFailures)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	failures := []types.HookFailure{}
+	for ; iterator.Valid(); iterator.Next() {
+		failures = append(failures, types.HookFailure{
+			Module: string(iterator.Key()),
+			Count:  sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return failures
+}
@@ -17,11 +17,27 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/epochs/types"
 )
 
-var _ types.EpochHooks = MultiEpochHooks{}
+const (
+	hookAfterEpochEnd      = "after_epoch_end"
+	hookBeforeEpochStart   = "before_epoch_start"
+	hookAfterEpochsSkipped = "after_epochs_skipped"
+)
+
+var (
+	_ types.EpochHooks              = MultiEpochHooks{}
+	_ types.EpochIdentifierReferrer = MultiEpochHooks{}
+	_ types.EpochsSkippedHooks      = MultiEpochHooks{}
+)
 
 // combine multiple epoch hooks, all hook functions are run in array sequence
 type MultiEpochHooks []types.EpochHooks
@@ -46,12 +62,154 @@ func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier stri
 	}
 }
 
-// AfterEpochEnd executes the indicated hook after epochs ends
+// AfterEpochsSkipped is called after missed epochs have been skipped on the
+// hooks receivers that implement the EpochsSkippedHooks interface
+func (mh MultiEpochHooks) AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber, skippedEpochs int64) {
+	for i := range mh {
+		if hook, ok := mh[i].(types.EpochsSkippedHooks); ok {
+			hook.AfterEpochsSkipped(ctx, epochIdentifier, epochNumber, skippedEpochs)
+		}
+	}
+}
+
+// IsEpochIdentifierReferenced returns true if any of the hooks receivers that
+// implement the EpochIdentifierReferrer interface refers to the given epoch
+// identifier
+func (mh MultiEpochHooks) IsEpochIdentifierReferenced(ctx sdk.Context, epochIdentifier string) bool {
+	for i := range mh {
+		referrer, ok := mh[i].(types.EpochIdentifierReferrer)
+		if ok && referrer.IsEpochIdentifierReferenced(ctx, epochIdentifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// AfterEpochEnd executes the indicated hook after epochs ends. Each hooks
+// receiver is executed in isolation (see callHook).
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	for _, hook := range k.hookReceivers() {
+		hook := hook
+		k.callHook(ctx, hook, hookAfterEpochEnd, identifier, epochNumber, func(cacheCtx sdk.Context) {
+			hook.AfterEpochEnd(cacheCtx, identifier, epochNumber)
+		})
+	}
 }
 
-// BeforeEpochStart executes the indicated hook before the epochs
+// BeforeEpochStart executes the indicated hook before the epochs. Each hooks
+// receiver is executed in isolation (see callHook).
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	for _, hook := range k.hookReceivers() {
+		hook := hook
+		k.callHook(ctx, hook, hookBeforeEpochStart, identifier, epochNumber, func(cacheCtx sdk.Context) {
+			hook.BeforeEpochStart(cacheCtx, identifier, epochNumber)
+		})
+	}
+}
+
+// AfterEpochsSkipped executes the indicated hook after missed epochs have been
+// skipped. Each hooks receiver is executed in isolation (see callHook).
+func (k Keeper) AfterEpochsSkipped(ctx sdk.Context, identifier string, epochNumber, skippedEpochs int64) {
+	for _, hook := range k.hookReceivers() {
+		skippedHook, ok := hook.(types.EpochsSkippedHooks)
+		if !ok {
+			continue
+		}
+
+		k.callHook(ctx, hook, hookAfterEpochsSkipped, identifier, epochNumber, func(cacheCtx sdk.Context) {
+			skippedHook.AfterEpochsSkipped(cacheCtx, identifier, epochNumber, skippedEpochs)
+		})
+	}
+}
+
+// hookReceivers returns the individual hooks receivers set on the keeper
+func (k Keeper) hookReceivers() []types.EpochHooks {
+	switch hooks := k.hooks.(type) {
+	case nil:
+		return nil
+	case MultiEpochHooks:
+		return hooks
+	default:
+		return []types.EpochHooks{hooks}
+	}
+}
+
+// callHook executes the given hook function on a cached context and recovers
+// from any panic that occurs during its execution, so that a faulty hooks
+// receiver cannot halt the chain. The state changes and events of the hook are
+// only committed if it succeeds. On failure, they are discarded, a failure
+// event is emitted and the failure counter of the receiver module is
+// incremented.
+func (k Keeper) callHook(
+	ctx sdk.Context,
+	hook types.EpochHooks,
+	hookName, identifier string,
+	epochNumber int64,
+	fn func(cacheCtx sdk.Context),
+) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%v", r)
+			}
+		}()
+
+		fn(cacheCtx)
+		return nil
+	}()
+
+	if err == nil {
+		writeCache()
+		return
+	}
+
+	moduleName := hookModuleName(hook)
+	count := k.IncrementHookFailures(ctx, moduleName)
+
+	k.Logger(ctx).Error(
+		"epoch hook failed",
+		"module", moduleName,
+		"hook", hookName,
+		"identifier", identifier,
+		"epoch-number", epochNumber,
+		"failures", count,
+		"error", err.Error(),
+	)
+
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyHookFailure,
+			evmostelemetry.NewModuleLabel(moduleName),
+			telemetry.NewLabel(types.AttributeKeyHook, hookName),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochHookFailure,
+			sdk.NewAttribute(types.AttributeKeyModule, moduleName),
+			sdk.NewAttribute(types.AttributeKeyHook, hookName),
+			sdk.NewAttribute(types.AttributeEpochIdentifier, identifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// hookModuleName returns the module name reported by the hooks receiver or its
+// type name if it doesn't implement the NamedEpochHooks interface
+func hookModuleName(hook types.EpochHooks) string {
+	if named, ok := hook.(types.NamedEpochHooks); ok {
+		return named.GetModuleName()
+	}
+	return fmt.Sprintf("%T", hook)
+}
+
+// IsEpochIdentifierReferenced returns true if the hooks receivers refer to the
+// given epoch identifier
+func (k Keeper) IsEpochIdentifierReferenced(ctx sdk.Context, identifier string) bool {
+	referrer, ok := k.hooks.(types.EpochIdentifierReferrer)
+	return ok && referrer.IsEpochIdentifierReferenced(ctx, identifier)
 }
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v11/x/epochs/keeper"
+	"github.com/evmos/evmos/v11/x/epochs/types"
+)
+
+var storeKeyHook = []byte("hook")
+
+// mockEpochHooks writes to the store and panics if the panic flag is set
+type mockEpochHooks struct {
+	name     string
+	storeKey storetypes.StoreKey
+	panic    bool
+}
+
+func (h mockEpochHooks) GetModuleName() string { return h.name }
+
+func (h mockEpochHooks) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) {
+	ctx.KVStore(h.storeKey).Set(append(storeKeyHook, h.name...), []byte{1})
+	if h.panic {
+		panic("after epoch end failed")
+	}
+}
+
+func (h mockEpochHooks) BeforeEpochStart(ctx sdk.Context, _ string, _ int64) {
+	ctx.KVStore(h.storeKey).Set(append(storeKeyHook, h.name...), []byte{1})
+	if h.panic {
+		panic("before epoch start failed")
+	}
+}
+
+func (suite *KeeperTestSuite) TestHooksPanicIsolation() {
+	storeKey := suite.app.GetKey(types.StoreKey)
+
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), storeKey, authtypes.NewModuleAddress(govtypes.ModuleName),
+	).SetHooks(
+		keeper.NewMultiEpochHooks(
+			mockEpochHooks{name: "good", storeKey: storeKey},
+			mockEpochHooks{name: "faulty", storeKey: storeKey, panic: true},
+		),
+	)
+
+	suite.Require().NotPanics(func() {
+		k.BeforeEpochStart(suite.ctx, types.DayEpochID, 1)
+		k.AfterEpochEnd(suite.ctx, types.DayEpochID, 1)
+	})
+
+	store := suite.ctx.KVStore(storeKey)
+	suite.Require().True(store.Has(append(storeKeyHook, "good"...)))
+	suite.Require().False(store.Has(append(storeKeyHook, "faulty"...)))
+
+	suite.Require().Equal(uint64(0), k.GetHookFailures(suite.ctx, "good"))
+	suite.Require().Equal(uint64(2), k.GetHookFailures(suite.ctx, "faulty"))
+
+	res, err := k.HookFailures(sdk.WrapSDKContext(suite.ctx), &types.QueryHookFailuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.HookFailure{{Module: "faulty", Count: 2}}, res.HookFailures)
+
+	failureEvents := 0
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEpochHookFailure {
+			failureEvents++
+		}
+	}
+	suite.Require().Equal(2, failureEvents)
+}
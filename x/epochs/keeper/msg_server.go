@@ -0,0 +1,135 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v11/x/epochs/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateEpoch defines a method for adding a new epoch identifier
+func (k Keeper) CreateEpoch(goCtx context.Context, req *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochAlreadyExists, "identifier %s", req.Identifier)
+	}
+
+	startTime := req.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	epoch := types.EpochInfo{
+		Identifier:              req.Identifier,
+		StartTime:               startTime,
+		Duration:                req.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartTime:   startTime,
+		EpochCountingStarted:    false,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		CatchUpPolicy:           req.CatchUpPolicy,
+	}
+
+	if err := epoch.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, req.Duration.String()),
+		),
+	)
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// UpdateEpoch defines a method for updating the duration of an existing epoch
+func (k Keeper) UpdateEpoch(goCtx context.Context, req *types.MsgUpdateEpoch) (*types.MsgUpdateEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epoch, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	epoch.Duration = req.Duration
+	epoch.CatchUpPolicy = req.CatchUpPolicy
+	if err := epoch.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, req.Duration.String()),
+		),
+	)
+
+	return &types.MsgUpdateEpochResponse{}, nil
+}
+
+// DeleteEpoch defines a method for removing an epoch that is not referenced by
+// any of the epoch hooks receivers
+func (k Keeper) DeleteEpoch(goCtx context.Context, req *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", req.Identifier)
+	}
+
+	if k.IsEpochIdentifierReferenced(ctx, req.Identifier) {
+		return nil, errorsmod.Wrapf(types.ErrEpochInUse, "identifier %s", req.Identifier)
+	}
+
+	k.DeleteEpochInfo(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
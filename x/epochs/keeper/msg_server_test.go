@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v11/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgCreateEpoch
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgCreateEpoch{Authority: "foobar", Identifier: "monthly", Duration: time.Hour},
+			expectErr: true,
+		},
+		{
+			name:      "fail - epoch already exists",
+			request:   &types.MsgCreateEpoch{Authority: authority, Identifier: types.DayEpochID, Duration: time.Hour},
+			expectErr: true,
+		},
+		{
+			name:      "pass - new epoch",
+			request:   &types.MsgCreateEpoch{Authority: authority, Identifier: "monthly", Duration: time.Hour * 24 * 30},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, err := suite.app.EpochsKeeper.CreateEpoch(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			epoch, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.request.Identifier)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.request.Duration, epoch.Duration)
+			suite.Require().Equal(suite.ctx.BlockTime(), epoch.StartTime)
+			suite.Require().False(epoch.EpochCountingStarted)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateEpoch
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateEpoch{Authority: "foobar", Identifier: types.DayEpochID, Duration: time.Hour},
+			expectErr: true,
+		},
+		{
+			name:      "fail - epoch not found",
+			request:   &types.MsgUpdateEpoch{Authority: authority, Identifier: "monthly", Duration: time.Hour},
+			expectErr: true,
+		},
+		{
+			name:      "pass - update duration",
+			request:   &types.MsgUpdateEpoch{Authority: authority, Identifier: types.DayEpochID, Duration: time.Hour * 12},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, err := suite.app.EpochsKeeper.UpdateEpoch(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			epoch, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.request.Identifier)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.request.Duration, epoch.Duration)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeleteEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		request   *types.MsgDeleteEpoch
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			malleate:  func() {},
+			request:   &types.MsgDeleteEpoch{Authority: "foobar", Identifier: types.DayEpochID},
+			expectErr: true,
+		},
+		{
+			name:      "fail - epoch not found",
+			malleate:  func() {},
+			request:   &types.MsgDeleteEpoch{Authority: authority, Identifier: "monthly"},
+			expectErr: true,
+		},
+		{
+			name:      "fail - epoch referenced by inflation",
+			malleate:  func() {},
+			request:   &types.MsgDeleteEpoch{Authority: authority, Identifier: types.DayEpochID},
+			expectErr: true,
+		},
+		{
+			name:      "fail - epoch referenced by incentives",
+			malleate:  func() {},
+			request:   &types.MsgDeleteEpoch{Authority: authority, Identifier: types.WeekEpochID},
+			expectErr: true,
+		},
+		{
+			name: "pass - unreferenced epoch",
+			malleate: func() {
+				suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, types.EpochInfo{
+					Identifier: "monthly",
+					Duration:   time.Hour * 24 * 30,
+				})
+			},
+			request:   &types.MsgDeleteEpoch{Authority: authority, Identifier: "monthly"},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			_, err := suite.app.EpochsKeeper.DeleteEpoch(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			_, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.request.Identifier)
+			suite.Require().False(found)
+		})
+	}
+}
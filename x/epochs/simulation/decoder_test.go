@@ -0,0 +1,56 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/epochs/simulation"
+	"github.com/evmos/evmos/v11/x/epochs/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	epoch := types.EpochInfo{
+		Identifier:            types.DayEpochID,
+		StartTime:             time.Unix(0, 0).UTC(),
+		Duration:              time.Hour * 24,
+		CurrentEpochStartTime: time.Unix(0, 0).UTC(),
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.KeyPrefixEpoch, []byte(types.DayEpochID)...), Value: cdc.MustMarshal(&epoch)},
+			{Key: append(types.KeyPrefixHookFailures, []byte(types.DayEpochID)...), Value: sdk.Uint64ToBigEndian(3)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"EpochInfo", fmt.Sprintf("%v\n%v", epoch, epoch)},
+		{"HookFailures", "3\n3"},
+		{"other", ""},
+	}
+	for i, tc := range testCases {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			switch i {
+			case len(testCases) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tc.name)
+			default:
+				require.Equal(t, tc.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/evmos/evmos/v11/x/epochs/types"
+)
+
+// Simulation parameter constants
+const (
+	DayEpochDuration  = "day_epoch_duration"
+	WeekEpochDuration = "week_epoch_duration"
+)
+
+// GenDayEpochDuration randomizes the duration of the day epoch between 1 and
+// 48 hours
+func GenDayEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(r.Int63n(48)+1) * time.Hour
+}
+
+// GenWeekEpochDuration randomizes the duration of the week epoch between 1 and
+// 14 days
+func GenWeekEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(r.Int63n(14)+1) * 24 * time.Hour
+}
+
+// RandomizedGenState generates a random GenesisState for the epochs module
+func RandomizedGenState(simState *module.SimulationState) {
+	var dayEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DayEpochDuration, &dayEpochDuration, simState.Rand,
+		func(r *rand.Rand) { dayEpochDuration = GenDayEpochDuration(r) },
+	)
+
+	var weekEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WeekEpochDuration, &weekEpochDuration, simState.Rand,
+		func(r *rand.Rand) { weekEpochDuration = GenWeekEpochDuration(r) },
+	)
+
+	epochsGenesis := types.NewGenesisState([]types.EpochInfo{
+		{
+			Identifier: types.WeekEpochID,
+			Duration:   weekEpochDuration,
+		},
+		{
+			Identifier: types.DayEpochID,
+			Duration:   dayEpochDuration,
+		},
+	})
+
+	bz, err := json.MarshalIndent(epochsGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epochs parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochsGenesis)
+}
@@ -0,0 +1,48 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/epochs/simulation"
+	"github.com/evmos/evmos/v11/x/epochs/types"
+)
+
+// TestRandomizedGenState tests that the randomized epochs genesis state is
+// valid and deterministic for a given seed.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	genState := func(seed int64) json.RawMessage {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 20),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+			GenTimestamp: time.Unix(0, 0).UTC(),
+		}
+		simulation.RandomizedGenState(&simState)
+		return simState.GenState[types.ModuleName]
+	}
+
+	bz := genState(1)
+	require.Equal(t, bz, genState(1))
+
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &genesis)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Epochs, 2)
+}
@@ -20,8 +20,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
+// MaxCatchUpEpochsPerBlock is the maximum number of epochs that are ended in a
+// single block with the CatchUpPolicyAllAtOnce policy. The remaining missed
+// epochs are carried over to the following blocks.
+const MaxCatchUpEpochsPerBlock = 100
+
 // StartInitialEpoch sets the epoch info fields to their start values
 func (ei *EpochInfo) StartInitialEpoch() {
 	ei.EpochCountingStarted = true
@@ -35,6 +41,29 @@ func (ei *EpochInfo) EndEpoch() {
 	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration)
 }
 
+// MissedEpochs returns the number of epochs after the current one that have
+// already ended at the given block time
+func (ei EpochInfo) MissedEpochs(blockTime time.Time) int64 {
+	if ei.Duration <= 0 {
+		return 0
+	}
+
+	elapsed := blockTime.Sub(ei.CurrentEpochStartTime)
+	if elapsed <= ei.Duration {
+		return 0
+	}
+
+	// an epoch ends on the first block time strictly after its end time
+	return int64((elapsed-1)/ei.Duration) - 1
+}
+
+// SkipEpochs increments the epoch counter and the epoch start time by the
+// given number of epochs
+func (ei *EpochInfo) SkipEpochs(epochs int64) {
+	ei.CurrentEpoch += epochs
+	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration * time.Duration(epochs))
+}
+
 // Validate performs a stateless validation of the epoch info fields
 func (ei EpochInfo) Validate() error {
 	if strings.TrimSpace(ei.Identifier) == "" {
@@ -49,5 +78,13 @@ func (ei EpochInfo) Validate() error {
 	if ei.CurrentEpochStartHeight < 0 {
 		return fmt.Errorf("current epoch start height cannot be negative: %d", ei.CurrentEpochStartHeight)
 	}
+	return ValidateCatchUpPolicy(ei.CatchUpPolicy)
+}
+
+// ValidateCatchUpPolicy returns an error if the catch up policy is not defined
+func ValidateCatchUpPolicy(policy CatchUpPolicy) error {
+	if _, ok := CatchUpPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid catch up policy: %d", policy)
+	}
 	return nil
 }
@@ -30,6 +30,34 @@ func (suite *EpochInfoTestSuite) TestStartEndEpoch() {
 	suite.Require().Equal(startTime.Add(duration), ei.CurrentEpochStartTime)
 }
 
+func (suite *EpochInfoTestSuite) TestMissedEpochs() {
+	startTime := time.Now()
+	duration := time.Hour
+	ei := EpochInfo{CurrentEpochStartTime: startTime, Duration: duration}
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		expMissed int64
+	}{
+		{"current epoch not ended", startTime.Add(duration / 2), 0},
+		{"current epoch end time", startTime.Add(duration), 0},
+		{"current epoch ended", startTime.Add(duration + time.Second), 0},
+		{"next epoch end time", startTime.Add(2 * duration), 0},
+		{"one missed epoch", startTime.Add(2*duration + time.Second), 1},
+		{"ten missed epochs", startTime.Add(11*duration + time.Second), 10},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expMissed, ei.MissedEpochs(tc.blockTime), tc.name)
+	}
+
+	ei.CurrentEpoch = 1
+	ei.SkipEpochs(10)
+	suite.Require().Equal(int64(11), ei.CurrentEpoch)
+	suite.Require().Equal(startTime.Add(10*duration), ei.CurrentEpochStartTime)
+}
+
 func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 	testCases := []struct {
 		name       string
@@ -46,6 +74,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				CatchUpPolicyOnePerBlock,
 			},
 			false,
 		},
@@ -59,6 +88,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				CatchUpPolicyOnePerBlock,
 			},
 			false,
 		},
@@ -72,6 +102,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				CatchUpPolicyOnePerBlock,
 			},
 			false,
 		},
@@ -85,6 +116,21 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				CatchUpPolicyOnePerBlock,
+			},
+			false,
+		},
+		{
+			"invalid - catch up policy",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				CatchUpPolicy(10),
 			},
 			false,
 		},
@@ -98,6 +144,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				CatchUpPolicyOnePerBlock,
 			},
 			true,
 		},
@@ -18,9 +18,21 @@ package types
 
 // epochs events
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd    = "epoch_end"
+	EventTypeEpochStart  = "epoch_start"
+	EventTypeCreateEpoch = "create_epoch"
+	EventTypeUpdateEpoch = "update_epoch"
+	EventTypeDeleteEpoch = "delete_epoch"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	EventTypeEpochHookFailure = "epoch_hook_failure"
+	EventTypeEpochsSkipped    = "epochs_skipped"
+
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "identifier"
+	AttributeEpochDuration   = "duration"
+	AttributeKeyModule       = "module"
+	AttributeKeyHook         = "hook"
+	AttributeKeyError        = "error"
+	AttributeSkippedEpochs   = "skipped_epochs"
 )
@@ -28,6 +28,45 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CatchUpPolicy defines how an epoch catches up with the block time when more
+// than one epoch duration has elapsed since the start of the current epoch (eg:
+// after a chain halt).
+type CatchUpPolicy int32
+
+const (
+	// CATCH_UP_POLICY_ONE_PER_BLOCK ends at most one epoch per block, so the
+	// missed epochs are processed over the following blocks.
+	CatchUpPolicyOnePerBlock CatchUpPolicy = 0
+	// CATCH_UP_POLICY_ALL_AT_ONCE ends the missed epochs in a single block,
+	// executing the epoch hooks for each of them. At most
+	// MaxCatchUpEpochsPerBlock epochs are ended per block and the remaining ones
+	// are carried over to the following blocks.
+	CatchUpPolicyAllAtOnce CatchUpPolicy = 1
+	// CATCH_UP_POLICY_SKIP_TO_NOW ends the current epoch and skips the missed
+	// epochs without executing the epoch hooks for them.
+	CatchUpPolicySkipToNow CatchUpPolicy = 2
+)
+
+var CatchUpPolicy_name = map[int32]string{
+	0: "CATCH_UP_POLICY_ONE_PER_BLOCK",
+	1: "CATCH_UP_POLICY_ALL_AT_ONCE",
+	2: "CATCH_UP_POLICY_SKIP_TO_NOW",
+}
+
+var CatchUpPolicy_value = map[string]int32{
+	"CATCH_UP_POLICY_ONE_PER_BLOCK": 0,
+	"CATCH_UP_POLICY_ALL_AT_ONCE":   1,
+	"CATCH_UP_POLICY_SKIP_TO_NOW":   2,
+}
+
+func (x CatchUpPolicy) String() string {
+	return proto.EnumName(CatchUpPolicy_name, int32(x))
+}
+
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c74bc0b3e7fa01c2, []int{0}
+}
+
 // EpochInfo defines the message interface containing the relevant informations about
 // an epoch.
 type EpochInfo struct {
@@ -45,6 +84,9 @@ type EpochInfo struct {
 	EpochCountingStarted bool `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	// current_epoch_start_height of the epoch
 	CurrentEpochStartHeight int64 `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// catch_up_policy defines how the epoch catches up with the block time when
+	// more than one epoch has been missed
+	CatchUpPolicy CatchUpPolicy `protobuf:"varint,8,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=evmos.epochs.v1.CatchUpPolicy" json:"catch_up_policy,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -129,6 +171,13 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetCatchUpPolicy() CatchUpPolicy {
+	if m != nil {
+		return m.CatchUpPolicy
+	}
+	return CatchUpPolicyOnePerBlock
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
@@ -176,6 +225,7 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 }
 
 func init() {
+	proto.RegisterEnum("evmos.epochs.v1.CatchUpPolicy", CatchUpPolicy_name, CatchUpPolicy_value)
 	proto.RegisterType((*EpochInfo)(nil), "evmos.epochs.v1.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "evmos.epochs.v1.GenesisState")
 }
@@ -183,36 +233,46 @@ func init() {
 func init() { proto.RegisterFile("evmos/epochs/v1/genesis.proto", fileDescriptor_c74bc0b3e7fa01c2) }
 
 var fileDescriptor_c74bc0b3e7fa01c2 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xae, 0x69, 0x29, 0x57, 0x73, 0xe8, 0x84, 0x75, 0x40, 0xa8, 0x74, 0x4e, 0x14, 0x96, 0x22,
-	0x90, 0x4d, 0x81, 0x01, 0xc1, 0xd6, 0x03, 0x71, 0xac, 0x29, 0x03, 0x62, 0xa9, 0xd2, 0xd6, 0x75,
-	0x2c, 0x5d, 0xe2, 0x28, 0x79, 0xa9, 0xe8, 0xc6, 0xcc, 0x74, 0x23, 0x3f, 0xe9, 0xc6, 0x1b, 0x99,
-	0x0a, 0x6a, 0x37, 0xc6, 0xfb, 0x05, 0x28, 0x76, 0x52, 0xca, 0x15, 0xc4, 0x12, 0xc5, 0xef, 0xfb,
-	0xde, 0xf7, 0xf9, 0x7b, 0x7a, 0xc6, 0x47, 0x62, 0x1e, 0xeb, 0x9c, 0x8b, 0x54, 0x4f, 0xa2, 0x9c,
-	0xcf, 0xfb, 0x5c, 0x8a, 0x44, 0xe4, 0x2a, 0x67, 0x69, 0xa6, 0x41, 0x93, 0x03, 0x03, 0x33, 0x0b,
-	0xb3, 0x79, 0xbf, 0x7b, 0x28, 0xb5, 0xd4, 0x06, 0xe3, 0xe5, 0x9f, 0xa5, 0x75, 0xa9, 0xd4, 0x5a,
-	0x9e, 0x0a, 0x6e, 0x4e, 0xe3, 0x62, 0xc6, 0xa7, 0x45, 0x16, 0x82, 0xd2, 0x49, 0x85, 0xbb, 0x57,
-	0x71, 0x50, 0xb1, 0xc8, 0x21, 0x8c, 0x53, 0x4b, 0xf0, 0xbf, 0xb4, 0x70, 0xe7, 0x4d, 0x69, 0xf2,
-	0x2e, 0x99, 0x69, 0x42, 0x31, 0x56, 0x53, 0x91, 0x80, 0x9a, 0x29, 0x91, 0x39, 0xc8, 0x43, 0xbd,
-	0x4e, 0xb0, 0x55, 0x21, 0x1f, 0x30, 0xce, 0x21, 0xcc, 0x60, 0x54, 0xca, 0x38, 0xd7, 0x3c, 0xd4,
-	0xbb, 0xf9, 0xb4, 0xcb, 0xac, 0x07, 0xab, 0x3d, 0xd8, 0xfb, 0xda, 0x63, 0x70, 0x74, 0xbe, 0x74,
-	0x1b, 0x97, 0x4b, 0xf7, 0xf6, 0x22, 0x8c, 0x4f, 0x5f, 0xfa, 0xbf, 0x7b, 0xfd, 0xb3, 0xef, 0x2e,
-	0x0a, 0x3a, 0xa6, 0x50, 0xd2, 0x49, 0x84, 0xf7, 0xea, 0xab, 0x3b, 0x4d, 0xa3, 0x7b, 0x7f, 0x47,
-	0xf7, 0x75, 0x45, 0x18, 0xf4, 0x4b, 0xd9, 0x9f, 0x4b, 0x97, 0xd4, 0x2d, 0x8f, 0x75, 0xac, 0x40,
-	0xc4, 0x29, 0x2c, 0x2e, 0x97, 0xee, 0x81, 0x35, 0xab, 0x31, 0xff, 0x6b, 0x69, 0xb5, 0x51, 0x27,
-	0x0f, 0xf0, 0xad, 0x49, 0x91, 0x65, 0x22, 0x81, 0x91, 0x99, 0xae, 0xd3, 0xf2, 0x50, 0xaf, 0x19,
-	0xec, 0x57, 0x45, 0x33, 0x0c, 0xf2, 0x19, 0x61, 0xe7, 0x0f, 0xd6, 0x68, 0x2b, 0xf7, 0xf5, 0xff,
-	0xe6, 0x7e, 0x54, 0xe5, 0x76, 0xed, 0x55, 0xfe, 0xa5, 0x64, 0xa7, 0x70, 0x67, 0xdb, 0x79, 0xb8,
-	0x99, 0xc8, 0x73, 0x7c, 0xd7, 0xf2, 0x27, 0xba, 0x48, 0x40, 0x25, 0xd2, 0x36, 0x8a, 0xa9, 0xd3,
-	0xf6, 0x50, 0x6f, 0x2f, 0x38, 0x34, 0xe8, 0x71, 0x05, 0x0e, 0x2d, 0x46, 0x5e, 0xe1, 0xee, 0xdf,
-	0xdc, 0x22, 0xa1, 0x64, 0x04, 0xce, 0x0d, 0x13, 0xf5, 0xde, 0x8e, 0xe1, 0x89, 0x81, 0xfd, 0x13,
-	0xbc, 0xff, 0xd6, 0x6e, 0xe1, 0x10, 0x42, 0x10, 0xe4, 0x05, 0x6e, 0xdb, 0x05, 0x74, 0x90, 0xd7,
-	0x34, 0x91, 0xaf, 0x6c, 0x25, 0xdb, 0xac, 0xce, 0xa0, 0x55, 0x46, 0x0e, 0x2a, 0xfe, 0xe0, 0xf8,
-	0x7c, 0x45, 0xd1, 0xc5, 0x8a, 0xa2, 0x1f, 0x2b, 0x8a, 0xce, 0xd6, 0xb4, 0x71, 0xb1, 0xa6, 0x8d,
-	0x6f, 0x6b, 0xda, 0xf8, 0xf8, 0x50, 0x2a, 0x88, 0x8a, 0x31, 0x9b, 0xe8, 0x98, 0x57, 0x4f, 0xc0,
-	0x7c, 0xe7, 0xfd, 0x27, 0xfc, 0x53, 0xfd, 0x1c, 0x60, 0x91, 0x8a, 0x7c, 0xdc, 0x36, 0x93, 0x7d,
-	0xf6, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xf4, 0x04, 0x86, 0x2b, 0x03, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0xb5, 0xa1, 0xb4, 0x47, 0x4b, 0x8b, 0x55, 0x8a, 0x31, 0xd4, 0xb6, 0xc2, 0x12, 0x3e,
+	0x64, 0x2b, 0x85, 0x01, 0xd1, 0x01, 0x25, 0x26, 0xd0, 0xaa, 0x51, 0x1c, 0x39, 0xa9, 0xf8, 0x58,
+	0x4e, 0xa9, 0x7b, 0x75, 0x4e, 0x8d, 0x7d, 0x96, 0x7d, 0x09, 0x64, 0x63, 0x44, 0x9d, 0x3a, 0xb2,
+	0x74, 0xe2, 0x9f, 0xe9, 0x58, 0x31, 0x31, 0x05, 0xd4, 0x6e, 0x8c, 0xfd, 0x0b, 0x90, 0xef, 0xec,
+	0x92, 0xa4, 0x45, 0x2c, 0x96, 0xef, 0xfd, 0xbe, 0xee, 0x3d, 0x3d, 0x1d, 0x5c, 0xc5, 0x7d, 0x9f,
+	0xc6, 0x26, 0x0e, 0xa9, 0xdb, 0x89, 0xcd, 0x7e, 0xc9, 0xf4, 0x70, 0x80, 0x63, 0x12, 0x1b, 0x61,
+	0x44, 0x19, 0x95, 0x16, 0x39, 0x6c, 0x08, 0xd8, 0xe8, 0x97, 0x94, 0x65, 0x8f, 0x7a, 0x94, 0x63,
+	0x66, 0xf2, 0x27, 0x68, 0x8a, 0xea, 0x51, 0xea, 0x75, 0xb1, 0xc9, 0x4f, 0x3b, 0xbd, 0x3d, 0x73,
+	0xb7, 0x17, 0xb5, 0x19, 0xa1, 0x41, 0x8a, 0x6b, 0x93, 0x38, 0x23, 0x3e, 0x8e, 0x59, 0xdb, 0x0f,
+	0x05, 0xa1, 0xf0, 0x3d, 0x0f, 0xe7, 0xaa, 0x49, 0xc8, 0x66, 0xb0, 0x47, 0x25, 0x15, 0x42, 0xb2,
+	0x8b, 0x03, 0x46, 0xf6, 0x08, 0x8e, 0x64, 0xa0, 0x83, 0xe2, 0x9c, 0x33, 0x52, 0x91, 0xde, 0x41,
+	0x18, 0xb3, 0x76, 0xc4, 0x50, 0x62, 0x23, 0x4f, 0xe9, 0xa0, 0x78, 0x63, 0x4d, 0x31, 0x44, 0x86,
+	0x91, 0x65, 0x18, 0xad, 0x2c, 0xa3, 0xb2, 0x7a, 0x3c, 0xd4, 0x72, 0xe7, 0x43, 0xed, 0xd6, 0xa0,
+	0xed, 0x77, 0x5f, 0x14, 0xfe, 0x6a, 0x0b, 0x87, 0x3f, 0x35, 0xe0, 0xcc, 0xf1, 0x42, 0x42, 0x97,
+	0x3a, 0x70, 0x36, 0xbb, 0xba, 0x3c, 0xcd, 0x7d, 0xef, 0x5e, 0xf2, 0x7d, 0x95, 0x12, 0x2a, 0xa5,
+	0xc4, 0xf6, 0xf7, 0x50, 0x93, 0x32, 0xc9, 0x13, 0xea, 0x13, 0x86, 0xfd, 0x90, 0x0d, 0xce, 0x87,
+	0xda, 0xa2, 0x08, 0xcb, 0xb0, 0xc2, 0xd7, 0x24, 0xea, 0xc2, 0x5d, 0x7a, 0x00, 0x17, 0xdc, 0x5e,
+	0x14, 0xe1, 0x80, 0x21, 0x3e, 0x5d, 0x39, 0xaf, 0x83, 0xe2, 0xb4, 0x33, 0x9f, 0x16, 0xf9, 0x30,
+	0xa4, 0xcf, 0x00, 0xca, 0x63, 0x2c, 0x34, 0xd2, 0xf7, 0xb5, 0xff, 0xf6, 0xfd, 0x38, 0xed, 0x5b,
+	0x13, 0x57, 0xf9, 0x97, 0x93, 0x98, 0xc2, 0xed, 0xd1, 0xe4, 0xe6, 0xc5, 0x44, 0x9e, 0xc1, 0x15,
+	0xc1, 0x77, 0x69, 0x2f, 0x60, 0x24, 0xf0, 0x84, 0x10, 0xef, 0xca, 0x33, 0x3a, 0x28, 0xce, 0x3a,
+	0xcb, 0x1c, 0xb5, 0x52, 0xb0, 0x29, 0x30, 0x69, 0x1d, 0x2a, 0x57, 0xa5, 0x75, 0x30, 0xf1, 0x3a,
+	0x4c, 0xbe, 0xce, 0x5b, 0xbd, 0x73, 0x29, 0x70, 0x83, 0xc3, 0xd2, 0x6b, 0xb8, 0xe8, 0xb6, 0x99,
+	0xdb, 0x41, 0xbd, 0x10, 0x85, 0xb4, 0x4b, 0xdc, 0x81, 0x3c, 0xab, 0x83, 0xe2, 0xcd, 0x35, 0xd5,
+	0x98, 0x58, 0x47, 0xc3, 0x4a, 0x78, 0xdb, 0x61, 0x83, 0xb3, 0x9c, 0x05, 0x77, 0xf4, 0x58, 0xd8,
+	0x80, 0xf3, 0x6f, 0xc4, 0x36, 0x37, 0x59, 0x9b, 0x61, 0xe9, 0x39, 0x9c, 0x11, 0x4a, 0x19, 0xe8,
+	0xd3, 0x7c, 0x74, 0x93, 0x76, 0x17, 0x2b, 0x58, 0xc9, 0x27, 0xa3, 0x73, 0x52, 0xfe, 0xa3, 0x13,
+	0x00, 0x17, 0xc6, 0xa2, 0xa4, 0x97, 0x70, 0xd5, 0x2a, 0xb7, 0xac, 0x0d, 0xb4, 0xdd, 0x40, 0x0d,
+	0xbb, 0xb6, 0x69, 0xbd, 0x47, 0x76, 0xbd, 0x8a, 0x1a, 0x55, 0x07, 0x55, 0x6a, 0xb6, 0xb5, 0xb5,
+	0x94, 0x53, 0xee, 0x1f, 0x1c, 0xe9, 0xf2, 0x98, 0xca, 0x0e, 0x70, 0x03, 0x47, 0x95, 0x2e, 0x75,
+	0xf7, 0xa5, 0x75, 0x78, 0x6f, 0xd2, 0xa0, 0x5c, 0xab, 0xa1, 0x72, 0x0b, 0xd9, 0x75, 0xab, 0xba,
+	0x04, 0x14, 0xe5, 0xe0, 0x48, 0x5f, 0x19, 0x93, 0x97, 0xbb, 0xdd, 0x32, 0xb3, 0x03, 0x17, 0x5f,
+	0x25, 0x6e, 0x6e, 0x6d, 0x36, 0x50, 0xcb, 0x46, 0x75, 0xfb, 0xed, 0xd2, 0xd4, 0x15, 0xe2, 0xe6,
+	0x3e, 0x09, 0x5b, 0xb4, 0x4e, 0x3f, 0x2a, 0xf9, 0x2f, 0xdf, 0xd4, 0x5c, 0xc5, 0x3a, 0x3e, 0x55,
+	0xc1, 0xc9, 0xa9, 0x0a, 0x7e, 0x9d, 0xaa, 0xe0, 0xf0, 0x4c, 0xcd, 0x9d, 0x9c, 0xa9, 0xb9, 0x1f,
+	0x67, 0x6a, 0xee, 0xc3, 0x43, 0x8f, 0xb0, 0x4e, 0x6f, 0xc7, 0x70, 0xa9, 0x6f, 0xa6, 0xaf, 0x03,
+	0xff, 0xf6, 0x4b, 0x25, 0xf3, 0x53, 0xf6, 0x52, 0xb0, 0x41, 0x88, 0xe3, 0x9d, 0x19, 0xbe, 0x74,
+	0x4f, 0xff, 0x0c, 0x00, 0xd6, 0x26, 0xcf, 0xa4, 0x46, 0x04, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -235,6 +295,11 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CatchUpPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CatchUpPolicy))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -362,6 +427,9 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.CatchUpPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.CatchUpPolicy))
+	}
 	return n
 }
 
@@ -604,6 +672,25 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			m.CatchUpPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpPolicy |= CatchUpPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -25,3 +25,29 @@ type EpochHooks interface {
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
 }
+
+// EpochIdentifierReferrer defines the optional interface for epoch hooks
+// receivers whose state refers to an epoch identifier (eg: in their params).
+// The epochs module uses it to prevent the deletion of epochs that are in use.
+type EpochIdentifierReferrer interface {
+	// IsEpochIdentifierReferenced returns true if the module refers to the
+	// given epoch identifier
+	IsEpochIdentifierReferenced(ctx sdk.Context, epochIdentifier string) bool
+}
+
+// NamedEpochHooks defines the optional interface for epoch hooks receivers to
+// report the name of the module they belong to. It is used to identify the
+// receiver of a failed hook execution.
+type NamedEpochHooks interface {
+	// GetModuleName returns the name of the hooks receiver module
+	GetModuleName() string
+}
+
+// EpochsSkippedHooks defines the optional interface for epoch hooks receivers
+// to be notified about the epochs skipped by the CATCH_UP_POLICY_SKIP_TO_NOW
+// catch up policy. The hooks are not executed for the skipped epochs.
+type EpochsSkippedHooks interface {
+	// AfterEpochsSkipped is called after the missed epochs have been skipped,
+	// epochNumber is the number of the last skipped epoch
+	AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber, skippedEpochs int64)
+}
@@ -30,7 +30,14 @@ const (
 // prefix bytes for the epochs persistent store
 const (
 	prefixEpoch = iota + 1
+	prefixHookFailures
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs
-var KeyPrefixEpoch = []byte{prefixEpoch}
+// prefix bytes for the epochs persistent store
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs
+	KeyPrefixEpoch = []byte{prefixEpoch}
+	// KeyPrefixHookFailures defines prefix key for storing the hook failure
+	// counters of each hooks receiver module
+	KeyPrefixHookFailures = []byte{prefixHookFailures}
+)
@@ -0,0 +1,130 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateEpoch{}
+	_ sdk.Msg = &MsgUpdateEpoch{}
+	_ sdk.Msg = &MsgDeleteEpoch{}
+)
+
+const (
+	TypeMsgCreateEpoch = "create_epoch"
+	TypeMsgUpdateEpoch = "update_epoch"
+	TypeMsgDeleteEpoch = "delete_epoch"
+)
+
+// Route returns the message route for a MsgCreateEpoch message.
+func (m MsgCreateEpoch) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateEpoch message.
+func (m MsgCreateEpoch) Type() string { return TypeMsgCreateEpoch }
+
+// GetSigners returns the expected signers for a MsgCreateEpoch message.
+func (m *MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+
+	if m.Duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+
+	return ValidateCatchUpPolicy(m.CatchUpPolicy)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns the message route for a MsgUpdateEpoch message.
+func (m MsgUpdateEpoch) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateEpoch message.
+func (m MsgUpdateEpoch) Type() string { return TypeMsgUpdateEpoch }
+
+// GetSigners returns the expected signers for a MsgUpdateEpoch message.
+func (m *MsgUpdateEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+
+	if m.Duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+
+	return ValidateCatchUpPolicy(m.CatchUpPolicy)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns the message route for a MsgDeleteEpoch message.
+func (m MsgDeleteEpoch) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgDeleteEpoch message.
+func (m MsgDeleteEpoch) Type() string { return TypeMsgDeleteEpoch }
+
+// GetSigners returns the expected signers for a MsgDeleteEpoch message.
+func (m *MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
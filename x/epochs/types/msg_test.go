@@ -0,0 +1,135 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgCreateEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgCreateEpoch{Authority: "invalid", Identifier: "monthly", Duration: time.Hour},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			&MsgCreateEpoch{Authority: authority, Identifier: " ", Duration: time.Hour},
+			false,
+		},
+		{
+			"fail - zero duration",
+			&MsgCreateEpoch{Authority: authority, Identifier: "monthly"},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgCreateEpoch{Authority: authority, Identifier: "monthly", Duration: time.Hour},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateEpoch{Authority: "invalid", Identifier: DayEpochID, Duration: time.Hour},
+			false,
+		},
+		{
+			"fail - negative duration",
+			&MsgUpdateEpoch{Authority: authority, Identifier: DayEpochID, Duration: -time.Hour},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateEpoch{Authority: authority, Identifier: DayEpochID, Duration: time.Hour},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeleteEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgDeleteEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgDeleteEpoch{Authority: "invalid", Identifier: DayEpochID},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			&MsgDeleteEpoch{Authority: authority},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgDeleteEpoch{Authority: authority, Identifier: DayEpochID},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -227,46 +227,195 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// HookFailure defines the number of failed epoch hook executions of a hooks
+// receiver module
+type HookFailure struct {
+	// module is the name of the hooks receiver module
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// count is the number of failed hook executions
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *HookFailure) Reset()         { *m = HookFailure{} }
+func (m *HookFailure) String() string { return proto.CompactTextString(m) }
+func (*HookFailure) ProtoMessage()    {}
+func (*HookFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{4}
+}
+func (m *HookFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookFailure.Merge(m, src)
+}
+func (m *HookFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookFailure proto.InternalMessageInfo
+
+func (m *HookFailure) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *HookFailure) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryHookFailuresRequest is the request type for the Query/HookFailures RPC
+// method.
+type QueryHookFailuresRequest struct {
+}
+
+func (m *QueryHookFailuresRequest) Reset()         { *m = QueryHookFailuresRequest{} }
+func (m *QueryHookFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHookFailuresRequest) ProtoMessage()    {}
+func (*QueryHookFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{5}
+}
+func (m *QueryHookFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookFailuresRequest.Merge(m, src)
+}
+func (m *QueryHookFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookFailuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookFailuresRequest proto.InternalMessageInfo
+
+// QueryHookFailuresResponse is the response type for the Query/HookFailures RPC
+// method.
+type QueryHookFailuresResponse struct {
+	// hook_failures is a slice of the failure counters of each module
+	HookFailures []HookFailure `protobuf:"bytes,1,rep,name=hook_failures,json=hookFailures,proto3" json:"hook_failures"`
+}
+
+func (m *QueryHookFailuresResponse) Reset()         { *m = QueryHookFailuresResponse{} }
+func (m *QueryHookFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHookFailuresResponse) ProtoMessage()    {}
+func (*QueryHookFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{6}
+}
+func (m *QueryHookFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookFailuresResponse.Merge(m, src)
+}
+func (m *QueryHookFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryHookFailuresResponse) GetHookFailures() []HookFailure {
+	if m != nil {
+		return m.HookFailures
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "evmos.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "evmos.epochs.v1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "evmos.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "evmos.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*HookFailure)(nil), "evmos.epochs.v1.HookFailure")
+	proto.RegisterType((*QueryHookFailuresRequest)(nil), "evmos.epochs.v1.QueryHookFailuresRequest")
+	proto.RegisterType((*QueryHookFailuresResponse)(nil), "evmos.epochs.v1.QueryHookFailuresResponse")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/query.proto", fileDescriptor_d13f5778acd937ff) }
 
 var fileDescriptor_d13f5778acd937ff = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6b, 0x14, 0x31,
-	0x14, 0xc6, 0x37, 0x5b, 0x2d, 0x98, 0x56, 0x84, 0x20, 0x76, 0x3b, 0x6a, 0xba, 0xac, 0xd0, 0x6e,
-	0x7b, 0x48, 0x9c, 0xf5, 0x22, 0x9e, 0xa4, 0x45, 0xc5, 0x9b, 0xce, 0xd1, 0x8b, 0xce, 0x8e, 0xaf,
-	0x69, 0xc0, 0x26, 0xd3, 0x49, 0x66, 0xb0, 0x37, 0xf1, 0x2e, 0x08, 0xde, 0xc4, 0x3f, 0xa8, 0xc7,
-	0x82, 0x17, 0x4f, 0x22, 0xbb, 0xfe, 0x21, 0x32, 0x49, 0x56, 0x67, 0x77, 0x07, 0xd6, 0xcb, 0x10,
-	0xf2, 0xde, 0xf7, 0xbd, 0xdf, 0xfb, 0x26, 0xf8, 0x36, 0x54, 0xa7, 0xda, 0x70, 0xc8, 0x75, 0x76,
-	0x62, 0x78, 0x15, 0xf3, 0xb3, 0x12, 0x8a, 0x73, 0x96, 0x17, 0xda, 0x6a, 0x72, 0xc3, 0x15, 0x99,
-	0x2f, 0xb2, 0x2a, 0x8e, 0x0e, 0x32, 0x6d, 0xea, 0xf6, 0x71, 0x6a, 0xc0, 0x77, 0xf2, 0x2a, 0x1e,
-	0x83, 0x4d, 0x63, 0x9e, 0xa7, 0x42, 0xaa, 0xd4, 0x4a, 0xad, 0xbc, 0x38, 0xba, 0xbb, 0xe8, 0x2c,
-	0x40, 0x81, 0x91, 0x26, 0x94, 0x6f, 0x0a, 0x2d, 0xb4, 0x3b, 0xf2, 0xfa, 0x14, 0x6e, 0xef, 0x08,
-	0xad, 0xc5, 0x3b, 0xe0, 0x69, 0x2e, 0x79, 0xaa, 0x94, 0xb6, 0xce, 0x31, 0x68, 0x06, 0x6f, 0xf0,
-	0xad, 0x97, 0xf5, 0xd0, 0x27, 0xce, 0xf3, 0xb9, 0x3a, 0xd6, 0x09, 0x9c, 0x95, 0x60, 0x2c, 0x79,
-	0x8a, 0xf1, 0x3f, 0x80, 0x1e, 0xea, 0xa3, 0xe1, 0xc6, 0x68, 0x97, 0x79, 0x5a, 0x56, 0xd3, 0x32,
-	0xbf, 0x57, 0xa0, 0x65, 0x2f, 0x52, 0x01, 0x41, 0x9b, 0x34, 0x94, 0x83, 0x6f, 0x08, 0x6f, 0x2d,
-	0x8d, 0x30, 0xb9, 0x56, 0x06, 0xc8, 0x43, 0xbc, 0xee, 0x97, 0xe9, 0xa1, 0xfe, 0xda, 0x70, 0x63,
-	0x14, 0xb1, 0x85, 0x78, 0x98, 0x13, 0xd5, 0x9a, 0xc3, 0x2b, 0x17, 0x3f, 0x77, 0x3a, 0x49, 0xe8,
-	0x27, 0xcf, 0xe6, 0xe8, 0xba, 0x8e, 0x6e, 0x6f, 0x25, 0x9d, 0x1f, 0x3b, 0x87, 0xf7, 0x08, 0xf7,
-	0x1c, 0xdd, 0x51, 0x59, 0x14, 0xa0, 0xac, 0x9b, 0x37, 0x8b, 0x80, 0x62, 0x2c, 0xdf, 0x82, 0xb2,
-	0xf2, 0x58, 0x42, 0xe1, 0x22, 0xb8, 0x96, 0x34, 0x6e, 0x06, 0x8f, 0xf1, 0x76, 0x8b, 0x36, 0xec,
-	0x76, 0x0f, 0x5f, 0xcf, 0xfc, 0xfd, 0x6b, 0xc7, 0xec, 0xf4, 0x6b, 0xc9, 0x66, 0xd6, 0x68, 0x1e,
-	0x7d, 0xed, 0xe2, 0xab, 0xce, 0x82, 0x7c, 0x40, 0x18, 0xff, 0x5d, 0xd6, 0x90, 0xbd, 0xa5, 0x24,
-	0xda, 0x7f, 0x53, 0x34, 0x5c, 0xdd, 0xe8, 0x81, 0x06, 0x3b, 0x1f, 0xbf, 0xff, 0xfe, 0xd2, 0xdd,
-	0x26, 0x5b, 0x7c, 0xf1, 0x19, 0x85, 0x4c, 0x3f, 0x21, 0xbc, 0xd9, 0x5c, 0x85, 0xec, 0xb7, 0x7b,
-	0xb7, 0x44, 0x15, 0x1d, 0xfc, 0x4f, 0x6b, 0x00, 0xd9, 0x75, 0x20, 0x7d, 0x42, 0x97, 0x40, 0xe6,
-	0x02, 0x3b, 0x3c, 0xba, 0x98, 0x50, 0x74, 0x39, 0xa1, 0xe8, 0xd7, 0x84, 0xa2, 0xcf, 0x53, 0xda,
-	0xb9, 0x9c, 0xd2, 0xce, 0x8f, 0x29, 0xed, 0xbc, 0xda, 0x17, 0xd2, 0x9e, 0x94, 0x63, 0x96, 0xe9,
-	0xd3, 0x99, 0x87, 0xfb, 0x56, 0xf1, 0x7d, 0xfe, 0x7e, 0xe6, 0x67, 0xcf, 0x73, 0x30, 0xe3, 0x75,
-	0xf7, 0xce, 0x1f, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x64, 0xad, 0x04, 0x96, 0x03, 0x00,
-	0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xa6, 0x8d, 0xc4, 0x24, 0x15, 0xd2, 0xaa, 0x6a, 0x13, 0x53, 0xdc, 0xc8, 0x48,
+	0x6d, 0x9a, 0x83, 0x2d, 0x87, 0x0b, 0x82, 0x0b, 0x6a, 0x45, 0x0b, 0x37, 0xf0, 0x91, 0x4b, 0x71,
+	0x9c, 0x8d, 0x63, 0x35, 0xd9, 0x71, 0xbd, 0xeb, 0x88, 0xde, 0x10, 0x77, 0x24, 0x24, 0xae, 0x3c,
+	0x01, 0x4f, 0xd2, 0x63, 0x25, 0x2e, 0x9c, 0x10, 0x4a, 0x78, 0x10, 0x94, 0xdd, 0x0d, 0x38, 0x89,
+	0x51, 0x7a, 0x89, 0x76, 0x77, 0xe6, 0xff, 0xe7, 0x9b, 0x99, 0x24, 0xf0, 0x80, 0x8e, 0x47, 0xc8,
+	0x5d, 0x9a, 0x60, 0x38, 0xe0, 0xee, 0xd8, 0x73, 0xaf, 0x32, 0x9a, 0x5e, 0x3b, 0x49, 0x8a, 0x02,
+	0xc9, 0x7d, 0x19, 0x74, 0x54, 0xd0, 0x19, 0x7b, 0x66, 0x3b, 0x44, 0x3e, 0x4b, 0xef, 0x06, 0x9c,
+	0xaa, 0x4c, 0x77, 0xec, 0x75, 0xa9, 0x08, 0x3c, 0x37, 0x09, 0xa2, 0x98, 0x05, 0x22, 0x46, 0xa6,
+	0xc4, 0xe6, 0xc3, 0x65, 0xe7, 0x88, 0x32, 0xca, 0x63, 0xae, 0xc3, 0x3b, 0x11, 0x46, 0x28, 0x8f,
+	0xee, 0xec, 0xa4, 0x5f, 0xf7, 0x23, 0xc4, 0x68, 0x48, 0xdd, 0x20, 0x89, 0xdd, 0x80, 0x31, 0x14,
+	0xd2, 0x51, 0x6b, 0xec, 0x77, 0xb0, 0xfb, 0x66, 0x56, 0xf4, 0x85, 0xf4, 0x7c, 0xc5, 0xfa, 0xe8,
+	0xd3, 0xab, 0x8c, 0x72, 0x41, 0xce, 0x00, 0xfe, 0x01, 0xd4, 0x8d, 0xa6, 0xd1, 0xaa, 0x76, 0x0e,
+	0x1d, 0x45, 0xeb, 0xcc, 0x68, 0x1d, 0xd5, 0x97, 0xa6, 0x75, 0x5e, 0x07, 0x11, 0xd5, 0x5a, 0x3f,
+	0xa7, 0xb4, 0xbf, 0x1a, 0xb0, 0xb7, 0x52, 0x82, 0x27, 0xc8, 0x38, 0x25, 0x4f, 0xa0, 0xa2, 0x9a,
+	0xa9, 0x1b, 0xcd, 0x72, 0xab, 0xda, 0x31, 0x9d, 0xa5, 0xf1, 0x38, 0x52, 0x34, 0xd3, 0x9c, 0x6c,
+	0xde, 0xfc, 0x3c, 0x28, 0xf9, 0x3a, 0x9f, 0x9c, 0x2f, 0xd0, 0x6d, 0x48, 0xba, 0xa3, 0xb5, 0x74,
+	0xaa, 0xec, 0x02, 0xde, 0x53, 0xa8, 0x4b, 0xba, 0xd3, 0x2c, 0x4d, 0x29, 0x13, 0xb2, 0xde, 0x7c,
+	0x04, 0x16, 0x40, 0xdc, 0xa3, 0x4c, 0xc4, 0xfd, 0x98, 0xa6, 0x72, 0x04, 0xf7, 0xfc, 0xdc, 0x8b,
+	0xfd, 0x1c, 0x1a, 0x05, 0x5a, 0xdd, 0xdb, 0x23, 0xd8, 0x0e, 0xd5, 0xfb, 0x85, 0x64, 0x96, 0xfa,
+	0xb2, 0x5f, 0x0b, 0x73, 0xc9, 0xf6, 0x33, 0xa8, 0xbe, 0x44, 0xbc, 0x3c, 0x0b, 0xe2, 0x61, 0x96,
+	0x52, 0xb2, 0x0b, 0x95, 0x11, 0xf6, 0xb2, 0x21, 0xd5, 0xc5, 0xf4, 0x8d, 0xec, 0xc0, 0x56, 0x88,
+	0x19, 0x13, 0xb2, 0xd1, 0x4d, 0x5f, 0x5d, 0x6c, 0x53, 0xa3, 0xe7, 0x1c, 0xb8, 0x46, 0xb7, 0x7b,
+	0xd0, 0x28, 0x88, 0x69, 0xb4, 0x73, 0xd8, 0x1e, 0x20, 0x5e, 0x5e, 0xf4, 0x75, 0x40, 0x4f, 0x7f,
+	0x7f, 0x65, 0xfa, 0x39, 0xb5, 0x9e, 0x7f, 0x6d, 0x90, 0x33, 0xec, 0x7c, 0x2b, 0xc3, 0x96, 0x2c,
+	0x43, 0x3e, 0x18, 0x00, 0x7f, 0x77, 0xc5, 0xc9, 0xd1, 0x8a, 0x55, 0xf1, 0xb7, 0xcc, 0x6c, 0xad,
+	0x4f, 0x54, 0xd0, 0xf6, 0xc1, 0xc7, 0xef, 0xbf, 0xbf, 0x6c, 0x34, 0xc8, 0x9e, 0xbb, 0xfc, 0x2b,
+	0x50, 0x27, 0xf2, 0xc9, 0x80, 0x5a, 0x7e, 0x13, 0xe4, 0xb8, 0xd8, 0xbb, 0x60, 0xd3, 0x66, 0xfb,
+	0x2e, 0xa9, 0x1a, 0xe4, 0x50, 0x82, 0x34, 0x89, 0xb5, 0x02, 0xb2, 0xb0, 0x6f, 0xc9, 0x93, 0x1f,
+	0xff, 0xff, 0x78, 0x0a, 0xd6, 0x67, 0xb6, 0xef, 0x92, 0xba, 0x96, 0x67, 0x61, 0xc9, 0x27, 0xa7,
+	0x37, 0x13, 0xcb, 0xb8, 0x9d, 0x58, 0xc6, 0xaf, 0x89, 0x65, 0x7c, 0x9e, 0x5a, 0xa5, 0xdb, 0xa9,
+	0x55, 0xfa, 0x31, 0xb5, 0x4a, 0x6f, 0x8f, 0xa3, 0x58, 0x0c, 0xb2, 0xae, 0x13, 0xe2, 0x68, 0xee,
+	0x21, 0x3f, 0xc7, 0x9e, 0xe7, 0xbe, 0x9f, 0xfb, 0x89, 0xeb, 0x84, 0xf2, 0x6e, 0x45, 0xfe, 0x6d,
+	0x3c, 0xfe, 0x33, 0x00, 0x51, 0x9f, 0xbd, 0x83, 0xe5, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +434,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// HookFailures provide the number of failed epoch hook executions for each
+	// hooks receiver module
+	HookFailures(ctx context.Context, in *QueryHookFailuresRequest, opts ...grpc.CallOption) (*QueryHookFailuresResponse, error)
 }
 
 type queryClient struct {
@@ -313,12 +465,24 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) HookFailures(ctx context.Context, in *QueryHookFailuresRequest, opts ...grpc.CallOption) (*QueryHookFailuresResponse, error) {
+	out := new(QueryHookFailuresResponse)
+	err := c.cc.Invoke(ctx, "/evmos.epochs.v1.Query/HookFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// HookFailures provide the number of failed epoch hook executions for each
+	// hooks receiver module
+	HookFailures(context.Context, *QueryHookFailuresRequest) (*QueryHookFailuresResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -331,6 +495,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) HookFailures(ctx context.Context, req *QueryHookFailuresRequest) (*QueryHookFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookFailures not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -372,6 +539,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HookFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.epochs.v1.Query/HookFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookFailures(ctx, req.(*QueryHookFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.epochs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -384,6 +569,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "HookFailures",
+			Handler:    _Query_HookFailures_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/epochs/v1/query.proto",
@@ -531,6 +720,101 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *HookFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookFailures) > 0 {
+		for iNdEx := len(m.HookFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -599,6 +883,46 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *HookFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryHookFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHookFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HookFailures) > 0 {
+		for _, e := range m.HookFailures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -962,6 +1286,241 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HookFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHookFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHookFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHookFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHookFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHookFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHookFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookFailures = append(m.HookFailures, HookFailure{})
+			if err := m.HookFailures[len(m.HookFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -42,6 +42,28 @@ func (k Keeper) DeployERC20Contract(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
 ) (common.Address, error) {
+	data, err := erc20ContractData(coinMetadata)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
+	_, err = k.CallEVMWithData(ctx, types.ModuleAddress, nil, data, true)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract for %s", coinMetadata.Name)
+	}
+
+	return contractAddr, nil
+}
+
+// erc20ContractData returns the creation bytecode of the ERC20 contract of a
+// Cosmos coin, followed by its constructor arguments
+func erc20ContractData(coinMetadata banktypes.Metadata) ([]byte, error) {
 	decimals := uint8(0)
 	if len(coinMetadata.DenomUnits) > 0 {
 		decimalsIdx := len(coinMetadata.DenomUnits) - 1
@@ -54,25 +76,14 @@ func (k Keeper) DeployERC20Contract(
 		decimals,
 	)
 	if err != nil {
-		return common.Address{}, errorsmod.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
+		return nil, errorsmod.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
 	}
 
 	data := make([]byte, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
 	copy(data[:len(contracts.ERC20MinterBurnerDecimalsContract.Bin)], contracts.ERC20MinterBurnerDecimalsContract.Bin)
 	copy(data[len(contracts.ERC20MinterBurnerDecimalsContract.Bin):], ctorArgs)
 
-	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
-	if err != nil {
-		return common.Address{}, err
-	}
-
-	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
-	_, err = k.CallEVMWithData(ctx, types.ModuleAddress, nil, data, true)
-	if err != nil {
-		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract for %s", coinMetadata.Name)
-	}
-
-	return contractAddr, nil
+	return data, nil
 }
 
 // QueryERC20 returns the data of a deployed ERC20 contract
@@ -235,6 +246,58 @@ func (k Keeper) CallEVMWithData(
 	return res, nil
 }
 
+// CallEVMWithGasLimit performs a smart contract call with the given data and
+// gas limit, as done by the modules that call contracts back on IBC packets
+// or EVM events. The call runs on its own gas meter, limited to the gas limit,
+// so that it cannot exhaust the gas of the transaction, and any panic is
+// returned as an error. It returns the gas used by the call, which is the whole
+// gas limit if it panicked.
+func (k Keeper) CallEVMWithGasLimit(
+	ctx sdk.Context,
+	from, contract common.Address,
+	data []byte,
+	gasLimit uint64,
+) (gasUsed uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasLimit
+			err = errorsmod.Wrapf(types.ErrEVMCall, "%v", r)
+		}
+	}()
+
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		nonce,
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return 0, err
+	}
+
+	if res.Failed() {
+		return res.GasUsed, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	return res.GasUsed, nil
+}
+
 // monitorApprovalEvent returns an error if the given transactions logs include
 // an unexpected `Approval` event
 func (k Keeper) monitorApprovalEvent(res *evmtypes.MsgEthereumTxResponse) error {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -239,6 +240,63 @@ func (suite *KeeperTestSuite) TestCallEVMWithData() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCallEVMWithGasLimit() {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	testCases := []struct {
+		name     string
+		method   string
+		args     []interface{}
+		gasLimit uint64
+		expErr   error
+	}{
+		{
+			"fail - out of gas panic",
+			"balanceOf",
+			[]interface{}{tests.GenerateAddress()},
+			25_000,
+			types.ErrEVMCall,
+		},
+		{
+			"fail - reverted call",
+			"transferFrom",
+			[]interface{}{tests.GenerateAddress(), tests.GenerateAddress(), big.NewInt(1)},
+			100_000,
+			evmtypes.ErrVMExecution,
+		},
+		{
+			"pass",
+			"balanceOf",
+			[]interface{}{tests.GenerateAddress()},
+			100_000,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			contract, err := suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			data, err := erc20.Pack(tc.method, tc.args...)
+			suite.Require().NoError(err)
+
+			gasConsumed := suite.ctx.GasMeter().GasConsumed()
+			gasUsed, err := suite.app.Erc20Keeper.CallEVMWithGasLimit(suite.ctx, types.ModuleAddress, contract, data, tc.gasLimit)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Less(gasUsed, tc.gasLimit)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+			suite.Require().NotZero(gasUsed)
+
+			// the call doesn't consume the gas of the context
+			suite.Require().Equal(gasConsumed, suite.ctx.GasMeter().GasConsumed())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestForceFail() {
 	var mockEVMKeeper *MockEVMKeeper
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
@@ -17,10 +17,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v11/ibc"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/erc20/types"
 )
 
@@ -44,7 +46,12 @@ import (
 // stack if:
 // - ERC20s are disabled
 // - Denomination is native staking token
-// - The base denomination is not registered as ERC20
+// - The base denomination is not registered as ERC20 and the voucher was not
+// received on a trusted channel
+//
+// The token pair of a voucher received on a trusted channel is registered on
+// its first receive, i.e. the voucher metadata is set and its ERC20 contract
+// is deployed, before converting it.
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -88,6 +95,13 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
+	// return acknowledgement without conversion if recipient is a module
+	// account, e.g. the forward module account holding funds to forward
+	recipientAcc := k.accountKeeper.GetAccount(ctx, recipient)
+	if types.IsModuleAccount(recipientAcc) {
+		return ack
+	}
+
 	// parse the transferred denom
 	coin := ibc.GetReceivedCoin(
 		packet.SourcePort, packet.SourceChannel,
@@ -104,9 +118,19 @@ func (k Keeper) OnRecvPacket(
 
 	pairID := k.GetTokenPairID(ctx, coin.Denom)
 	if len(pairID) == 0 {
+		// register the token pair of vouchers received on a trusted channel
+		registered, err := k.registerTrustedVoucher(ctx, packet, data, coin)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
 		// short-circuit: if the denom is not registered, conversion will fail
 		// so we can continue with the rest of the stack
-		return ack
+		if registered == nil {
+			return ack
+		}
+
+		pairID = registered.GetID()
 	}
 
 	pair, _ := k.GetTokenPair(ctx, pairID)
@@ -131,20 +155,74 @@ func (k Keeper) OnRecvPacket(
 	}
 
 	defer func() {
-		telemetry.IncrCounterWithLabels(
-			[]string{types.ModuleName, "ibc", "on_recv", "total"},
-			1,
-			[]metrics.Label{
-				telemetry.NewLabel("denom", coin.Denom),
-				telemetry.NewLabel("source_channel", packet.SourceChannel),
-				telemetry.NewLabel("source_port", packet.SourcePort),
-			},
+		evmostelemetry.IncrCounter(
+			types.MetricKeyIBCOnRecv,
+			evmostelemetry.NewDenomLabel(coin.Denom),
+			evmostelemetry.NewChannelLabel(packet.DestinationChannel),
 		)
 	}()
 
 	return ack
 }
 
+// registerTrustedVoucher registers the token pair of an IBC voucher received
+// on a trusted channel. It returns nil if the voucher is not trusted. Only the
+// vouchers of tokens that are native to the counterparty chain, i.e. with a
+// single hop trace, are registered. The voucher metadata is derived from its
+// denomination trace and the optional ERC20 metadata of its trusted channel
+// entry, unless the bank metadata of the voucher is already set. The packet
+// memo is ignored, as it is controlled by the sender.
+func (k Keeper) registerTrustedVoucher(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	coin sdk.Coin,
+) (*types.TokenPair, error) {
+	// no-op: the voucher is being returned to its source chain
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.Denom) {
+		return nil, nil
+	}
+
+	trace := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, data.Denom),
+	)
+
+	// no-op: multi-hop vouchers are only registered through governance
+	if trace.Path != fmt.Sprintf("%s/%s", packet.DestinationPort, packet.DestinationChannel) {
+		return nil, nil
+	}
+
+	params := k.GetParams(ctx)
+	trusted, found := params.GetTrustedChannel(packet.DestinationChannel, trace.BaseDenom)
+	if !found {
+		return nil, nil
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom)
+	if !found {
+		var err error
+		metadata, err = types.NewIBCVoucherMetadata(trace, trusted.ERC20Metadata())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pair, err := k.RegisterCoin(ctx, metadata)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to register trusted voucher %s", coin.Denom)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement was a
 // success then nothing occurs. If the acknowledgement failed, then the sender
@@ -216,7 +294,11 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 	}
 
 	defer func() {
-		telemetry.IncrCounter(1, types.ModuleName, "ibc", "error", "total")
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(coin.Denom),
+		}
+		evmostelemetry.IncrCounter(types.MetricKeyIBCRefund, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeyIBCRefundAmount, coin.Amount, labels...)
 	}()
 
 	return nil
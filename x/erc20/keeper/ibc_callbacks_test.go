@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v11/testutil"
 
@@ -393,6 +394,203 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketTrustedVoucher() {
+	senderAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ethPk, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	receiverAddr := sdk.AccAddress(ethPk.PubKey().Address())
+
+	sourceChannel := "channel-292"
+	evmosChannel := "channel-3"
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+	expAck := ibcmock.MockAcknowledgement
+
+	testCases := []struct {
+		name          string
+		channel       string
+		denom         string
+		memo          string
+		trusted       *types.TrustedChannel
+		malleate      func(voucher string)
+		ackSuccess    bool
+		expRegistered bool
+		expName       string
+		expDecimals   uint32
+	}{
+		{
+			"no-op - untrusted channel",
+			"channel-4",
+			"uatom",
+			"",
+			nil,
+			func(string) {},
+			true,
+			false,
+			"",
+			0,
+		},
+		{
+			"no-op - denom doesn't match the trusted pattern",
+			evmosChannel,
+			"stake",
+			"",
+			nil,
+			func(string) {},
+			true,
+			false,
+			"",
+			0,
+		},
+		{
+			"no-op - multi-hop voucher",
+			evmosChannel,
+			"transfer/channel-5/uatom",
+			"",
+			nil,
+			func(string) {},
+			true,
+			false,
+			"",
+			0,
+		},
+		{
+			"no-op - voucher returned to its source chain",
+			evmosChannel,
+			fmt.Sprintf("%s/%s/uatom", transfertypes.PortID, sourceChannel),
+			"",
+			nil,
+			func(string) {},
+			true,
+			false,
+			"",
+			0,
+		},
+		{
+			"pass - register the voucher of a trusted channel",
+			evmosChannel,
+			"uatom",
+			"",
+			nil,
+			func(string) {},
+			true,
+			true,
+			"",
+			0,
+		},
+		{
+			"pass - ignore the erc20 metadata of the memo",
+			evmosChannel,
+			"uatom",
+			`{"erc20": {"name": "Fake Atom", "symbol": "ATOM", "decimals": 18}}`,
+			nil,
+			func(string) {},
+			true,
+			true,
+			"",
+			0,
+		},
+		{
+			"pass - register the voucher with the metadata of its trusted channel",
+			evmosChannel,
+			"uatom",
+			`{"erc20": {"name": "Fake Atom", "symbol": "ATOM", "decimals": 18}}`,
+			&types.TrustedChannel{ChannelId: evmosChannel, DenomPattern: "uatom", Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6},
+			func(string) {},
+			true,
+			true,
+			"Cosmos Hub Atom",
+			6,
+		},
+		{
+			"pass - register the voucher with the existing bank metadata",
+			evmosChannel,
+			"uatom",
+			"",
+			&types.TrustedChannel{ChannelId: evmosChannel, DenomPattern: "uatom", Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6},
+			func(voucher string) {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					Description: "Atom",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: voucher, Exponent: 0},
+						{Denom: "atom", Exponent: 18},
+					},
+					Base:    voucher,
+					Display: "atom",
+					Name:    "Atom",
+					Symbol:  "ATOM",
+				})
+			},
+			true,
+			true,
+			"Atom",
+			18,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.TrustedChannels = []types.TrustedChannel{types.NewTrustedChannel(evmosChannel, "u*")}
+			if tc.trusted != nil {
+				params.TrustedChannels = append(params.TrustedChannels, *tc.trusted)
+			}
+			err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			transfer := transfertypes.NewFungibleTokenPacketData(tc.denom, "1000", senderAddr.String(), receiverAddr.String(), tc.memo)
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, sourceChannel, transfertypes.PortID, tc.channel, timeoutHeight, 0)
+
+			// Fund receiver account with the vouchers minted by the transfer module
+			voucher := transfertypes.ParseDenomTrace(
+				transfertypes.GetPrefixedDenom(transfertypes.PortID, tc.channel, tc.denom),
+			).IBCDenom()
+			coins := sdk.NewCoins(sdk.NewCoin(voucher, sdk.NewInt(1000)))
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, receiverAddr, coins)
+			suite.Require().NoError(err)
+
+			tc.malleate(voucher)
+
+			ack := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, expAck)
+			if !tc.ackSuccess {
+				suite.Require().False(ack.Success(), string(ack.Acknowledgement()))
+				return
+			}
+
+			suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+			suite.Require().Equal(expAck, ack)
+
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, voucher)
+			if !tc.expRegistered {
+				suite.Require().Empty(id)
+				suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, receiverAddr))
+				return
+			}
+
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().True(pair.Enabled)
+			suite.Require().Equal(types.OWNER_MODULE, pair.ContractOwner)
+
+			// the whole voucher balance is converted
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, receiverAddr, voucher).IsZero())
+			balance := suite.app.Erc20Keeper.BalanceOf(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(receiverAddr.Bytes()))
+			suite.Require().Equal(int64(1000), balance.Int64())
+
+			expName := tc.expName
+			if expName == "" {
+				expName = voucher
+			}
+			erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pair.GetERC20Contract())
+			suite.Require().NoError(err)
+			suite.Require().Equal(expName, erc20Data.Name)
+			suite.Require().Equal(uint8(tc.expDecimals), erc20Data.Decimals)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestConvertCoinToERC20FromPacket() {
 	senderAddr := "evmos1x2w87cvt5mqjncav4lxy8yfreynn273xn5335v"
 
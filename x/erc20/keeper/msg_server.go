@@ -22,13 +22,13 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v11/contracts"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/erc20/types"
 )
 
@@ -167,23 +167,13 @@ func (k Keeper) convertCoinNativeCoin(
 	}
 
 	defer func() {
-		telemetry.IncrCounterWithLabels(
-			[]string{"tx", "msg", "convert", "coin", "total"},
-			1,
-			[]metrics.Label{
-				telemetry.NewLabel("denom", pair.Denom),
-			},
-		)
-
-		if msg.Coin.Amount.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{"tx", "msg", "convert", "coin", "amount", "total"},
-				float32(msg.Coin.Amount.Int64()),
-				[]metrics.Label{
-					telemetry.NewLabel("denom", pair.Denom),
-				},
-			)
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(pair.Denom),
 		}
+		evmostelemetry.IncrCounter(types.MetricKeyConvertCoin, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeyConvertCoinAmount, msg.Coin.Amount, labels...)
+
+		types.RecordConversion(ctx.Context(), pair.Denom, types.ConversionERC20, msg.Coin.Amount)
 	}()
 
 	ctx.EventManager().EmitEvents(
@@ -265,23 +255,13 @@ func (k Keeper) convertERC20NativeCoin(
 	}
 
 	defer func() {
-		telemetry.IncrCounterWithLabels(
-			[]string{"tx", "msg", "convert", "erc20", "total"},
-			1,
-			[]metrics.Label{
-				telemetry.NewLabel("denom", pair.Denom),
-			},
-		)
-
-		if msg.Amount.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{"tx", "msg", "convert", "erc20", "amount", "total"},
-				float32(msg.Amount.Int64()),
-				[]metrics.Label{
-					telemetry.NewLabel("denom", pair.Denom),
-				},
-			)
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(pair.Denom),
 		}
+		evmostelemetry.IncrCounter(types.MetricKeyConvertERC20, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeyConvertERC20Amount, msg.Amount, labels...)
+
+		types.RecordConversion(ctx.Context(), pair.Denom, types.ConversionCoin, msg.Amount)
 	}()
 
 	ctx.EventManager().EmitEvents(
@@ -391,23 +371,13 @@ func (k Keeper) convertERC20NativeToken(
 	}
 
 	defer func() {
-		telemetry.IncrCounterWithLabels(
-			[]string{"tx", "msg", "convert", "erc20", "total"},
-			1,
-			[]metrics.Label{
-				telemetry.NewLabel("coin", pair.Denom),
-			},
-		)
-
-		if msg.Amount.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{"tx", "msg", "convert", "erc20", "amount", "total"},
-				float32(msg.Amount.Int64()),
-				[]metrics.Label{
-					telemetry.NewLabel("denom", pair.Denom),
-				},
-			)
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(pair.Denom),
 		}
+		evmostelemetry.IncrCounter(types.MetricKeyConvertERC20, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeyConvertERC20Amount, msg.Amount, labels...)
+
+		types.RecordConversion(ctx.Context(), pair.Denom, types.ConversionCoin, msg.Amount)
 	}()
 
 	ctx.EventManager().EmitEvents(
@@ -499,23 +469,13 @@ func (k Keeper) convertCoinNativeERC20(
 	}
 
 	defer func() {
-		telemetry.IncrCounterWithLabels(
-			[]string{"tx", "msg", "convert", "coin", "total"},
-			1,
-			[]metrics.Label{
-				telemetry.NewLabel("denom", pair.Denom),
-			},
-		)
-
-		if msg.Coin.Amount.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{"tx", "msg", "convert", "coin", "amount", "total"},
-				float32(msg.Coin.Amount.Int64()),
-				[]metrics.Label{
-					telemetry.NewLabel("denom", pair.Denom),
-				},
-			)
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(pair.Denom),
 		}
+		evmostelemetry.IncrCounter(types.MetricKeyConvertCoin, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeyConvertCoinAmount, msg.Coin.Amount, labels...)
+
+		types.RecordConversion(ctx.Context(), pair.Denom, types.ConversionERC20, msg.Coin.Amount)
 	}()
 
 	ctx.EventManager().EmitEvents(
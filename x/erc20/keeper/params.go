@@ -27,8 +27,9 @@ var isTrue = []byte("0x01")
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	enableEvmHook := k.GetEnableEVMHook(ctx)
+	trustedChannels := k.GetTrustedChannels(ctx)
 
-	return types.NewParams(enableErc20, enableEvmHook)
+	return types.NewParams(enableErc20, enableEvmHook, trustedChannels...)
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -39,6 +40,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setEnableEVMHook(ctx, params.EnableEVMHook)
+	k.setTrustedChannels(ctx, params.TrustedChannels)
 
 	return nil
 }
@@ -74,3 +76,29 @@ func (k Keeper) setEnableEVMHook(ctx sdk.Context, enable bool) {
 	}
 	store.Delete(types.ParamStoreKeyEnableEVMHook)
 }
+
+// GetTrustedChannels returns the channels whose received IBC vouchers are
+// registered on their first receive
+func (k Keeper) GetTrustedChannels(ctx sdk.Context) []types.TrustedChannel {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyTrustedChannels)
+	if bz == nil {
+		return nil
+	}
+
+	var trustedChannels types.TrustedChannels
+	k.cdc.MustUnmarshal(bz, &trustedChannels)
+	return trustedChannels.Channels
+}
+
+// setTrustedChannels sets the TrustedChannels param in the store
+func (k Keeper) setTrustedChannels(ctx sdk.Context, trustedChannels []types.TrustedChannel) {
+	store := ctx.KVStore(k.storeKey)
+	if len(trustedChannels) == 0 {
+		store.Delete(types.ParamStoreKeyTrustedChannels)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&types.TrustedChannels{Channels: trustedChannels})
+	store.Set(types.ParamStoreKeyTrustedChannels, bz)
+}
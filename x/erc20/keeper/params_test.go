@@ -26,6 +26,22 @@ func (suite *KeeperTestSuite) TestParams() {
 			},
 			true,
 		},
+		{
+			"success - Checks if the trusted channels are set correctly",
+			func() interface{} {
+				params := types.DefaultParams()
+				params.TrustedChannels = []types.TrustedChannel{
+					types.NewTrustedChannel("channel-0", "uatom"),
+					types.NewTrustedChannel("channel-1", "u*"),
+				}
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params) //nolint:errcheck
+				return params
+			},
+			func() interface{} {
+				return suite.app.Erc20Keeper.GetParams(suite.ctx)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
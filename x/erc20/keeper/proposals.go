@@ -23,6 +23,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/erc20/types"
 )
 
@@ -64,6 +65,14 @@ func (k Keeper) RegisterCoin(
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
 
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyRegisterCoin,
+			evmostelemetry.NewDenomLabel(pair.Denom),
+			evmostelemetry.NewContractLabel(pair.Erc20Address),
+		)
+	}()
+
 	return &pair, nil
 }
 
@@ -91,6 +100,15 @@ func (k Keeper) RegisterERC20(
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyRegisterERC20,
+			evmostelemetry.NewDenomLabel(pair.Denom),
+			evmostelemetry.NewContractLabel(pair.Erc20Address),
+		)
+	}()
+
 	return &pair, nil
 }
 
@@ -188,6 +206,15 @@ func (k Keeper) ToggleConversion(
 	pair.Enabled = !pair.Enabled
 
 	k.SetTokenPair(ctx, pair)
+
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyToggleConversion,
+			evmostelemetry.NewDenomLabel(pair.Denom),
+			evmostelemetry.NewContractLabel(pair.Erc20Address),
+		)
+	}()
+
 	return pair, nil
 }
 
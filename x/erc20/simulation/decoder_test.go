@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/erc20/simulation"
+	"github.com/evmos/evmos/v11/x/erc20/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	tokenPair := types.NewTokenPair(tests.GenerateAddress(), "acoin", true, types.OWNER_MODULE)
+	id := tokenPair.GetID()
+	trustedChannels := types.TrustedChannels{Channels: []types.TrustedChannel{types.NewTrustedChannel("channel-0", "uatom")}}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.KeyPrefixTokenPair, id...), Value: cdc.MustMarshal(&tokenPair)},
+			{Key: append(types.KeyPrefixTokenPairByERC20, tokenPair.GetERC20Contract().Bytes()...), Value: id},
+			{Key: types.ParamStoreKeyEnableErc20, Value: []byte{0x01}},
+			{Key: types.ParamStoreKeyTrustedChannels, Value: cdc.MustMarshal(&trustedChannels)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"TokenPair", fmt.Sprintf("%v\n%v", tokenPair, tokenPair)},
+		{"TokenPairByERC20", fmt.Sprintf("%s\n%s", common.BytesToHash(id), common.BytesToHash(id))},
+		{"EnableErc20", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"TrustedChannels", fmt.Sprintf("%v\n%v", trustedChannels.Channels, trustedChannels.Channels)},
+		{"other", ""},
+	}
+	for i, tc := range testCases {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			switch i {
+			case len(testCases) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tc.name)
+			default:
+				require.Equal(t, tc.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/gogo/protobuf/proto"
+
+	erc20testutil "github.com/evmos/evmos/v11/testutil/erc20"
+	"github.com/evmos/evmos/v11/x/erc20/types"
+)
+
+// Simulation parameter constants
+const (
+	EnableErc20   = "enable_erc20"
+	EnableEVMHook = "enable_evm_hook"
+	NumTokenPairs = "num_token_pairs"
+)
+
+// GenEnableErc20 randomizes whether the token conversions are enabled
+func GenEnableErc20(r *rand.Rand) bool {
+	return r.Int63n(101) <= 95 // 95% chance of conversions being enabled
+}
+
+// GenEnableEVMHook randomizes whether the EVM hook conversions are enabled
+func GenEnableEVMHook(r *rand.Rand) bool {
+	return r.Int63n(101) <= 80 // 80% chance of the EVM hook being enabled
+}
+
+// GenNumTokenPairs randomizes the number of token pairs registered on genesis
+func GenNumTokenPairs(r *rand.Rand) int {
+	return r.Intn(4) + 1
+}
+
+// RandomizedGenState generates a random GenesisState for the erc20 module.
+// The genesis contains enabled token pairs of simulation coins, whose ERC20
+// contracts are deployed on genesis and whose coins are held by the simulation
+// accounts, so that the conversion operations aren't no-ops. More token pairs
+// are registered during the simulation through governance proposals.
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableErc20 bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableErc20, &enableErc20, simState.Rand,
+		func(r *rand.Rand) { enableErc20 = GenEnableErc20(r) },
+	)
+
+	var enableEVMHook bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableEVMHook, &enableEVMHook, simState.Rand,
+		func(r *rand.Rand) { enableEVMHook = GenEnableEVMHook(r) },
+	)
+
+	var numTokenPairs int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, NumTokenPairs, &numTokenPairs, simState.Rand,
+		func(r *rand.Rand) { numTokenPairs = GenNumTokenPairs(r) },
+	)
+
+	erc20Genesis := types.NewGenesisState(
+		types.NewParams(enableErc20, enableEVMHook),
+		genTokenPairs(simState, numTokenPairs),
+	)
+
+	bz, err := json.MarshalIndent(&erc20Genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated erc20 parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&erc20Genesis)
+}
+
+// genTokenPairs registers the given number of simulation coins as token pairs
+// on genesis. The ERC20 contracts are deployed by the erc20 module account,
+// whose sequence is set to the number of contracts, and each simulation
+// account receives a random amount of every coin, which is send enabled.
+func genTokenPairs(simState *module.SimulationState, numTokenPairs int) []types.TokenPair {
+	if numTokenPairs == 0 {
+		return []types.TokenPair{}
+	}
+
+	var (
+		pairs     []types.TokenPair
+		metadata  []banktypes.Metadata
+		accounts  []authtypes.GenesisAccount
+		contracts []evmtypes.GenesisAccount
+		supply    sdk.Coins
+		balances  = make(map[string]sdk.Coins)
+	)
+
+	for i := 0; i < numTokenPairs; i++ {
+		base := fmt.Sprintf("asimcoin%d", i)
+		display := fmt.Sprintf("simcoin%d", i)
+		coinMetadata := banktypes.Metadata{
+			Description: fmt.Sprintf("Simulation coin %d", i),
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: base, Exponent: 0},
+				{Denom: display, Exponent: 18},
+			},
+			Base:    base,
+			Display: display,
+			Name:    display,
+			Symbol:  strings.ToUpper(display),
+		}
+
+		account, contract, err := erc20testutil.GenesisContract(coinMetadata, uint64(i))
+		if err != nil {
+			panic(err)
+		}
+
+		pairs = append(pairs, types.NewTokenPair(common.BytesToAddress(account.GetAddress()), base, true, types.OWNER_MODULE))
+		metadata = append(metadata, coinMetadata)
+		accounts = append(accounts, account)
+		contracts = append(contracts, contract)
+
+		for _, simAccount := range simState.Accounts {
+			address := simAccount.Address.String()
+			coin := sdk.NewCoin(base, sdk.NewInt(simState.Rand.Int63n(1e12)+1))
+			balances[address] = balances[address].Add(coin)
+			supply = supply.Add(coin)
+		}
+	}
+
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter, authtypes.Burner)
+	if err := moduleAccount.SetSequence(uint64(numTokenPairs)); err != nil {
+		panic(err)
+	}
+	accounts = append(accounts, moduleAccount)
+
+	packed, err := authtypes.PackAccounts(accounts)
+	if err != nil {
+		panic(err)
+	}
+
+	var authGenesis authtypes.GenesisState
+	updateGenesis(simState, authtypes.ModuleName, &authGenesis, func() {
+		authGenesis.Accounts = append(authGenesis.Accounts, packed...)
+	})
+
+	var bankGenesis banktypes.GenesisState
+	updateGenesis(simState, banktypes.ModuleName, &bankGenesis, func() {
+		bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, metadata...)
+
+		// the conversions require the coins to be sendable
+		for _, coinMetadata := range metadata {
+			bankGenesis.Params.SendEnabled = append(bankGenesis.Params.SendEnabled, banktypes.NewSendEnabled(coinMetadata.Base, true))
+		}
+
+		// the coins are added to the existing balances, as each address can
+		// only have one balance
+		for i, balance := range bankGenesis.Balances {
+			if coins, found := balances[balance.Address]; found {
+				bankGenesis.Balances[i].Coins = balance.Coins.Add(coins...)
+				delete(balances, balance.Address)
+			}
+		}
+
+		for _, simAccount := range simState.Accounts {
+			address := simAccount.Address.String()
+			if coins, found := balances[address]; found {
+				bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: address, Coins: coins})
+			}
+		}
+
+		// an empty supply is computed from the balances on genesis
+		if !bankGenesis.Supply.Empty() {
+			bankGenesis.Supply = bankGenesis.Supply.Add(supply...)
+		}
+	})
+
+	var evmGenesis evmtypes.GenesisState
+	updateGenesis(simState, evmtypes.ModuleName, &evmGenesis, func() {
+		evmGenesis.Accounts = append(evmGenesis.Accounts, contracts...)
+	})
+
+	return pairs
+}
+
+// updateGenesis unmarshals the genesis state of another module, if it is set,
+// updates it and marshals it back
+func updateGenesis(simState *module.SimulationState, module string, state proto.Message, update func()) {
+	if bz, found := simState.GenState[module]; found {
+		simState.Cdc.MustUnmarshalJSON(bz, state)
+	}
+	update()
+	simState.GenState[module] = simState.Cdc.MustMarshalJSON(state)
+}
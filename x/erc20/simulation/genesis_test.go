@@ -0,0 +1,78 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/erc20/simulation"
+	"github.com/evmos/evmos/v11/x/erc20/types"
+)
+
+// TestRandomizedGenState tests that the randomized erc20 genesis state is
+// valid and deterministic for a given seed, and that its token pairs are set up
+// in the genesis states of the other modules.
+func TestRandomizedGenState(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	ethermint.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	genState := func(seed int64) map[string]json.RawMessage {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 20),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState: map[string]json.RawMessage{
+				authtypes.ModuleName: cdc.MustMarshalJSON(authtypes.DefaultGenesisState()),
+				banktypes.ModuleName: cdc.MustMarshalJSON(banktypes.DefaultGenesisState()),
+			},
+			GenTimestamp: time.Unix(0, 0).UTC(),
+		}
+		simulation.RandomizedGenState(&simState)
+		return simState.GenState
+	}
+
+	genesisState := genState(1)
+	require.Equal(t, genesisState, genState(1))
+
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[types.ModuleName], &genesis)
+	require.NoError(t, genesis.Validate())
+	require.NotEmpty(t, genesis.TokenPairs)
+
+	var authGenesis authtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenesis)
+	require.NoError(t, authtypes.ValidateGenesis(authGenesis))
+
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	require.NoError(t, bankGenesis.Validate())
+
+	var evmGenesis evmtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[evmtypes.ModuleName], &evmGenesis)
+	require.Len(t, evmGenesis.Accounts, len(genesis.TokenPairs))
+
+	for i, pair := range genesis.TokenPairs {
+		require.True(t, pair.Enabled)
+		require.Equal(t, pair.Erc20Address, evmGenesis.Accounts[i].Address)
+		require.NotEmpty(t, evmGenesis.Accounts[i].Code)
+		require.False(t, bankGenesis.Balances[0].Coins.AmountOf(pair.Denom).IsZero())
+	}
+}
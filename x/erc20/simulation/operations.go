@@ -0,0 +1,200 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v11/contracts"
+	"github.com/evmos/evmos/v11/x/erc20/keeper"
+	"github.com/evmos/evmos/v11/x/erc20/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgConvertCoin       = "op_weight_msg_convert_coin"  //nolint:gosec
+	OpWeightMsgConvertERC20      = "op_weight_msg_convert_erc20" //nolint:gosec
+	DefaultWeightMsgConvertCoin  = 100
+	DefaultWeightMsgConvertERC20 = 100
+)
+
+// WeightedOperations returns all the erc20 module operations with their
+// respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgConvertCoin, weightMsgConvertERC20 int
+	appParams.GetOrGenerate(cdc, OpWeightMsgConvertCoin, &weightMsgConvertCoin, nil,
+		func(_ *rand.Rand) { weightMsgConvertCoin = DefaultWeightMsgConvertCoin },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConvertERC20, &weightMsgConvertERC20, nil,
+		func(_ *rand.Rand) { weightMsgConvertERC20 = DefaultWeightMsgConvertERC20 },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgConvertCoin,
+			SimulateMsgConvertCoin(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConvertERC20,
+			SimulateMsgConvertERC20(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgConvertCoin generates a MsgConvertCoin that converts a random
+// amount of a registered Cosmos coin to its ERC20 representation
+func SimulateMsgConvertCoin(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pair, found := randomEnabledPair(r, ctx, k, true)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "no native coin pairs enabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(pair.Denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "insufficient balance"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, "unable to generate amount"), nil, err
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		if _, err := k.MintingEnabled(ctx, simAccount.Address, receiver.Address, pair.Denom); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertCoin, err.Error()), nil, nil
+		}
+
+		coin := sdk.NewCoin(pair.Denom, amount)
+		msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(receiver.Address), simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(coin),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgConvertERC20 generates a MsgConvertERC20 that converts a random
+// amount of the ERC20 tokens held by an account to their Cosmos coin
+func SimulateMsgConvertERC20(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		pair, found := randomEnabledPair(r, ctx, k, false)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertERC20, "no token pairs enabled"), nil, nil
+		}
+
+		// only the accounts that converted coins hold ERC20 tokens, so the
+		// sender is the first holder from a random account onwards
+		var (
+			simAccount simtypes.Account
+			sender     common.Address
+			balance    *big.Int
+		)
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		start := r.Intn(len(accs))
+		for i := range accs {
+			simAccount = accs[(start+i)%len(accs)]
+			sender = common.BytesToAddress(simAccount.Address)
+			balance = k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), sender)
+			if balance != nil && balance.Sign() > 0 {
+				break
+			}
+		}
+
+		if balance == nil || balance.Sign() <= 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertERC20, "insufficient balance"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, sdk.NewIntFromBigInt(balance))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertERC20, "unable to generate amount"), nil, err
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		if _, err := k.MintingEnabled(ctx, simAccount.Address, receiver.Address, pair.Erc20Address); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgConvertERC20, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgConvertERC20(amount, receiver.Address, pair.GetERC20Contract(), sender)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomEnabledPair returns a random token pair with the conversions enabled,
+// optionally restricted to the pairs of native Cosmos coins
+func randomEnabledPair(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, nativeCoin bool) (types.TokenPair, bool) {
+	var pairs []types.TokenPair
+	for _, pair := range k.GetTokenPairs(ctx) {
+		if pair.Enabled && (!nativeCoin || pair.IsNativeCoin()) {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	if len(pairs) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	return pairs[r.Intn(len(pairs))], true
+}
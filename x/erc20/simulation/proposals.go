@@ -0,0 +1,120 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/evmos/evmos/v11/x/erc20/keeper"
+	"github.com/evmos/evmos/v11/x/erc20/types"
+)
+
+// Governance proposal weight constants
+const (
+	OpWeightRegisterCoinProposal               = "op_weight_register_coin_proposal"           //nolint:gosec
+	OpWeightToggleTokenConversionProposal      = "op_weight_toggle_token_conversion_proposal" //nolint:gosec
+	DefaultWeightRegisterCoinProposal          = 10
+	DefaultWeightToggleTokenConversionProposal = 5
+)
+
+// ProposalContents defines the erc20 module weighted proposals' contents
+func ProposalContents(k keeper.Keeper, bk bankkeeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightRegisterCoinProposal,
+			DefaultWeightRegisterCoinProposal,
+			SimulateRegisterCoinProposalContent(k, bk),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightToggleTokenConversionProposal,
+			DefaultWeightToggleTokenConversionProposal,
+			SimulateToggleTokenConversionProposalContent(k),
+		),
+	}
+}
+
+// SimulateRegisterCoinProposalContent generates a random proposal to register
+// a Cosmos coin that has a supply but no token pair yet
+func SimulateRegisterCoinProposalContent(k keeper.Keeper, bk bankkeeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.IsERC20Enabled(ctx) {
+			return nil
+		}
+
+		var denoms []string
+		bk.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			// the EVM denomination cannot be registered
+			if !strings.Contains(coin.Denom, "evm") && !k.IsDenomRegistered(ctx, coin.Denom) {
+				denoms = append(denoms, coin.Denom)
+			}
+			return false
+		})
+
+		if len(denoms) == 0 {
+			return nil
+		}
+
+		denom := denoms[r.Intn(len(denoms))]
+		metadata, found := bk.GetDenomMetaData(ctx, denom)
+		if !found {
+			metadata = banktypes.Metadata{
+				Description: simtypes.RandStringOfLength(r, 50),
+				DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+				Base:        denom,
+				Display:     denom,
+				Name:        denom,
+				Symbol:      strings.ToUpper(denom),
+			}
+		}
+
+		return types.NewRegisterCoinProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			metadata,
+		)
+	}
+}
+
+// SimulateToggleTokenConversionProposalContent generates a random proposal to
+// toggle the conversions of a registered token pair
+func SimulateToggleTokenConversionProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.IsERC20Enabled(ctx) {
+			return nil
+		}
+
+		pairs := k.GetTokenPairs(ctx)
+		if len(pairs) == 0 {
+			return nil
+		}
+
+		pair := pairs[r.Intn(len(pairs))]
+
+		return types.NewToggleTokenConversionProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pair.Erc20Address,
+		)
+	}
+}
@@ -85,6 +85,9 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// trusted_channels are the channels whose received IBC vouchers are
+	// registered as token pairs on their first receive
+	TrustedChannels []TrustedChannel `protobuf:"bytes,3,rep,name=trusted_channels,json=trustedChannels,proto3" json:"trusted_channels"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,34 +137,185 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetTrustedChannels() []TrustedChannel {
+	if m != nil {
+		return m.TrustedChannels
+	}
+	return nil
+}
+
+// TrustedChannel defines a channel whose received IBC vouchers are registered
+// as token pairs, if their base denomination matches the pattern
+type TrustedChannel struct {
+	// channel_id is the Evmos channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom_pattern is the glob pattern of the base denominations of the
+	// vouchers, e.g. "uatom" or "*"
+	DenomPattern string `protobuf:"bytes,2,opt,name=denom_pattern,json=denomPattern,proto3" json:"denom_pattern,omitempty"`
+	// name is the optional name of the ERC20 token of the vouchers. It can only
+	// be set if the pattern is an exact base denomination.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the symbol of the ERC20 token of the vouchers. It must be set
+	// together with the name.
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// decimals is the exponent of the display denomination of the vouchers,
+	// whose name is the lowercase symbol
+	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *TrustedChannel) Reset()         { *m = TrustedChannel{} }
+func (m *TrustedChannel) String() string { return proto.CompactTextString(m) }
+func (*TrustedChannel) ProtoMessage()    {}
+func (*TrustedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{2}
+}
+func (m *TrustedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedChannel.Merge(m, src)
+}
+func (m *TrustedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustedChannel proto.InternalMessageInfo
+
+func (m *TrustedChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TrustedChannel) GetDenomPattern() string {
+	if m != nil {
+		return m.DenomPattern
+	}
+	return ""
+}
+
+func (m *TrustedChannel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TrustedChannel) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TrustedChannel) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// TrustedChannels defines the stored value of the TrustedChannels parameter
+type TrustedChannels struct {
+	// channels are the trusted channels
+	Channels []TrustedChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *TrustedChannels) Reset()         { *m = TrustedChannels{} }
+func (m *TrustedChannels) String() string { return proto.CompactTextString(m) }
+func (*TrustedChannels) ProtoMessage()    {}
+func (*TrustedChannels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{3}
+}
+func (m *TrustedChannels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustedChannels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedChannels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustedChannels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedChannels.Merge(m, src)
+}
+func (m *TrustedChannels) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedChannels) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedChannels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustedChannels proto.InternalMessageInfo
+
+func (m *TrustedChannels) GetChannels() []TrustedChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
+	proto.RegisterType((*TrustedChannel)(nil), "evmos.erc20.v1.TrustedChannel")
+	proto.RegisterType((*TrustedChannels)(nil), "evmos.erc20.v1.TrustedChannels")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0x54, 0x43, 0x24, 0xc0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x33, 0xb8,
-	0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0xd5, 0x0e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42, 0x0e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xf1,
-	0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0xe8, 0x5a, 0x43,
-	0x40, 0x4a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xba, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69, 0x5c,
-	0x6c, 0x10, 0x93, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1, 0x1a,
-	0xc1, 0xee, 0xe0, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61, 0x4a,
-	0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd, 0x93,
-	0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c, 0xcb,
-	0x05, 0x71, 0x9d, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x8e, 0x60, 0xb2, 0xcc,
-	0xd0, 0x40, 0xbf, 0x02, 0x1a, 0xa6, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xc5, 0x52, 0x8e, 0x9d, 0x01, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x8e, 0x12, 0x41,
+	0x10, 0x86, 0xe9, 0x05, 0x09, 0x14, 0xb0, 0x68, 0xc7, 0x6c, 0x46, 0xa2, 0xb3, 0x88, 0x17, 0x4e,
+	0x33, 0x82, 0x5e, 0xbc, 0x19, 0xcc, 0x46, 0x3d, 0x18, 0xc9, 0xac, 0xf1, 0xe0, 0x65, 0xd2, 0x30,
+	0x1d, 0x98, 0x40, 0x77, 0x4f, 0xa6, 0x7b, 0x27, 0xee, 0x0b, 0x78, 0xf6, 0x01, 0x7c, 0x0f, 0x5f,
+	0x61, 0x8f, 0x7b, 0xf4, 0xb4, 0x31, 0xf0, 0x22, 0x66, 0xaa, 0x7b, 0x49, 0x66, 0x3d, 0xed, 0x85,
+	0x54, 0xfd, 0xf5, 0xfd, 0xc5, 0xdf, 0x99, 0x82, 0xa7, 0xbc, 0x10, 0x4a, 0x87, 0x3c, 0x5f, 0x4e,
+	0x5f, 0x86, 0xc5, 0x24, 0x5c, 0x71, 0xc9, 0x75, 0xaa, 0x83, 0x2c, 0x57, 0x46, 0xd1, 0x63, 0x9c,
+	0x06, 0x38, 0x0d, 0x8a, 0xc9, 0x60, 0x70, 0x87, 0xb6, 0x03, 0x64, 0x07, 0x8f, 0x57, 0x6a, 0xa5,
+	0xb0, 0x0c, 0xcb, 0xca, 0xaa, 0xa3, 0x1f, 0x04, 0xba, 0xef, 0xed, 0xce, 0x73, 0xc3, 0x0c, 0xa7,
+	0xaf, 0xa1, 0x99, 0xb1, 0x9c, 0x09, 0xed, 0x91, 0x21, 0x19, 0x77, 0xa6, 0x27, 0x41, 0xf5, 0x3f,
+	0x82, 0x39, 0x4e, 0x67, 0x8d, 0xab, 0x9b, 0xd3, 0x5a, 0xe4, 0x58, 0xfa, 0x16, 0x3a, 0x46, 0x6d,
+	0xb8, 0x8c, 0x33, 0x96, 0xe6, 0xda, 0x3b, 0x1a, 0xd6, 0xc7, 0x9d, 0xe9, 0x93, 0xbb, 0xd6, 0x2f,
+	0x25, 0x32, 0x67, 0x69, 0xee, 0xdc, 0x60, 0x6e, 0x05, 0x3d, 0xfa, 0x4d, 0xa0, 0x69, 0x57, 0xd3,
+	0xe7, 0xd0, 0xe5, 0x92, 0x2d, 0xb6, 0x3c, 0x46, 0x27, 0x06, 0x69, 0x45, 0x1d, 0xab, 0x9d, 0x95,
+	0x12, 0x7d, 0x03, 0xfd, 0x5b, 0xa4, 0x10, 0xf1, 0x5a, 0xa9, 0x8d, 0x77, 0x54, 0x52, 0xb3, 0x47,
+	0xbb, 0x9b, 0xd3, 0xde, 0x99, 0x25, 0xbf, 0x7e, 0xfa, 0xa0, 0xd4, 0x26, 0xea, 0x39, 0x63, 0x21,
+	0xca, 0x96, 0x7e, 0x86, 0x87, 0x26, 0xbf, 0xd0, 0x86, 0x27, 0xf1, 0x72, 0xcd, 0xa4, 0xe4, 0x5b,
+	0xed, 0xd5, 0x31, 0xaf, 0xff, 0x5f, 0x5e, 0xcb, 0xbd, 0xb3, 0x98, 0x0b, 0xdd, 0x37, 0x15, 0x55,
+	0x8f, 0x7e, 0x11, 0x38, 0xae, 0x92, 0xf4, 0x19, 0x80, 0xdb, 0x1d, 0xa7, 0x09, 0xe6, 0x6f, 0x47,
+	0x6d, 0xa7, 0x7c, 0x4c, 0xe8, 0x0b, 0xe8, 0x25, 0x5c, 0x2a, 0x11, 0x67, 0xcc, 0x18, 0x9e, 0x4b,
+	0xcc, 0xde, 0x8e, 0xba, 0x28, 0xce, 0xad, 0x46, 0x29, 0x34, 0x24, 0x13, 0xdc, 0xab, 0xe3, 0x0c,
+	0x6b, 0x7a, 0x02, 0x4d, 0x7d, 0x29, 0x16, 0x6a, 0xeb, 0x35, 0x50, 0x75, 0x1d, 0x1d, 0x40, 0x2b,
+	0xe1, 0xcb, 0x54, 0xb0, 0xad, 0xf6, 0x1e, 0x0c, 0xc9, 0xb8, 0x17, 0x1d, 0xfa, 0xd1, 0x39, 0xf4,
+	0xab, 0xe9, 0xca, 0xaf, 0xd5, 0x3a, 0x3c, 0x9d, 0xdc, 0xe3, 0xe9, 0x07, 0xd7, 0x6c, 0x76, 0xb5,
+	0xf3, 0xc9, 0xf5, 0xce, 0x27, 0x7f, 0x77, 0x3e, 0xf9, 0xb9, 0xf7, 0x6b, 0xd7, 0x7b, 0xbf, 0xf6,
+	0x67, 0xef, 0xd7, 0xbe, 0x8d, 0x57, 0xa9, 0x59, 0x5f, 0x2c, 0x82, 0xa5, 0x12, 0xa1, 0xbb, 0x46,
+	0xfc, 0x2d, 0x26, 0x93, 0xf0, 0xbb, 0xbb, 0x4c, 0x73, 0x99, 0x71, 0xbd, 0x68, 0xe2, 0x05, 0xbe,
+	0xfa, 0x37, 0x00, 0x3e, 0x27, 0xe5, 0x8c, 0xe3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -231,6 +385,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TrustedChannels) > 0 {
+		for iNdEx := len(m.TrustedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -254,6 +422,99 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrustedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomPattern) > 0 {
+		i -= len(m.DenomPattern)
+		copy(dAtA[i:], m.DenomPattern)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DenomPattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustedChannels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustedChannels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedChannels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -294,6 +555,55 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if len(m.TrustedChannels) > 0 {
+		for _, e := range m.TrustedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TrustedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DenomPattern)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *TrustedChannels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -489,6 +799,321 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedChannels = append(m.TrustedChannels, TrustedChannel{})
+			if err := m.TrustedChannels[len(m.TrustedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedChannels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedChannels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedChannels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, TrustedChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -35,6 +35,7 @@ var (
 const (
 	TypeMsgConvertCoin  = "convert_coin"
 	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgUpdateParams = "update_params"
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -129,6 +130,12 @@ func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr.Bytes()}
 }
 
+// Route returns the message route for a MsgUpdateParams message.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams message.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Authority)
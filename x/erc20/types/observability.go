@@ -0,0 +1,72 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// Conversion directions of the observability views
+const (
+	ConversionCoin  = "coin"
+	ConversionERC20 = "erc20"
+)
+
+var (
+	tagKeyDenom     = tag.MustNewKey("denom")
+	tagKeyDirection = tag.MustNewKey("direction")
+
+	mConversions = stats.Float64("erc20/conversions", "the amount of converted tokens", "1")
+
+	viewConversions = &view.View{
+		Name:        "erc20_conversions",
+		Measure:     mConversions,
+		Description: "The number of token conversions",
+		TagKeys:     []tag.Key{tagKeyDenom, tagKeyDirection},
+		Aggregation: view.Count(),
+	}
+	viewConversionAmount = &view.View{
+		Name:        "erc20_conversion_amount",
+		Measure:     mConversions,
+		Description: "The amount of converted tokens",
+		TagKeys:     []tag.Key{tagKeyDenom, tagKeyDirection},
+		Aggregation: view.Sum(),
+	}
+)
+
+// ObservabilityViews returns the OpenCensus views of the module
+func ObservabilityViews() []*view.View {
+	return []*view.View{viewConversions, viewConversionAmount}
+}
+
+// RecordConversion records a conversion of the given amount of a token pair
+// to Cosmos coins (ConversionCoin) or ERC20 tokens (ConversionERC20)
+func RecordConversion(ctx context.Context, denom, direction string, amount sdk.Int) {
+	_ = stats.RecordWithTags(
+		ctx,
+		[]tag.Mutator{
+			tag.Upsert(tagKeyDenom, denom),
+			tag.Upsert(tagKeyDirection, direction),
+		},
+		mConversions.M(sdk.NewDecFromInt(amount).MustFloat64()),
+	)
+}
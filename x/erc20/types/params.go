@@ -18,22 +18,29 @@ package types
 
 import (
 	fmt "fmt"
+	"path"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 )
 
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
-	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+	ParamStoreKeyEnableErc20     = []byte("EnableErc20")
+	ParamStoreKeyEnableEVMHook   = []byte("EnableEVMHook")
+	ParamStoreKeyTrustedChannels = []byte("TrustedChannels")
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	trustedChannels ...TrustedChannel,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:     enableErc20,
+		EnableEVMHook:   enableEVMHook,
+		TrustedChannels: trustedChannels,
 	}
 }
 
@@ -58,5 +65,118 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateBool(p.EnableErc20)
+	if err := validateBool(p.EnableErc20); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, trusted := range p.TrustedChannels {
+		key := trusted.ChannelId + "/" + trusted.DenomPattern
+		if seen[key] {
+			return fmt.Errorf("duplicate trusted channel %s with pattern %s", trusted.ChannelId, trusted.DenomPattern)
+		}
+		seen[key] = true
+
+		if err := trusted.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsTrustedVoucher returns true if the vouchers of the base denomination
+// received on the channel are registered on their first receive
+func (p Params) IsTrustedVoucher(channelID, baseDenom string) bool {
+	_, found := p.GetTrustedChannel(channelID, baseDenom)
+	return found
+}
+
+// GetTrustedChannel returns the trusted channel entry that matches the vouchers
+// of the base denomination received on the channel. An entry for the exact
+// base denomination takes precedence over the glob patterns.
+func (p Params) GetTrustedChannel(channelID, baseDenom string) (TrustedChannel, bool) {
+	var (
+		match TrustedChannel
+		found bool
+	)
+
+	for _, trusted := range p.TrustedChannels {
+		if !trusted.Matches(channelID, baseDenom) {
+			continue
+		}
+
+		if trusted.DenomPattern == baseDenom {
+			return trusted, true
+		}
+
+		if !found {
+			match, found = trusted, true
+		}
+	}
+
+	return match, found
+}
+
+// NewTrustedChannel creates a new TrustedChannel object
+func NewTrustedChannel(channelID, denomPattern string) TrustedChannel {
+	return TrustedChannel{
+		ChannelId:    channelID,
+		DenomPattern: denomPattern,
+	}
+}
+
+// Validate performs a stateless validation of the trusted channel fields
+func (tc TrustedChannel) Validate() error {
+	if err := host.ChannelIdentifierValidator(tc.ChannelId); err != nil {
+		return err
+	}
+
+	if tc.DenomPattern == "" {
+		return fmt.Errorf("denom pattern of trusted channel %s cannot be empty", tc.ChannelId)
+	}
+
+	if _, err := path.Match(tc.DenomPattern, ""); err != nil {
+		return fmt.Errorf("invalid denom pattern %s of trusted channel %s: %w", tc.DenomPattern, tc.ChannelId, err)
+	}
+
+	metadata := tc.ERC20Metadata()
+	if metadata == nil {
+		if tc.Decimals != 0 {
+			return fmt.Errorf("decimals of trusted channel %s cannot be set without a name and symbol", tc.ChannelId)
+		}
+		return nil
+	}
+
+	// the metadata of a pattern would be shared by all the matching vouchers
+	if strings.ContainsAny(tc.DenomPattern, `*?[\`) {
+		return fmt.Errorf("erc20 metadata of trusted channel %s requires an exact base denomination, got pattern %s", tc.ChannelId, tc.DenomPattern)
+	}
+
+	return metadata.Validate()
+}
+
+// ERC20Metadata returns the ERC20 metadata of the vouchers of the trusted
+// channel. It returns nil if neither the name nor the symbol are set.
+func (tc TrustedChannel) ERC20Metadata() *ERC20Metadata {
+	if tc.Name == "" && tc.Symbol == "" {
+		return nil
+	}
+
+	return &ERC20Metadata{
+		Name:     tc.Name,
+		Symbol:   tc.Symbol,
+		Decimals: tc.Decimals,
+	}
+}
+
+// Matches returns true if the base denomination received on the channel
+// matches the trusted channel
+func (tc TrustedChannel) Matches(channelID, baseDenom string) bool {
+	if tc.ChannelId != channelID {
+		return false
+	}
+
+	matched, err := path.Match(tc.DenomPattern, baseDenom)
+	return err == nil && matched
 }
@@ -31,6 +31,56 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			Params{},
 			false,
 		},
+		{
+			"valid trusted channels",
+			NewParams(true, true, NewTrustedChannel("channel-0", "uatom"), NewTrustedChannel("channel-1", "*")),
+			false,
+		},
+		{
+			"duplicate trusted channel",
+			NewParams(true, true, NewTrustedChannel("channel-0", "uatom"), NewTrustedChannel("channel-0", "uatom")),
+			true,
+		},
+		{
+			"invalid trusted channel id",
+			NewParams(true, true, NewTrustedChannel("channel", "uatom")),
+			true,
+		},
+		{
+			"empty trusted denom pattern",
+			NewParams(true, true, NewTrustedChannel("channel-0", "")),
+			true,
+		},
+		{
+			"malformed trusted denom pattern",
+			NewParams(true, true, NewTrustedChannel("channel-0", "u[atom")),
+			true,
+		},
+		{
+			"trusted channel with erc20 metadata",
+			NewParams(true, true, TrustedChannel{ChannelId: "channel-0", DenomPattern: "uatom", Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6}),
+			false,
+		},
+		{
+			"erc20 metadata of a pattern",
+			NewParams(true, true, TrustedChannel{ChannelId: "channel-0", DenomPattern: "u*", Name: "Cosmos Hub Atom", Symbol: "ATOM"}),
+			true,
+		},
+		{
+			"erc20 metadata without symbol",
+			NewParams(true, true, TrustedChannel{ChannelId: "channel-0", DenomPattern: "uatom", Name: "Cosmos Hub Atom"}),
+			true,
+		},
+		{
+			"decimals without name and symbol",
+			NewParams(true, true, TrustedChannel{ChannelId: "channel-0", DenomPattern: "uatom", Decimals: 6}),
+			true,
+		},
+		{
+			"duplicate trusted channel with different metadata",
+			NewParams(true, true, NewTrustedChannel("channel-0", "uatom"), TrustedChannel{ChannelId: "channel-0", DenomPattern: "uatom", Name: "Cosmos Hub Atom", Symbol: "ATOM"}),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -48,3 +98,49 @@ func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(1))
 	suite.Require().NoError(validateBool(true))
 }
+
+func (suite *ParamsTestSuite) TestIsTrustedVoucher() {
+	params := NewParams(
+		true, true,
+		NewTrustedChannel("channel-0", "uatom"),
+		NewTrustedChannel("channel-1", "u*"),
+		NewTrustedChannel("channel-2", "gamm/pool/*"),
+	)
+
+	testCases := []struct {
+		name      string
+		channelID string
+		baseDenom string
+		expPass   bool
+	}{
+		{"exact match", "channel-0", "uatom", true},
+		{"exact match on another channel", "channel-1", "uatom", true},
+		{"different denom", "channel-0", "uosmo", false},
+		{"untrusted channel", "channel-3", "uatom", false},
+		{"wildcard match", "channel-1", "uosmo", true},
+		{"wildcard mismatch", "channel-1", "aevmos", false},
+		{"wildcard doesn't match separator", "channel-1", "ufoo/bar", false},
+		{"match with separator", "channel-2", "gamm/pool/1", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expPass, params.IsTrustedVoucher(tc.channelID, tc.baseDenom), tc.name)
+	}
+}
+
+func (suite *ParamsTestSuite) TestGetTrustedChannel() {
+	exact := TrustedChannel{ChannelId: "channel-0", DenomPattern: "uatom", Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6}
+	params := NewParams(true, true, NewTrustedChannel("channel-0", "u*"), exact)
+
+	trusted, found := params.GetTrustedChannel("channel-0", "uatom")
+	suite.Require().True(found)
+	suite.Require().Equal(exact, trusted)
+	suite.Require().Equal(&ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6}, trusted.ERC20Metadata())
+
+	trusted, found = params.GetTrustedChannel("channel-0", "uosmo")
+	suite.Require().True(found)
+	suite.Require().Nil(trusted.ERC20Metadata())
+
+	_, found = params.GetTrustedChannel("channel-1", "uatom")
+	suite.Require().False(found)
+}
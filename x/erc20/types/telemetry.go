@@ -0,0 +1,47 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+// Telemetry metric keys of the module
+var (
+	MetricKeyConvertCoin        = []string{ModuleName, "convert_coin", "total"}
+	MetricKeyConvertCoinAmount  = []string{ModuleName, "convert_coin", "amount", "total"}
+	MetricKeyConvertERC20       = []string{ModuleName, "convert_erc20", "total"}
+	MetricKeyConvertERC20Amount = []string{ModuleName, "convert_erc20", "amount", "total"}
+	MetricKeyRegisterCoin       = []string{ModuleName, "register_coin", "total"}
+	MetricKeyRegisterERC20      = []string{ModuleName, "register_erc20", "total"}
+	MetricKeyToggleConversion   = []string{ModuleName, "toggle_conversion", "total"}
+	MetricKeyIBCOnRecv          = []string{ModuleName, "ibc", "on_recv", "total"}
+	MetricKeyIBCRefund          = []string{ModuleName, "ibc", "refund", "total"}
+	MetricKeyIBCRefundAmount    = []string{ModuleName, "ibc", "refund", "amount", "total"}
+)
+
+// MetricKeys returns the telemetry metric keys of the module
+func MetricKeys() [][]string {
+	return [][]string{
+		MetricKeyConvertCoin,
+		MetricKeyConvertCoinAmount,
+		MetricKeyConvertERC20,
+		MetricKeyConvertERC20Amount,
+		MetricKeyRegisterCoin,
+		MetricKeyRegisterERC20,
+		MetricKeyToggleConversion,
+		MetricKeyIBCOnRecv,
+		MetricKeyIBCRefund,
+		MetricKeyIBCRefundAmount,
+	}
+}
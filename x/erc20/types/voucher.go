@@ -0,0 +1,101 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+)
+
+// MaxERC20Decimals is the maximum number of decimals of the ERC20 metadata of
+// a trusted channel
+const MaxERC20Decimals = 18
+
+// ERC20Metadata defines the metadata of the ERC20 token of an IBC voucher
+type ERC20Metadata struct {
+	// Name is the name of the token
+	Name string
+	// Symbol is the symbol of the token
+	Symbol string
+	// Decimals is the exponent of the display denomination of the token,
+	// whose name is the lowercase symbol
+	Decimals uint32
+}
+
+// Validate performs a stateless validation of the ERC20 metadata
+func (m ERC20Metadata) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return errorsmod.Wrap(ErrInvalidERC20Metadata, "name cannot be blank")
+	}
+
+	if strings.TrimSpace(m.Symbol) == "" {
+		return errorsmod.Wrap(ErrInvalidERC20Metadata, "symbol cannot be blank")
+	}
+
+	if m.Decimals > MaxERC20Decimals {
+		return errorsmod.Wrapf(ErrInvalidERC20Metadata, "decimals cannot be greater than %d, got %d", MaxERC20Decimals, m.Decimals)
+	}
+
+	return nil
+}
+
+// NewIBCVoucherMetadata returns the bank metadata of the IBC voucher of a
+// denomination trace. The base denomination is used as the name and symbol of
+// the voucher, unless ERC20 metadata is provided.
+func NewIBCVoucherMetadata(trace transfertypes.DenomTrace, erc20 *ERC20Metadata) (banktypes.Metadata, error) {
+	base := trace.IBCDenom()
+
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("IBC voucher of %s received on %s", trace.BaseDenom, trace.Path),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    base,
+				Exponent: 0,
+				Aliases:  []string{trace.BaseDenom},
+			},
+		},
+		Base:    base,
+		Display: base,
+		Name:    base,
+		Symbol:  trace.BaseDenom,
+	}
+
+	if erc20 != nil {
+		metadata.Name = erc20.Name
+		metadata.Symbol = erc20.Symbol
+
+		// only append a display unit if decimals > 0, otherwise validation fails
+		if erc20.Decimals > 0 {
+			display := strings.ToLower(erc20.Symbol)
+			metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+				Denom:    display,
+				Exponent: erc20.Decimals,
+			})
+			metadata.Display = display
+		}
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, errorsmod.Wrapf(ErrInvalidERC20Metadata, "invalid metadata of %s: %s", base, err)
+	}
+
+	return metadata, nil
+}
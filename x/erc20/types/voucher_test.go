@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestERC20MetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata ERC20Metadata
+		expPass  bool
+	}{
+		{"valid", ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6}, true},
+		{"valid without decimals", ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM"}, true},
+		{"blank name", ERC20Metadata{Name: " ", Symbol: "ATOM"}, false},
+		{"blank symbol", ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: ""}, false},
+		{"too many decimals", ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 19}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.metadata.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestNewIBCVoucherMetadata(t *testing.T) {
+	trace := transfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	ibcDenom := trace.IBCDenom()
+
+	testCases := []struct {
+		name       string
+		erc20      *ERC20Metadata
+		expName    string
+		expSymbol  string
+		expDisplay string
+		expUnits   int
+		expError   bool
+	}{
+		{"without erc20 metadata", nil, ibcDenom, "uatom", ibcDenom, 1, false},
+		{"with erc20 metadata", &ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6}, "Cosmos Hub Atom", "ATOM", "atom", 2, false},
+		{"with erc20 metadata without decimals", &ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM"}, "Cosmos Hub Atom", "ATOM", ibcDenom, 1, false},
+		{"invalid display denom", &ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "A", Decimals: 6}, "", "", "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		metadata, err := NewIBCVoucherMetadata(trace, tc.erc20)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, ibcDenom, metadata.Base, tc.name)
+		require.Equal(t, tc.expName, metadata.Name, tc.name)
+		require.Equal(t, tc.expSymbol, metadata.Symbol, tc.name)
+		require.Equal(t, tc.expDisplay, metadata.Display, tc.name)
+		require.Len(t, metadata.DenomUnits, tc.expUnits, tc.name)
+	}
+}
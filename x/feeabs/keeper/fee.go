@@ -0,0 +1,180 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/feeabs/types"
+)
+
+// IsFeeToken returns true if fee abstraction is enabled and the denomination
+// is a whitelisted fee token
+func (k Keeper) IsFeeToken(ctx sdk.Context, denom string) bool {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeAbstraction {
+		return false
+	}
+
+	_, found := params.GetFeeToken(denom)
+	return found
+}
+
+// ExchangeRate returns the amount of EVM denomination units paid for one unit
+// of the fee token. The fee token must be a registered and enabled ERC20 token
+// pair.
+func (k Keeper) ExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeAbstraction {
+		return sdk.Dec{}, types.ErrFeeAbstractionDisabled
+	}
+
+	token, found := params.GetFeeToken(denom)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "%s is not a whitelisted fee token", denom)
+	}
+
+	if denom == k.evmKeeper.GetParams(ctx).EvmDenom {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "%s is the EVM denomination", denom)
+	}
+
+	id := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, id)
+	if !found || !pair.Enabled {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "%s is not an enabled token pair", denom)
+	}
+
+	if token.UseTwap && k.rateSource != nil {
+		if price, found := k.rateSource.GetTWAP(ctx, denom); found && price.IsPositive() {
+			return price, nil
+		}
+	}
+
+	return token.Rate, nil
+}
+
+// ConvertedFee returns the amount of EVM denomination that is paid for the
+// given fee, rounded down
+func (k Keeper) ConvertedFee(ctx sdk.Context, fee sdk.Coin) (sdk.Coin, error) {
+	rate, err := k.ExchangeRate(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	return sdk.NewCoin(evmDenom, rate.MulInt(fee.Amount).TruncateInt()), nil
+}
+
+// ConvertFee swaps the fee paid by the payer with the whitelisted fee token
+// for its EVM denomination value, which is sent from the module reserve to the
+// payer. The converted fee is then deducted from the payer as any other fee,
+// so that the fee collector only receives the EVM denomination.
+func (k Keeper) ConvertFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coin) (sdk.Coin, error) {
+	baseFee, err := k.ConvertedFee(ctx, fee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.swap(ctx, payer, fee, baseFee); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return baseFee, nil
+}
+
+// CoverFee converts one of the payer's whitelisted fee tokens, the first one
+// in the order of the params with a sufficient balance, so that the payer's
+// EVM denomination balance reaches the required amount. At most the fee amount
+// is converted. It's a no-op if fee abstraction is disabled, if the balance
+// already covers the required amount or if no fee token balance can cover it.
+func (k Keeper) CoverFee(ctx sdk.Context, payer sdk.AccAddress, required, fee math.Int) error {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeAbstraction || len(params.FeeTokens) == 0 {
+		return nil
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	balance := k.bankKeeper.GetBalance(ctx, payer, evmDenom).Amount
+	if balance.GTE(required) {
+		return nil
+	}
+
+	shortfall := math.MinInt(required.Sub(balance), fee)
+	if !shortfall.IsPositive() {
+		return nil
+	}
+
+	for _, token := range params.FeeTokens {
+		rate, err := k.ExchangeRate(ctx, token.Denom)
+		if err != nil {
+			continue
+		}
+
+		// round up the amount of fee tokens so that the shortfall is covered
+		amount := sdk.NewDecFromInt(shortfall).Quo(rate).Ceil().TruncateInt()
+		if k.bankKeeper.GetBalance(ctx, payer, token.Denom).Amount.LT(amount) {
+			continue
+		}
+
+		tokenFee := sdk.NewCoin(token.Denom, amount)
+		baseFee := sdk.NewCoin(evmDenom, rate.MulInt(amount).TruncateInt())
+		return k.swap(ctx, payer, tokenFee, baseFee)
+	}
+
+	return nil
+}
+
+// swap transfers the fee from the payer to the module account and the base
+// fee from the module reserve to the payer
+func (k Keeper) swap(ctx sdk.Context, payer sdk.AccAddress, fee, baseFee sdk.Coin) error {
+	if !baseFee.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee %s is worth zero %s", fee, baseFee.Denom)
+	}
+
+	reserve := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), baseFee.Denom)
+	if reserve.IsLT(baseFee) {
+		return errorsmod.Wrapf(types.ErrInsufficientReserve, "reserve %s is smaller than %s", reserve, baseFee)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.Coins{fee}); err != nil {
+		return errorsmod.Wrap(err, "failed to pay fee token")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, sdk.Coins{baseFee}); err != nil {
+		return errorsmod.Wrap(err, "failed to send converted fee")
+	}
+
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyConvertFee, evmostelemetry.NewDenomLabel(fee.Denom))
+		evmostelemetry.IncrAmount(types.MetricKeyConvertFeeAmount, fee.Amount, evmostelemetry.NewDenomLabel(fee.Denom))
+		evmostelemetry.IncrAmount(types.MetricKeyBaseFeeAmount, baseFee.Amount, evmostelemetry.NewDenomLabel(baseFee.Denom))
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertFee,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/testutil"
+	"github.com/evmos/evmos/v11/x/feeabs/types"
+)
+
+type mockRateSource struct {
+	price sdk.Dec
+}
+
+func (rs mockRateSource) GetTWAP(_ sdk.Context, _ string) (sdk.Dec, bool) {
+	return rs.price, !rs.price.IsNil()
+}
+
+func (suite *KeeperTestSuite) setFeeTokens(enabled bool, tokens ...types.FeeToken) {
+	err := suite.app.FeeAbsKeeper.SetParams(suite.ctx, types.NewParams(enabled, tokens))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestConvertedFee() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		fee      sdk.Coin
+		expFee   sdk.Coin
+		expPass  bool
+	}{
+		{
+			"fail - fee abstraction disabled",
+			func() {
+				suite.setFeeTokens(false, types.NewFeeToken(ibcDenom, sdk.NewDec(2)))
+			},
+			sdk.NewInt64Coin(ibcDenom, 100),
+			sdk.Coin{},
+			false,
+		},
+		{
+			"fail - not a fee token",
+			func() {
+				suite.setFeeTokens(true)
+			},
+			sdk.NewInt64Coin(ibcDenom, 100),
+			sdk.Coin{},
+			false,
+		},
+		{
+			"fail - not a registered token pair",
+			func() {
+				suite.setFeeTokens(true, types.NewFeeToken("uatom", sdk.NewDec(2)))
+			},
+			sdk.NewInt64Coin("uatom", 100),
+			sdk.Coin{},
+			false,
+		},
+		{
+			"fail - EVM denomination",
+			func() {
+				suite.setFeeTokens(true, types.NewFeeToken(evmDenom, sdk.NewDec(2)))
+			},
+			sdk.NewInt64Coin(evmDenom, 100),
+			sdk.Coin{},
+			false,
+		},
+		{
+			"pass - rounded down with the params rate",
+			func() {
+				suite.setFeeTokens(true, types.NewFeeToken(ibcDenom, sdk.NewDecWithPrec(15, 1)))
+			},
+			sdk.NewInt64Coin(ibcDenom, 101),
+			sdk.NewInt64Coin(evmDenom, 151),
+			true,
+		},
+		{
+			"pass - params rate without TWAP source",
+			func() {
+				token := types.NewFeeToken(ibcDenom, sdk.NewDec(2))
+				token.UseTwap = true
+				suite.setFeeTokens(true, token)
+			},
+			sdk.NewInt64Coin(ibcDenom, 100),
+			sdk.NewInt64Coin(evmDenom, 200),
+			true,
+		},
+		{
+			"pass - TWAP source rate",
+			func() {
+				token := types.NewFeeToken(ibcDenom, sdk.NewDec(2))
+				token.UseTwap = true
+				suite.setFeeTokens(true, token)
+				suite.app.FeeAbsKeeper.SetRateSource(mockRateSource{price: sdk.NewDec(3)})
+			},
+			sdk.NewInt64Coin(ibcDenom, 100),
+			sdk.NewInt64Coin(evmDenom, 300),
+			true,
+		},
+		{
+			"pass - params rate when the TWAP source has no price",
+			func() {
+				token := types.NewFeeToken(ibcDenom, sdk.NewDec(2))
+				token.UseTwap = true
+				suite.setFeeTokens(true, token)
+				suite.app.FeeAbsKeeper.SetRateSource(mockRateSource{})
+			},
+			sdk.NewInt64Coin(ibcDenom, 100),
+			sdk.NewInt64Coin(evmDenom, 200),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			fee, err := suite.app.FeeAbsKeeper.ConvertedFee(suite.ctx, tc.fee)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFee, fee)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertFee() {
+	payer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - insufficient reserve",
+			func() {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100)))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - insufficient fee token balance",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1000)))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"pass - fee swapped for the EVM denomination",
+			func() {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100)))
+				suite.Require().NoError(err)
+				err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1000)))
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setFeeTokens(true, types.NewFeeToken(ibcDenom, sdk.NewDec(2)))
+			tc.malleate()
+
+			baseFee, err := suite.app.FeeAbsKeeper.ConvertFee(suite.ctx, payer, sdk.NewInt64Coin(ibcDenom, 100))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt64Coin(evmDenom, 200), baseFee)
+				suite.Require().Equal(sdk.NewInt64Coin(evmDenom, 200), suite.app.BankKeeper.GetBalance(suite.ctx, payer, evmDenom))
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, payer, ibcDenom).IsZero())
+				suite.Require().Equal(sdk.NewInt64Coin(evmDenom, 800), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, evmDenom))
+				suite.Require().Equal(sdk.NewInt64Coin(ibcDenom, 100), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, ibcDenom))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCoverFee() {
+	payer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		balances   sdk.Coins
+		required   int64
+		fee        int64
+		expBalance sdk.Coins
+	}{
+		{
+			"no-op - balance covers the required amount",
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 500), sdk.NewInt64Coin(ibcDenom, 100)),
+			500,
+			100,
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 500), sdk.NewInt64Coin(ibcDenom, 100)),
+		},
+		{
+			"no-op - insufficient fee token balance",
+			sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 10)),
+			100,
+			100,
+			sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 10)),
+		},
+		{
+			"pass - shortfall converted rounded up",
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 50), sdk.NewInt64Coin(ibcDenom, 100)),
+			101,
+			100,
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 102), sdk.NewInt64Coin(ibcDenom, 74)),
+		},
+		{
+			"pass - conversion capped at the fee",
+			sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100)),
+			1000,
+			100,
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 100), sdk.NewInt64Coin(ibcDenom, 50)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setFeeTokens(true, types.NewFeeToken(ibcDenom, sdk.NewDec(2)))
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1000)))
+			suite.Require().NoError(err)
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, tc.balances)
+			suite.Require().NoError(err)
+
+			err = suite.app.FeeAbsKeeper.CoverFee(suite.ctx, payer, sdk.NewInt(tc.required), sdk.NewInt(tc.fee))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, payer))
+		})
+	}
+}
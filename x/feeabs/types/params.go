@@ -0,0 +1,88 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params object
+func NewParams(enableFeeAbstraction bool, feeTokens []FeeToken) Params {
+	return Params{
+		EnableFeeAbstraction: enableFeeAbstraction,
+		FeeTokens:            feeTokens,
+	}
+}
+
+// DefaultParams returns default feeabs module parameters, which don't
+// whitelist any fee token
+func DefaultParams() Params {
+	return Params{
+		EnableFeeAbstraction: true,
+		FeeTokens:            []FeeToken{},
+	}
+}
+
+// Validate performs a stateless validation of the params fields
+func (p Params) Validate() error {
+	seenDenoms := make(map[string]bool, len(p.FeeTokens))
+	for _, token := range p.FeeTokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[token.Denom] {
+			return fmt.Errorf("duplicate fee token %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	return nil
+}
+
+// GetFeeToken returns the fee token with the given denomination, if it's
+// whitelisted
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, token := range p.FeeTokens {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// NewFeeToken creates a new FeeToken with a fixed exchange rate
+func NewFeeToken(denom string, rate sdk.Dec) FeeToken {
+	return FeeToken{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// Validate performs a stateless validation of the fee token fields
+func (t FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+
+	if t.Rate.IsNil() || !t.Rate.IsPositive() {
+		return fmt.Errorf("fee token %s rate must be positive: %s", t.Denom, t.Rate)
+	}
+
+	return nil
+}
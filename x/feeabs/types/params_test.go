@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{
+			"empty params",
+			Params{},
+			false,
+		},
+		{
+			"default params",
+			DefaultParams(),
+			false,
+		},
+		{
+			"custom params",
+			NewParams(true, []FeeToken{NewFeeToken(ibcDenom, sdk.NewDecWithPrec(15, 1))}),
+			false,
+		},
+		{
+			"invalid denom",
+			NewParams(true, []FeeToken{NewFeeToken("", sdk.OneDec())}),
+			true,
+		},
+		{
+			"nil rate",
+			NewParams(true, []FeeToken{{Denom: ibcDenom}}),
+			true,
+		},
+		{
+			"zero rate",
+			NewParams(true, []FeeToken{NewFeeToken(ibcDenom, sdk.ZeroDec())}),
+			true,
+		},
+		{
+			"negative rate",
+			NewParams(true, []FeeToken{NewFeeToken(ibcDenom, sdk.NewDec(-1))}),
+			true,
+		},
+		{
+			"duplicate fee token",
+			NewParams(true, []FeeToken{NewFeeToken(ibcDenom, sdk.OneDec()), NewFeeToken(ibcDenom, sdk.NewDec(2))}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsGetFeeToken(t *testing.T) {
+	token := NewFeeToken("uatom", sdk.OneDec())
+	params := NewParams(true, []FeeToken{token})
+
+	found, ok := params.GetFeeToken("uatom")
+	require.True(t, ok)
+	require.Equal(t, token, found)
+
+	_, ok = params.GetFeeToken("uosmo")
+	require.False(t, ok)
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v11/x/forward/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryInFlightPackets() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.InFlightPackets(ctx, &types.QueryInFlightPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.InFlightPackets)
+
+	data := transfertypes.NewFungibleTokenPacketData("uosmo", "10", "osmo1", "evmos1", "")
+	inbound := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-0", clienttypes.NewHeight(1, 100), 0)
+	packet := types.NewInFlightPacket(inbound, types.ForwardMetadata{Receiver: "cosmos1", Channel: "channel-1"}, "")
+	packet.ForwardSequence = 1
+	packet.Token = sdk.NewInt64Coin("uosmo", 10)
+	suite.app.ForwardKeeper.SetInFlightPacket(suite.ctx, packet)
+
+	res, err = suite.queryClient.InFlightPackets(ctx, &types.QueryInFlightPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.InFlightPacket{packet}, res.InFlightPackets)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	expParams := types.DefaultParams()
+
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, res.Params)
+}
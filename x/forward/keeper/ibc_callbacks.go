@@ -0,0 +1,280 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/evmos/evmos/v11/ibc"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/forward/types"
+)
+
+// OverrideReceiver returns the received packet with the forward module
+// account as receiver and without memo. The funds to forward are received on
+// the module account, so that the other middlewares of the transfer stack
+// (e.g. the ERC20 auto-conversion) leave them untouched.
+func (k Keeper) OverrideReceiver(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) channeltypes.Packet {
+	data.Receiver = k.accountKeeper.GetModuleAddress(types.ModuleName).String()
+	data.Memo = ""
+	packet.Data = data.GetBytes()
+	return packet
+}
+
+// ForwardPacket sends the funds of a received packet, held by the module
+// account, to the next chain defined by the forward metadata. The
+// acknowledgement of the received packet is written once the forwarded packet
+// is acknowledged or has timed out.
+func (k Keeper) ForwardPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata types.ForwardMetadata,
+) error {
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidForwardMetadata, err.Error())
+	}
+
+	inFlight := types.NewInFlightPacket(packet, metadata, memo)
+	inFlight.Token = ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	if err := k.sendForward(ctx, &inFlight); err != nil {
+		return err
+	}
+
+	defer func() {
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(inFlight.Token.Denom),
+			evmostelemetry.NewChannelLabel(inFlight.ForwardChannel),
+		}
+		evmostelemetry.IncrCounter(types.MetricKeyForward, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeyForwardAmount, inFlight.Token.Amount, labels...)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardPacket,
+			sdk.NewAttribute(types.AttributeKeyInboundChannel, inFlight.InboundDestChannel),
+			sdk.NewAttribute(types.AttributeKeyInboundSequence, strconv.FormatUint(inFlight.InboundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlight.ForwardChannel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(inFlight.ForwardSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, inFlight.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, inFlight.Token.String()),
+		),
+	)
+
+	return nil
+}
+
+// OnAcknowledgementPacket writes the acknowledgement of the received packet
+// whose funds were forwarded with the acknowledged packet. The received funds
+// are returned to the sending chain if the forwarded packet failed.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	k.DeleteInFlightPacket(ctx, inFlight.ForwardChannel, inFlight.ForwardSequence)
+
+	if !ack.Success() {
+		return k.failForward(ctx, inFlight, errorsmod.Wrap(types.ErrForwardFailed, ack.GetError()))
+	}
+
+	return k.writeInboundAcknowledgement(ctx, inFlight, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+}
+
+// OnTimeoutPacket sends the funds of a timed out forwarded packet again if it
+// has retries remaining. Otherwise, it writes an error acknowledgement for the
+// received packet and returns the received funds to the sending chain.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeleteInFlightPacket(ctx, inFlight.ForwardChannel, inFlight.ForwardSequence)
+
+	if inFlight.RetriesRemaining == 0 {
+		return k.failForward(ctx, inFlight, errorsmod.Wrap(types.ErrForwardFailed, "forwarded packet timed out"))
+	}
+
+	inFlight.RetriesRemaining--
+
+	// discard the state changes of a failed retry
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.sendForward(cacheCtx, &inFlight); err != nil {
+		k.Logger(ctx).Error("failed to retry forwarded packet", "channel", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+		return k.failForward(ctx, inFlight, err)
+	}
+
+	writeCache()
+
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyRetry, evmostelemetry.NewChannelLabel(inFlight.ForwardChannel))
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetryPacket,
+			sdk.NewAttribute(types.AttributeKeyInboundChannel, inFlight.InboundDestChannel),
+			sdk.NewAttribute(types.AttributeKeyInboundSequence, strconv.FormatUint(inFlight.InboundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlight.ForwardChannel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(inFlight.ForwardSequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// SendPacket implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying SendPacket function directly to move down the middleware stack.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	return k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying WriteAcknowledgement function directly to move down the middleware stack.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, packet, ack)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// sendForward transfers the funds of an in-flight packet from the module
+// account to the receiver on the next chain and tracks the forwarded packet
+func (k Keeper) sendForward(ctx sdk.Context, inFlight *types.InFlightPacket) error {
+	timeout := ctx.BlockTime().Add(inFlight.Timeout)
+
+	msg := transfertypes.NewMsgTransfer(
+		inFlight.ForwardPort,
+		inFlight.ForwardChannel,
+		inFlight.Token,
+		k.accountKeeper.GetModuleAddress(types.ModuleName).String(),
+		inFlight.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(timeout.UnixNano()),
+		inFlight.Memo,
+	)
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrForwardTransfer, err.Error())
+	}
+
+	inFlight.ForwardSequence = res.Sequence
+	k.SetInFlightPacket(ctx, *inFlight)
+	return nil
+}
+
+// failForward returns the funds of a failed in-flight packet to the sending
+// chain and writes an error acknowledgement for the received packet, which
+// refunds the original sender.
+func (k Keeper) failForward(ctx sdk.Context, inFlight types.InFlightPacket, reason error) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlight.InboundData, &data); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	// undo the receive of the funds, which were refunded to the module account
+	coins := sdk.NewCoins(inFlight.Token)
+	if transfertypes.ReceiverChainIsSource(inFlight.InboundSourcePort, inFlight.InboundSourceChannel, data.Denom) {
+		// the funds were unescrowed, escrow them again
+		escrow := transfertypes.GetEscrowAddress(inFlight.InboundDestPort, inFlight.InboundDestChannel)
+		if err := k.bankKeeper.SendCoins(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), escrow, coins); err != nil {
+			return err
+		}
+	} else {
+		// the funds were minted as vouchers, burn them
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	return k.writeInboundAcknowledgement(ctx, inFlight, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+// writeInboundAcknowledgement writes the acknowledgement of the received
+// packet of an in-flight packet
+func (k Keeper) writeInboundAcknowledgement(ctx sdk.Context, inFlight types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlight.InboundDestPort, inFlight.InboundDestChannel)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve the channel capability of the received packet")
+	}
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, inFlight.InboundPacket(), ack); err != nil {
+		return err
+	}
+
+	defer func() {
+		status := "success"
+		if !ack.Success() {
+			status = "failure"
+		}
+		evmostelemetry.IncrCounter(
+			types.MetricKeyResult,
+			evmostelemetry.NewChannelLabel(inFlight.ForwardChannel),
+			evmostelemetry.NewStatusLabel(status),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardResult,
+			sdk.NewAttribute(types.AttributeKeyInboundChannel, inFlight.InboundDestChannel),
+			sdk.NewAttribute(types.AttributeKeyInboundSequence, strconv.FormatUint(inFlight.InboundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,338 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibcgotesting "github.com/cosmos/ibc-go/v6/testing"
+	ibcgotestinghelpers "github.com/cosmos/ibc-go/v6/testing/simapp/helpers"
+
+	"github.com/evmos/evmos/v11/app"
+	ibctesting "github.com/evmos/evmos/v11/ibc/testing"
+	evmostypes "github.com/evmos/evmos/v11/types"
+	teststypes "github.com/evmos/evmos/v11/types/tests"
+	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	"github.com/evmos/evmos/v11/x/forward/types"
+	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+)
+
+type IBCTestingSuite struct {
+	suite.Suite
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	EvmosChain      *ibcgotesting.TestChain
+	IBCOsmosisChain *ibcgotesting.TestChain
+	IBCCosmosChain  *ibcgotesting.TestChain
+
+	app *app.Evmos
+
+	pathOsmosisEvmos *ibctesting.Path
+	pathCosmosEvmos  *ibctesting.Path
+}
+
+func TestIBCTestingSuite(t *testing.T) {
+	suite.Run(t, new(IBCTestingSuite))
+}
+
+func (suite *IBCTestingSuite) SetupTest() {
+	// initializes 3 test chains
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 2)
+	suite.EvmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.IBCOsmosisChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.IBCCosmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(3))
+	suite.coordinator.CommitNBlocks(suite.EvmosChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCOsmosisChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCCosmosChain, 2)
+
+	suite.app = suite.EvmosChain.App.(*app.Evmos)
+	evmParams := suite.app.EvmKeeper.GetParams(suite.EvmosChain.GetContext())
+	evmParams.EvmDenom = evmostypes.BaseDenom
+	err := suite.app.EvmKeeper.SetParams(suite.EvmosChain.GetContext(), evmParams)
+	suite.Require().NoError(err)
+
+	// Increase max gas
+	ibcgotestinghelpers.DefaultGenTxGas = uint64(1_000_000_000)
+
+	// Set block proposer once, so its carried over on the ibc-go-testing suite
+	validators := suite.app.StakingKeeper.GetValidators(suite.EvmosChain.GetContext(), 2)
+	cons, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.EvmosChain.CurrentHeader.ProposerAddress = cons.Bytes()
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.EvmosChain.GetContext(), validators[0])
+	suite.Require().NoError(err)
+
+	// Fund sender address to pay fees
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(evmostypes.BaseDenom, amt))
+	err = suite.app.BankKeeper.MintCoins(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, suite.EvmosChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	// Mint the coins to forward and the IBC tx fees on the Osmosis and Cosmos chains
+	coins = sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(1000)), sdk.NewCoin(sdk.DefaultBondDenom, amt))
+	err = suite.IBCOsmosisChain.GetSimApp().BankKeeper.MintCoins(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.IBCOsmosisChain.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, suite.IBCOsmosisChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amt))
+	err = suite.IBCCosmosChain.GetSimApp().BankKeeper.MintCoins(suite.IBCCosmosChain.GetContext(), minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.IBCCosmosChain.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.IBCCosmosChain.GetContext(), minttypes.ModuleName, suite.IBCCosmosChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	suite.pathOsmosisEvmos = ibctesting.NewTransferPath(suite.IBCOsmosisChain, suite.EvmosChain) // clientID, connectionID, channelID empty
+	suite.pathCosmosEvmos = ibctesting.NewTransferPath(suite.IBCCosmosChain, suite.EvmosChain)
+	ibctesting.SetupPath(suite.coordinator, suite.pathOsmosisEvmos) // clientID, connectionID, channelID filled
+	ibctesting.SetupPath(suite.coordinator, suite.pathCosmosEvmos)
+	suite.Require().Equal("channel-0", suite.pathOsmosisEvmos.EndpointB.ChannelID)
+	suite.Require().Equal("channel-1", suite.pathCosmosEvmos.EndpointB.ChannelID)
+}
+
+var timeoutHeight = clienttypes.NewHeight(1000, 1000)
+
+// sendAndReceiveForward sends uosmo from Osmosis to the forward module of
+// Evmos with the given memo. It returns the packet sent from Osmosis and the
+// result of receiving it on Evmos.
+func (suite *IBCTestingSuite) sendAndReceiveForward(amount int64, memo string) (channeltypes.Packet, *sdk.Result) {
+	path := suite.pathOsmosisEvmos
+	sender := suite.IBCOsmosisChain.SenderAccount.GetAddress().String()
+	receiver := suite.EvmosChain.SenderAccount.GetAddress().String()
+
+	transferMsg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin("uosmo", sdk.NewInt(amount)), sender, receiver, timeoutHeight, 0, memo)
+	res, err := ibctesting.SendMsgs(suite.IBCOsmosisChain, ibctesting.DefaultFeeAmt, transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	return packet, res
+}
+
+// forwardMemo returns a memo forwarding the funds to the Cosmos chain
+func (suite *IBCTestingSuite) forwardMemo(timeout string, retries uint8) string {
+	return fmt.Sprintf(
+		`{"forward":{"receiver":"%s","channel":"%s","timeout":"%s","retries":%d}}`,
+		suite.IBCCosmosChain.SenderAccount.GetAddress().String(),
+		suite.pathCosmosEvmos.EndpointB.ChannelID,
+		timeout,
+		retries,
+	)
+}
+
+// cosmosUosmoDenom returns the denom of the forwarded uosmo on the Cosmos chain
+func (suite *IBCTestingSuite) cosmosUosmoDenom() string {
+	return transfertypes.ParseDenomTrace(fmt.Sprintf(
+		"%s/%s/%s/%s/uosmo",
+		suite.pathCosmosEvmos.EndpointA.ChannelConfig.PortID,
+		suite.pathCosmosEvmos.EndpointA.ChannelID,
+		suite.pathOsmosisEvmos.EndpointB.ChannelConfig.PortID,
+		suite.pathOsmosisEvmos.EndpointB.ChannelID,
+	)).IBCDenom()
+}
+
+// requireInboundAck checks the acknowledgement written on Evmos for the
+// packet received from Osmosis
+func (suite *IBCTestingSuite) requireInboundAck(packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	commitment, found := suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		suite.EvmosChain.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+	)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), commitment)
+}
+
+// timeoutForwardPacket times out the given packet sent from Evmos to Cosmos
+func (suite *IBCTestingSuite) timeoutForwardPacket(packet channeltypes.Packet) *sdk.Result {
+	path := suite.pathCosmosEvmos
+
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.IBCCosmosChain)
+	err := path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	// build the timeout message as the counterparty uses different channel identifiers
+	packetKey := host.PacketReceiptKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+	nextSeqRecv, found := suite.IBCCosmosChain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.IBCCosmosChain.GetContext(), packet.DestinationPort, packet.DestinationChannel)
+	suite.Require().True(found)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, suite.EvmosChain.SenderAccount.GetAddress().String())
+	res, err := ibctesting.SendMsgs(suite.EvmosChain, ibctesting.DefaultFeeAmt, timeoutMsg)
+	suite.Require().NoError(err)
+
+	return res
+}
+
+func (suite *IBCTestingSuite) TestForward() {
+	var amount int64 = 10
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"forward without ERC20 token pair",
+			func() {},
+		},
+		{
+			"forward with registered and enabled ERC20 token pair",
+			func() {
+				erc20params := erc20types.DefaultParams()
+				erc20params.EnableErc20 = true
+				err := suite.app.Erc20Keeper.SetParams(suite.EvmosChain.GetContext(), erc20params)
+				suite.Require().NoError(err)
+
+				// the coin needs a supply to be registered
+				coins := sdk.NewCoins(sdk.NewCoin(teststypes.UosmoIbcdenom, sdk.NewInt(100)))
+				err = suite.app.BankKeeper.MintCoins(suite.EvmosChain.GetContext(), erc20types.ModuleName, coins)
+				suite.Require().NoError(err)
+
+				_, err = suite.app.Erc20Keeper.RegisterCoin(suite.EvmosChain.GetContext(), banktypes.Metadata{
+					Description: "IBC Coin for IBC Osmosis Chain",
+					Base:        teststypes.UosmoIbcdenom,
+					DenomUnits: []*banktypes.DenomUnit{
+						{
+							Denom:    teststypes.UosmoDenomtrace.BaseDenom,
+							Exponent: 0,
+						},
+					},
+					Name:    teststypes.UosmoIbcdenom,
+					Symbol:  "OSMO",
+					Display: teststypes.UosmoDenomtrace.BaseDenom,
+				})
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+			suite.coordinator.CommitBlock(suite.EvmosChain)
+
+			receiver := suite.IBCCosmosChain.SenderAccount.GetAddress()
+			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+			packet, res := suite.sendAndReceiveForward(amount, suite.forwardMemo("10m", 1))
+
+			// the inbound acknowledgement is asynchronous
+			_, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().Error(err)
+
+			forwardPacket, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.pathCosmosEvmos.EndpointB.ChannelID, forwardPacket.SourceChannel)
+
+			inFlight, found := suite.app.ForwardKeeper.GetInFlightPacket(suite.EvmosChain.GetContext(), forwardPacket.SourceChannel, forwardPacket.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(packet.Sequence, inFlight.InboundSequence)
+			suite.Require().Equal(uint32(1), inFlight.RetriesRemaining)
+
+			// the forwarded funds are escrowed and neither converted nor left on the module account
+			balances := suite.app.BankKeeper.GetAllBalances(suite.EvmosChain.GetContext(), moduleAddr)
+			suite.Require().True(balances.IsZero())
+
+			err = suite.pathCosmosEvmos.RelayPacket(forwardPacket)
+			suite.Require().NoError(err)
+
+			balance := suite.IBCCosmosChain.GetSimApp().BankKeeper.GetBalance(suite.IBCCosmosChain.GetContext(), receiver, suite.cosmosUosmoDenom())
+			suite.Require().Equal(amount, balance.Amount.Int64())
+
+			_, found = suite.app.ForwardKeeper.GetInFlightPacket(suite.EvmosChain.GetContext(), forwardPacket.SourceChannel, forwardPacket.Sequence)
+			suite.Require().False(found)
+
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			suite.requireInboundAck(packet, ack)
+
+			// relay the inbound acknowledgement back to Osmosis
+			err = suite.pathOsmosisEvmos.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+			err = suite.pathOsmosisEvmos.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement())
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *IBCTestingSuite) TestForwardTimeout() {
+	var amount int64 = 10
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	packet, res := suite.sendAndReceiveForward(amount, suite.forwardMemo("1m", 0))
+	forwardPacket, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.timeoutForwardPacket(forwardPacket)
+
+	_, found := suite.app.ForwardKeeper.GetInFlightPacket(suite.EvmosChain.GetContext(), forwardPacket.SourceChannel, forwardPacket.Sequence)
+	suite.Require().False(found)
+
+	// the received vouchers are burned, so that Osmosis refunds the sender
+	supply := suite.app.BankKeeper.GetSupply(suite.EvmosChain.GetContext(), teststypes.UosmoIbcdenom)
+	suite.Require().True(supply.IsZero())
+	balances := suite.app.BankKeeper.GetAllBalances(suite.EvmosChain.GetContext(), moduleAddr)
+	suite.Require().True(balances.IsZero())
+
+	suite.requireInboundAck(packet, channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrForwardFailed, "forwarded packet timed out")))
+}
+
+func (suite *IBCTestingSuite) TestForwardRetry() {
+	var amount int64 = 10
+
+	packet, res := suite.sendAndReceiveForward(amount, suite.forwardMemo("1m", 1))
+	forwardPacket, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.timeoutForwardPacket(forwardPacket)
+
+	_, found := suite.app.ForwardKeeper.GetInFlightPacket(suite.EvmosChain.GetContext(), forwardPacket.SourceChannel, forwardPacket.Sequence)
+	suite.Require().False(found)
+
+	// the funds are forwarded again on the next sequence
+	inFlight, found := suite.app.ForwardKeeper.GetInFlightPacket(suite.EvmosChain.GetContext(), forwardPacket.SourceChannel, forwardPacket.Sequence+1)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.Sequence, inFlight.InboundSequence)
+	suite.Require().Equal(uint32(0), inFlight.RetriesRemaining)
+
+	_, found = suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		suite.EvmosChain.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence,
+	)
+	suite.Require().False(found)
+}
+
+func (suite *IBCTestingSuite) TestForwardInvalidMemo() {
+	receiver := suite.EvmosChain.SenderAccount.GetAddress()
+
+	_, res := suite.sendAndReceiveForward(10, `{"forward":{"receiver":"","channel":"channel-1"}}`)
+
+	ackBz, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	err = transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+	suite.Require().NoError(err)
+	suite.Require().False(ack.Success())
+
+	balance := suite.app.BankKeeper.GetBalance(suite.EvmosChain.GetContext(), receiver, teststypes.UosmoIbcdenom)
+	suite.Require().True(balance.IsZero())
+}
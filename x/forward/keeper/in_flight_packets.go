@@ -0,0 +1,69 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/forward/types"
+)
+
+// GetInFlightPackets returns all the packets being forwarded
+func (k Keeper) GetInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	packets := []types.InFlightPacket{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// GetInFlightPacket returns the in-flight packet forwarded on a channel with a
+// sequence
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	bz := store.Get(types.GetInFlightPacketKey(channelID, sequence))
+	if len(bz) == 0 {
+		return types.InFlightPacket{}, false
+	}
+
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetInFlightPacket stores an in-flight packet
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.GetInFlightPacketKey(packet.ForwardChannel, packet.ForwardSequence), bz)
+}
+
+// DeleteInFlightPacket removes the in-flight packet forwarded on a channel
+// with a sequence
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	store.Delete(types.GetInFlightPacketKey(channelID, sequence))
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewInFlightPacket returns an instance of InFlightPacket for a received packet
+// whose funds are forwarded with the given metadata
+func NewInFlightPacket(inbound channeltypes.Packet, metadata ForwardMetadata, memo string) InFlightPacket {
+	return InFlightPacket{
+		InboundSourcePort:       inbound.SourcePort,
+		InboundSourceChannel:    inbound.SourceChannel,
+		InboundDestPort:         inbound.DestinationPort,
+		InboundDestChannel:      inbound.DestinationChannel,
+		InboundSequence:         inbound.Sequence,
+		InboundData:             inbound.Data,
+		InboundTimeoutHeight:    inbound.TimeoutHeight,
+		InboundTimeoutTimestamp: inbound.TimeoutTimestamp,
+		ForwardPort:             metadata.GetPort(),
+		ForwardChannel:          metadata.Channel,
+		Receiver:                metadata.Receiver,
+		Memo:                    memo,
+		Timeout:                 metadata.GetTimeout(),
+		RetriesRemaining:        metadata.GetRetries(),
+	}
+}
+
+// InboundPacket returns the received packet whose funds are forwarded
+func (p InFlightPacket) InboundPacket() channeltypes.Packet {
+	return channeltypes.NewPacket(
+		p.InboundData,
+		p.InboundSequence,
+		p.InboundSourcePort,
+		p.InboundSourceChannel,
+		p.InboundDestPort,
+		p.InboundDestChannel,
+		p.InboundTimeoutHeight,
+		p.InboundTimeoutTimestamp,
+	)
+}
+
+// Validate performs a stateless validation of an in-flight packet
+func (p InFlightPacket) Validate() error {
+	if err := p.InboundPacket().ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(p.ForwardPort); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ForwardChannel); err != nil {
+		return err
+	}
+
+	if p.ForwardSequence == 0 {
+		return fmt.Errorf("forward sequence cannot be 0")
+	}
+
+	if err := p.Token.Validate(); err != nil {
+		return err
+	}
+
+	if p.Receiver == "" {
+		return fmt.Errorf("receiver cannot be empty")
+	}
+
+	if p.Timeout <= 0 {
+		return fmt.Errorf("timeout must be positive, got %s", p.Timeout)
+	}
+
+	return nil
+}
@@ -0,0 +1,1077 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/forward/v1/forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	types "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a received ICS20 packet whose funds are being
+// forwarded to another chain. The acknowledgement of the received packet is
+// written once the forwarded packet is acknowledged or has timed out.
+type InFlightPacket struct {
+	// inbound_source_port is the counterparty port of the received packet
+	InboundSourcePort string `protobuf:"bytes,1,opt,name=inbound_source_port,json=inboundSourcePort,proto3" json:"inbound_source_port,omitempty"`
+	// inbound_source_channel is the counterparty channel of the received packet
+	InboundSourceChannel string `protobuf:"bytes,2,opt,name=inbound_source_channel,json=inboundSourceChannel,proto3" json:"inbound_source_channel,omitempty"`
+	// inbound_dest_port is the Evmos port of the received packet
+	InboundDestPort string `protobuf:"bytes,3,opt,name=inbound_dest_port,json=inboundDestPort,proto3" json:"inbound_dest_port,omitempty"`
+	// inbound_dest_channel is the Evmos channel of the received packet
+	InboundDestChannel string `protobuf:"bytes,4,opt,name=inbound_dest_channel,json=inboundDestChannel,proto3" json:"inbound_dest_channel,omitempty"`
+	// inbound_sequence is the sequence of the received packet
+	InboundSequence uint64 `protobuf:"varint,5,opt,name=inbound_sequence,json=inboundSequence,proto3" json:"inbound_sequence,omitempty"`
+	// inbound_data is the ICS20 data of the received packet
+	InboundData []byte `protobuf:"bytes,6,opt,name=inbound_data,json=inboundData,proto3" json:"inbound_data,omitempty"`
+	// inbound_timeout_height is the timeout height of the received packet
+	InboundTimeoutHeight types.Height `protobuf:"bytes,7,opt,name=inbound_timeout_height,json=inboundTimeoutHeight,proto3" json:"inbound_timeout_height"`
+	// inbound_timeout_timestamp is the timeout timestamp of the received packet
+	InboundTimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=inbound_timeout_timestamp,json=inboundTimeoutTimestamp,proto3" json:"inbound_timeout_timestamp,omitempty"`
+	// forward_port is the Evmos port of the forwarded packet
+	ForwardPort string `protobuf:"bytes,9,opt,name=forward_port,json=forwardPort,proto3" json:"forward_port,omitempty"`
+	// forward_channel is the Evmos channel of the forwarded packet
+	ForwardChannel string `protobuf:"bytes,10,opt,name=forward_channel,json=forwardChannel,proto3" json:"forward_channel,omitempty"`
+	// forward_sequence is the sequence of the forwarded packet
+	ForwardSequence uint64 `protobuf:"varint,11,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// token is the forwarded coin in its Evmos denomination
+	Token types1.Coin `protobuf:"bytes,12,opt,name=token,proto3" json:"token"`
+	// receiver is the address of the receiver on the next chain
+	Receiver string `protobuf:"bytes,13,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// memo is the memo of the forwarded packet, e.g. the forward metadata of
+	// the next hop
+	Memo string `protobuf:"bytes,14,opt,name=memo,proto3" json:"memo,omitempty"`
+	// timeout is the relative timeout of the forwarded packet
+	Timeout time.Duration `protobuf:"bytes,15,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// retries_remaining is the number of times the forwarded packet is sent
+	// again after timing out
+	RetriesRemaining uint32 `protobuf:"varint,16,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68db2b475342e61, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetInboundSourcePort() string {
+	if m != nil {
+		return m.InboundSourcePort
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetInboundSourceChannel() string {
+	if m != nil {
+		return m.InboundSourceChannel
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetInboundDestPort() string {
+	if m != nil {
+		return m.InboundDestPort
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetInboundDestChannel() string {
+	if m != nil {
+		return m.InboundDestChannel
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetInboundSequence() uint64 {
+	if m != nil {
+		return m.InboundSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetInboundData() []byte {
+	if m != nil {
+		return m.InboundData
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetInboundTimeoutHeight() types.Height {
+	if m != nil {
+		return m.InboundTimeoutHeight
+	}
+	return types.Height{}
+}
+
+func (m *InFlightPacket) GetInboundTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.InboundTimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetForwardPort() string {
+	if m != nil {
+		return m.ForwardPort
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardChannel() string {
+	if m != nil {
+		return m.ForwardChannel
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetToken() types1.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types1.Coin{}
+}
+
+func (m *InFlightPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "evmos.forward.v1.InFlightPacket")
+}
+
+func init() { proto.RegisterFile("evmos/forward/v1/forward.proto", fileDescriptor_a68db2b475342e61) }
+
+var fileDescriptor_a68db2b475342e61 = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0xcb, 0x72, 0xd3, 0x3c,
+	0x14, 0x8e, 0xff, 0x3f, 0xbd, 0x29, 0xbd, 0xa4, 0xa2, 0x03, 0x6e, 0x16, 0x6e, 0x60, 0x43, 0x28,
+	0x33, 0x32, 0xe6, 0xb2, 0x61, 0x86, 0x4d, 0x9b, 0x61, 0x60, 0xd7, 0x49, 0x3b, 0x2c, 0xd8, 0x64,
+	0x64, 0xe5, 0xd4, 0xd1, 0x34, 0x96, 0x82, 0x2c, 0x1b, 0xd8, 0xf1, 0x08, 0x2c, 0x79, 0xa4, 0x2e,
+	0xbb, 0x64, 0x05, 0x4c, 0xf2, 0x22, 0x8c, 0x75, 0x31, 0x4d, 0x37, 0xc9, 0xf1, 0x77, 0x39, 0x3a,
+	0xfa, 0xe6, 0x08, 0x45, 0x50, 0xe5, 0xb2, 0x88, 0x2f, 0xa5, 0xfa, 0x4c, 0xd5, 0x24, 0xae, 0x12,
+	0x5f, 0x92, 0xb9, 0x92, 0x5a, 0xe2, 0xae, 0xe1, 0x89, 0x07, 0xab, 0xa4, 0x17, 0x31, 0x59, 0xd4,
+	0x96, 0x94, 0x16, 0x10, 0x57, 0x49, 0x0a, 0x9a, 0x26, 0x31, 0x93, 0x5c, 0x58, 0x47, 0xef, 0x20,
+	0x93, 0x99, 0x34, 0x65, 0x5c, 0x57, 0x0e, 0x8d, 0x32, 0x29, 0xb3, 0x19, 0xc4, 0xe6, 0x2b, 0x2d,
+	0x2f, 0xe3, 0x49, 0xa9, 0xa8, 0xe6, 0xd2, 0xbb, 0x8e, 0x78, 0xca, 0x62, 0x26, 0x15, 0xc4, 0x6c,
+	0xc6, 0x41, 0xe8, 0x7a, 0x12, 0x5b, 0x59, 0xc1, 0xa3, 0x6f, 0xeb, 0x68, 0xf7, 0xbd, 0x78, 0x3b,
+	0xe3, 0xd9, 0x54, 0x9f, 0x51, 0x76, 0x05, 0x1a, 0x13, 0x74, 0x8f, 0x8b, 0x54, 0x96, 0x62, 0x32,
+	0x2e, 0x64, 0xa9, 0x18, 0x8c, 0xe7, 0x52, 0xe9, 0x30, 0xe8, 0x07, 0x83, 0xad, 0xd1, 0xbe, 0xa3,
+	0xce, 0x0d, 0x73, 0x26, 0x95, 0xc6, 0x2f, 0xd1, 0xfd, 0x3b, 0x7a, 0x36, 0xa5, 0x42, 0xc0, 0x2c,
+	0xfc, 0xcf, 0x58, 0x0e, 0x56, 0x2c, 0xa7, 0x96, 0xc3, 0xc7, 0xc8, 0xb7, 0x1a, 0x4f, 0xa0, 0xd0,
+	0xf6, 0x8c, 0xff, 0x8d, 0x61, 0xcf, 0x11, 0x43, 0x28, 0xb4, 0x39, 0xe1, 0x19, 0x3a, 0x58, 0xd1,
+	0xfa, 0xfe, 0x6d, 0x23, 0xc7, 0xb7, 0xe4, 0xbe, 0xfb, 0x13, 0xd4, 0x6d, 0x66, 0x82, 0x4f, 0x25,
+	0x08, 0x06, 0xe1, 0x5a, 0x3f, 0x18, 0xb4, 0x9b, 0xe6, 0xe7, 0x0e, 0xc6, 0x0f, 0xd1, 0x76, 0xd3,
+	0x9c, 0x6a, 0x1a, 0xae, 0xf7, 0x83, 0xc1, 0xf6, 0xa8, 0xe3, 0x9b, 0x52, 0x4d, 0xf1, 0x87, 0x7f,
+	0x37, 0xd4, 0x3c, 0x07, 0x59, 0xea, 0xf1, 0x14, 0xea, 0xc4, 0xc2, 0x8d, 0x7e, 0x30, 0xe8, 0x3c,
+	0xef, 0x11, 0x9e, 0x32, 0x52, 0xc7, 0x4c, 0x5c, 0xb8, 0x55, 0x42, 0xde, 0x19, 0xc5, 0x49, 0xfb,
+	0xfa, 0xd7, 0x51, 0xab, 0xc9, 0xe0, 0xc2, 0xda, 0x2d, 0x87, 0x5f, 0xa3, 0xc3, 0xbb, 0x7d, 0xeb,
+	0xff, 0x42, 0xd3, 0x7c, 0x1e, 0x6e, 0x9a, 0x71, 0x1f, 0xac, 0x1a, 0x2f, 0x3c, 0x5d, 0x8f, 0xed,
+	0xb6, 0xc7, 0x46, 0xb7, 0x65, 0xb2, 0xe8, 0x38, 0xcc, 0xc4, 0xf6, 0x18, 0xed, 0x79, 0x89, 0x4f,
+	0x0c, 0x19, 0xd5, 0xae, 0x83, 0x6f, 0xa5, 0xe5, 0x85, 0x4d, 0x5a, 0x1d, 0x9b, 0x96, 0xc3, 0x9b,
+	0xb4, 0x5e, 0xa1, 0x35, 0x2d, 0xaf, 0x40, 0x84, 0xdb, 0xe6, 0xe6, 0x87, 0xc4, 0xae, 0x2d, 0xa9,
+	0xd7, 0x96, 0xb8, 0xb5, 0x25, 0xa7, 0x92, 0x0b, 0x77, 0x71, 0xab, 0xc6, 0x3d, 0xb4, 0xa9, 0x80,
+	0x01, 0xaf, 0x40, 0x85, 0x3b, 0x66, 0x86, 0xe6, 0x1b, 0x63, 0xd4, 0xce, 0x21, 0x97, 0xe1, 0xae,
+	0xc1, 0x4d, 0x8d, 0xdf, 0xa0, 0x0d, 0x97, 0x48, 0xb8, 0xe7, 0x0e, 0xb2, 0x9b, 0x4e, 0xfc, 0xa6,
+	0x93, 0xa1, 0xdb, 0xf4, 0x93, 0xcd, 0xfa, 0xa0, 0x1f, 0xbf, 0x8f, 0x82, 0x91, 0xf7, 0xe0, 0xa7,
+	0x68, 0x5f, 0x81, 0x56, 0x1c, 0x8a, 0xb1, 0x82, 0x9c, 0x72, 0xc1, 0x45, 0x16, 0x76, 0xfb, 0xc1,
+	0x60, 0x67, 0xd4, 0x75, 0xc4, 0xc8, 0xe3, 0x27, 0xc3, 0xeb, 0x45, 0x14, 0xdc, 0x2c, 0xa2, 0xe0,
+	0xcf, 0x22, 0x0a, 0xbe, 0x2f, 0xa3, 0xd6, 0xcd, 0x32, 0x6a, 0xfd, 0x5c, 0x46, 0xad, 0x8f, 0xc7,
+	0x19, 0xd7, 0xd3, 0x32, 0x25, 0x4c, 0xe6, 0xb1, 0x7d, 0xd0, 0xf6, 0xb7, 0x4a, 0x92, 0xf8, 0x4b,
+	0xf3, 0xb8, 0xf5, 0xd7, 0x39, 0x14, 0xe9, 0xba, 0x19, 0xec, 0xc5, 0xdf, 0x01, 0x00, 0x1b, 0x67,
+	0xe8, 0xd9, 0xfa, 0x03, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintForward(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x7a
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.ForwardSequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ForwardChannel) > 0 {
+		i -= len(m.ForwardChannel)
+		copy(dAtA[i:], m.ForwardChannel)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardChannel)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ForwardPort) > 0 {
+		i -= len(m.ForwardPort)
+		copy(dAtA[i:], m.ForwardPort)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardPort)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.InboundTimeoutTimestamp != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.InboundTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.InboundTimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.InboundData) > 0 {
+		i -= len(m.InboundData)
+		copy(dAtA[i:], m.InboundData)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.InboundData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InboundSequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.InboundSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InboundDestChannel) > 0 {
+		i -= len(m.InboundDestChannel)
+		copy(dAtA[i:], m.InboundDestChannel)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.InboundDestChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InboundDestPort) > 0 {
+		i -= len(m.InboundDestPort)
+		copy(dAtA[i:], m.InboundDestPort)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.InboundDestPort)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InboundSourceChannel) > 0 {
+		i -= len(m.InboundSourceChannel)
+		copy(dAtA[i:], m.InboundSourceChannel)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.InboundSourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InboundSourcePort) > 0 {
+		i -= len(m.InboundSourcePort)
+		copy(dAtA[i:], m.InboundSourcePort)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.InboundSourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundSourcePort)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.InboundSourceChannel)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.InboundDestPort)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.InboundDestChannel)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.InboundSequence != 0 {
+		n += 1 + sovForward(uint64(m.InboundSequence))
+	}
+	l = len(m.InboundData)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = m.InboundTimeoutHeight.Size()
+	n += 1 + l + sovForward(uint64(l))
+	if m.InboundTimeoutTimestamp != 0 {
+		n += 1 + sovForward(uint64(m.InboundTimeoutTimestamp))
+	}
+	l = len(m.ForwardPort)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.ForwardChannel)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovForward(uint64(m.ForwardSequence))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovForward(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovForward(uint64(l))
+	if m.RetriesRemaining != 0 {
+		n += 2 + sovForward(uint64(m.RetriesRemaining))
+	}
+	return n
+}
+
+func sovForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForward(x uint64) (n int) {
+	return sovForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundSourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundSourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDestPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundDestPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundDestChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundDestChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundSequence", wireType)
+			}
+			m.InboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundData = append(m.InboundData[:0], dAtA[iNdEx:postIndex]...)
+			if m.InboundData == nil {
+				m.InboundData = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundTimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTimeoutTimestamp", wireType)
+			}
+			m.InboundTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,53 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, inFlightPackets []InFlightPacket) GenesisState {
+	return GenesisState{
+		Params:          params,
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState sets default forward genesis state with default params
+// and no in-flight packets
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, packet := range gs.InFlightPackets {
+		key := fmt.Sprintf("%s/%d", packet.ForwardChannel, packet.ForwardSequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate in-flight packet %s", key)
+		}
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+		seen[key] = true
+	}
+
+	return gs.Params.Validate()
+}
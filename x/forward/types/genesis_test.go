@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData("uosmo", "10", "osmo1", "evmos1", "")
+	inbound := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-0", clienttypes.NewHeight(1, 100), 0)
+	metadata := ForwardMetadata{Receiver: "cosmos1", Channel: "channel-1"}
+
+	packet := NewInFlightPacket(inbound, metadata, "")
+	packet.ForwardSequence = 1
+	packet.Token = sdk.NewInt64Coin("uosmo", 10)
+
+	noSequence := packet
+	noSequence.ForwardSequence = 0
+
+	noTimeout := packet
+	noTimeout.Timeout = 0
+
+	otherPacket := packet
+	otherPacket.ForwardSequence = 2
+
+	testCases := []struct {
+		name     string
+		genesis  GenesisState
+		expError bool
+	}{
+		{"default genesis", *DefaultGenesisState(), false},
+		{"valid genesis", NewGenesisState(DefaultParams(), []InFlightPacket{packet, otherPacket}), false},
+		{"duplicate in-flight packet", NewGenesisState(DefaultParams(), []InFlightPacket{packet, packet}), true},
+		{"missing forward sequence", NewGenesisState(DefaultParams(), []InFlightPacket{noSequence}), true},
+		{"zero timeout", NewGenesisState(DefaultParams(), []InFlightPacket{noTimeout}), true},
+		{"invalid inbound packet", NewGenesisState(DefaultParams(), []InFlightPacket{{ForwardChannel: "channel-1", ForwardSequence: 1}}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// ModuleName defines the forward module name
+	ModuleName = "forward"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the forward module's persistent store
+const (
+	prefixParams = iota + 1
+	prefixInFlightPacket
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey               = []byte{prefixParams}
+	KeyPrefixInFlightPacket = []byte{prefixInFlightPacket}
+)
+
+// GetInFlightPacketKey returns the key of the in-flight packet forwarded on a
+// channel with a sequence, relative to its prefix store
+func GetInFlightPacketKey(channelID string, sequence uint64) []byte {
+	return append(address.MustLengthPrefix([]byte(channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,200 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const (
+	// DefaultTimeout is the relative timeout of a forwarded packet whose
+	// metadata doesn't define one
+	DefaultTimeout = 10 * time.Minute
+	// DefaultRetries is the number of retries of a forwarded packet whose
+	// metadata doesn't define them
+	DefaultRetries = 1
+	// MaxRetries is the maximum number of retries of a forwarded packet
+	MaxRetries = 10
+)
+
+// PacketMetadata defines the memo of an ICS20 packet whose funds are
+// forwarded to another chain, e.g.
+//
+//	{"forward": {"receiver": "osmo1...", "port": "transfer", "channel": "channel-0", "timeout": "10m", "retries": 2}}
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines the next hop of the funds of a received packet
+type ForwardMetadata struct {
+	// Receiver is the address of the receiver on the next chain
+	Receiver string `json:"receiver"`
+	// Port is the Evmos port of the forwarded packet, "transfer" by default
+	Port string `json:"port,omitempty"`
+	// Channel is the Evmos channel of the forwarded packet
+	Channel string `json:"channel"`
+	// Timeout is the relative timeout of the forwarded packet, as a duration
+	// string (e.g. "10m") or in nanoseconds
+	Timeout Duration `json:"timeout,omitempty"`
+	// Retries is the number of times the forwarded packet is sent again after
+	// timing out
+	Retries *uint8 `json:"retries,omitempty"`
+	// Next is the memo of the forwarded packet, as a JSON object or string,
+	// e.g. the forward metadata of the next hop
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// Duration is a time.Duration that is unmarshalled from a duration string or
+// a number of nanoseconds
+type Duration time.Duration
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var nanoseconds int64
+	if err := json.Unmarshal(bz, &nanoseconds); err == nil {
+		*d = Duration(nanoseconds)
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return fmt.Errorf("invalid duration %s", string(bz))
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(duration)
+	return nil
+}
+
+// ParseForwardMetadata returns the forward metadata of the memo of an ICS20
+// packet. It returns nil if the memo doesn't define a forward, and an error if
+// the forward is invalid.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, error) {
+	// other memos, e.g. plain text ones, are left to the other middlewares
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+
+	if _, found := fields["forward"]; !found {
+		return nil, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if metadata.Forward == nil {
+		return nil, errorsmod.Wrap(ErrInvalidForwardMetadata, "forward cannot be null")
+	}
+
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, err
+	}
+
+	return metadata.Forward, nil
+}
+
+// Validate performs a stateless validation of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if m.Receiver == "" {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+
+	if err := host.PortIdentifierValidator(m.GetPort()); err != nil {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "timeout cannot be negative, got %s", time.Duration(m.Timeout))
+	}
+
+	if m.GetRetries() > MaxRetries {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "retries cannot be greater than %d, got %d", MaxRetries, m.GetRetries())
+	}
+
+	if _, err := m.NextMemo(); err != nil {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	return nil
+}
+
+// GetPort returns the port of the forwarded packet
+func (m ForwardMetadata) GetPort() string {
+	if m.Port == "" {
+		return transfertypes.PortID
+	}
+	return m.Port
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(m.Timeout)
+}
+
+// GetRetries returns the number of retries of the forwarded packet
+func (m ForwardMetadata) GetRetries() uint32 {
+	if m.Retries == nil {
+		return DefaultRetries
+	}
+	return uint32(*m.Retries)
+}
+
+// NextMemo returns the memo of the forwarded packet
+func (m ForwardMetadata) NextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+
+	switch next[0] {
+	case '"':
+		var memo string
+		if err := json.Unmarshal(next, &memo); err != nil {
+			return "", err
+		}
+		return memo, nil
+	case '{':
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, next); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	default:
+		return "", fmt.Errorf("next must be a JSON object or string, got %s", string(next))
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		memo        string
+		expForward  bool
+		expError    bool
+		expTimeout  time.Duration
+		expRetries  uint32
+		expNextMemo string
+	}{
+		{"empty memo", "", false, false, 0, 0, ""},
+		{"plain text memo", "hello", false, false, 0, 0, ""},
+		{"other JSON memo", `{"wasm": {}}`, false, false, 0, 0, ""},
+		{
+			"forward with defaults",
+			`{"forward": {"receiver": "cosmos1", "channel": "channel-1"}}`,
+			true, false, DefaultTimeout, DefaultRetries, "",
+		},
+		{
+			"forward with timeout string and retries",
+			`{"forward": {"receiver": "cosmos1", "port": "transfer", "channel": "channel-1", "timeout": "1h", "retries": 3}}`,
+			true, false, time.Hour, 3, "",
+		},
+		{
+			"forward with timeout in nanoseconds",
+			`{"forward": {"receiver": "cosmos1", "channel": "channel-1", "timeout": 60000000000, "retries": 0}}`,
+			true, false, time.Minute, 0, "",
+		},
+		{
+			"forward with next hop object",
+			`{"forward": {"receiver": "cosmos1", "channel": "channel-1", "next": {"forward": {"receiver": "osmo1", "channel": "channel-2"}}}}`,
+			true, false, DefaultTimeout, DefaultRetries, `{"forward":{"receiver":"osmo1","channel":"channel-2"}}`,
+		},
+		{
+			"forward with next memo string",
+			`{"forward": {"receiver": "cosmos1", "channel": "channel-1", "next": "hello"}}`,
+			true, false, DefaultTimeout, DefaultRetries, "hello",
+		},
+		{"null forward", `{"forward": null}`, false, true, 0, 0, ""},
+		{"invalid forward", `{"forward": "cosmos1"}`, false, true, 0, 0, ""},
+		{"missing receiver", `{"forward": {"channel": "channel-1"}}`, false, true, 0, 0, ""},
+		{"invalid channel", `{"forward": {"receiver": "cosmos1", "channel": "1"}}`, false, true, 0, 0, ""},
+		{"invalid timeout", `{"forward": {"receiver": "cosmos1", "channel": "channel-1", "timeout": "1 hour"}}`, false, true, 0, 0, ""},
+		{"negative timeout", `{"forward": {"receiver": "cosmos1", "channel": "channel-1", "timeout": "-1h"}}`, false, true, 0, 0, ""},
+		{"too many retries", `{"forward": {"receiver": "cosmos1", "channel": "channel-1", "retries": 11}}`, false, true, 0, 0, ""},
+		{"invalid next", `{"forward": {"receiver": "cosmos1", "channel": "channel-1", "next": 1}}`, false, true, 0, 0, ""},
+	}
+
+	for _, tc := range testCases {
+		metadata, err := ParseForwardMetadata(tc.memo)
+		if tc.expError {
+			require.ErrorIs(t, err, ErrInvalidForwardMetadata, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		if !tc.expForward {
+			require.Nil(t, metadata, tc.name)
+			continue
+		}
+
+		require.NotNil(t, metadata, tc.name)
+		require.Equal(t, "transfer", metadata.GetPort(), tc.name)
+		require.Equal(t, tc.expTimeout, metadata.GetTimeout(), tc.name)
+		require.Equal(t, tc.expRetries, metadata.GetRetries(), tc.name)
+
+		memo, err := metadata.NextMemo()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expNextMemo, memo, tc.name)
+	}
+}
@@ -24,11 +24,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	erc20types "github.com/evmos/evmos/v11/x/erc20/types"
+	transfertypes "github.com/evmos/evmos/v11/x/ibc/transfer/types"
 )
 
 var _ types.MsgServer = Keeper{}
@@ -92,15 +93,7 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	balance := k.bankKeeper.GetBalance(ctx, sender, pair.Denom)
 	if balance.Amount.GTE(msg.Token.Amount) {
 
-		defer func() {
-			telemetry.IncrCounterWithLabels(
-				[]string{"erc20", "ibc", "transfer", "total"},
-				1,
-				[]metrics.Label{
-					telemetry.NewLabel("denom", pair.Denom),
-				},
-			)
-		}()
+		defer k.incrERC20TransferMetrics(pair, msg)
 
 		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
 	}
@@ -120,15 +113,18 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
-	defer func() {
-		telemetry.IncrCounterWithLabels(
-			[]string{"erc20", "ibc", "transfer", "total"},
-			1,
-			[]metrics.Label{
-				telemetry.NewLabel("denom", pair.Denom),
-			},
-		)
-	}()
+	defer k.incrERC20TransferMetrics(pair, msg)
 
 	return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
 }
+
+// incrERC20TransferMetrics increments the metrics of the transfers of a
+// registered token pair
+func (k Keeper) incrERC20TransferMetrics(pair erc20types.TokenPair, msg *types.MsgTransfer) {
+	labels := []metrics.Label{
+		evmostelemetry.NewDenomLabel(pair.Denom),
+		evmostelemetry.NewChannelLabel(msg.SourceChannel),
+	}
+	evmostelemetry.IncrCounter(transfertypes.MetricKeyTransferERC20, labels...)
+	evmostelemetry.IncrAmount(transfertypes.MetricKeyTransferERC20Amount, msg.Token.Amount, labels...)
+}
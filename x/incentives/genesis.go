@@ -53,7 +53,11 @@ func InitGenesis(
 
 		// Build allocation meter map
 		for _, al := range incentive.Allocations {
-			allocationMeters[al.Denom] = allocationMeters[al.Denom].Add(al.Amount)
+			allocationMeter, found := allocationMeters[al.Denom]
+			if !found {
+				allocationMeter = sdk.ZeroDec()
+			}
+			allocationMeters[al.Denom] = allocationMeter.Add(al.Amount)
 		}
 	}
 
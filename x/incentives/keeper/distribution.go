@@ -28,6 +28,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/incentives/types"
 )
 
@@ -47,7 +48,15 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	}
 
 	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
-		_, _ = k.rewardParticipants(ctx, incentive, rewardAllocations)
+		rewards, participants := k.rewardParticipants(ctx, incentive, rewardAllocations)
+
+		contractLabel := evmostelemetry.NewContractLabel(incentive.Contract)
+		evmostelemetry.IncrCoins(types.MetricKeyDistributeRewardsAmount, rewards, contractLabel)
+		telemetry.IncrCounterWithLabels(
+			types.MetricKeyDistributeParticipants,
+			float32(participants),
+			[]metrics.Label{contractLabel},
+		)
 
 		incentive.Epochs--
 
@@ -58,6 +67,7 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 			k.SetIncentiveTotalGas(ctx, incentive, 0)
 		} else {
 			k.DeleteIncentiveAndUpdateAllocationMeters(ctx, incentive)
+			evmostelemetry.IncrCounter(types.MetricKeyFinalizeIncentive, contractLabel)
 			logger.Info(
 				"incentive finalized",
 				"contract", incentive.Contract,
@@ -78,15 +88,7 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	})
 
 	defer func() {
-		for _, r := range totalRewards {
-			if r.Amount.IsInt64() {
-				telemetry.IncrCounterWithLabels(
-					[]string{types.ModuleName, "distribute", "reward", "total"},
-					float32(r.Amount.Int64()),
-					[]metrics.Label{telemetry.NewLabel("denom", r.Denom)},
-				)
-			}
-		}
+		types.RecordIncentivesDistributed(ctx.Context(), totalRewards)
 	}()
 
 	return nil
@@ -20,6 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	epochstypes "github.com/evmos/evmos/v11/x/epochs/types"
+	"github.com/evmos/evmos/v11/x/incentives/types"
 )
 
 // BeforeEpochStart performs a no-op
@@ -51,7 +52,11 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks              = Hooks{}
+	_ epochstypes.EpochIdentifierReferrer = Hooks{}
+	_ epochstypes.NamedEpochHooks         = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -67,3 +72,13 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// IsEpochIdentifierReferenced implements EpochIdentifierReferrer
+func (h Hooks) IsEpochIdentifierReferenced(ctx sdk.Context, epochIdentifier string) bool {
+	return h.k.GetParams(ctx).IncentivesEpochIdentifier == epochIdentifier
+}
+
+// GetModuleName implements NamedEpochHooks
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
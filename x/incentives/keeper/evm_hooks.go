@@ -17,6 +17,7 @@
 package keeper
 
 import (
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +28,7 @@ import (
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/incentives/types"
 )
 
@@ -71,15 +73,14 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 	k.addGasToParticipant(ctx, *contract, participant, receipt.GasUsed)
 
 	defer func() {
-		telemetry.IncrCounter(
-			1,
-			"tx", "msg", "ethereum_tx", types.ModuleName, "total",
-		)
+		contractLabel := evmostelemetry.NewContractLabel(contract.String())
+		evmostelemetry.IncrCounter(types.MetricKeyParticipation, contractLabel)
 
 		if receipt.GasUsed != 0 {
-			telemetry.IncrCounter(
+			telemetry.IncrCounterWithLabels(
+				types.MetricKeyParticipationGas,
 				float32(receipt.GasUsed),
-				"tx", "msg", "ethereum_tx", types.ModuleName, "gas_used", "total",
+				[]metrics.Label{contractLabel},
 			)
 		}
 	}()
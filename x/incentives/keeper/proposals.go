@@ -22,6 +22,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/incentives/types"
 )
 
@@ -110,6 +111,13 @@ func (k Keeper) RegisterIncentive(
 		k.SetAllocationMeter(ctx, am)
 	}
 
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyRegisterIncentive,
+			evmostelemetry.NewContractLabel(incentive.Contract),
+		)
+	}()
+
 	return &incentive, nil
 }
 
@@ -143,5 +151,12 @@ func (k Keeper) CancelIncentive(
 		k.DeleteGasMeter(ctx, gm)
 	}
 
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyCancelIncentive,
+			evmostelemetry.NewContractLabel(incentive.Contract),
+		)
+	}()
+
 	return nil
 }
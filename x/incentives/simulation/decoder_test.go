@@ -0,0 +1,60 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/incentives/simulation"
+	"github.com/evmos/evmos/v11/x/incentives/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	contract := tests.GenerateAddress()
+	incentive := types.NewIncentive(contract, sdk.DecCoins{sdk.NewDecCoinFromDec("acoin", sdk.NewDecWithPrec(5, 2))}, 10)
+	meter := sdk.NewDecWithPrec(5, 2)
+	meterBz, err := meter.Marshal()
+	require.NoError(t, err)
+	params := types.DefaultParams()
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.KeyPrefixIncentive, contract.Bytes()...), Value: cdc.MustMarshal(&incentive)},
+			{Key: append(types.KeyPrefixGasMeter, contract.Bytes()...), Value: sdk.Uint64ToBigEndian(100)},
+			{Key: append(types.KeyPrefixAllocationMeter, []byte("acoin")...), Value: meterBz},
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Incentive", fmt.Sprintf("%v\n%v", incentive, incentive)},
+		{"GasMeter", "100\n100"},
+		{"AllocationMeter", fmt.Sprintf("%v\n%v", meter, meter)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"other", ""},
+	}
+	for i, tc := range testCases {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			switch i {
+			case len(testCases) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tc.name)
+			default:
+				require.Equal(t, tc.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	epochstypes "github.com/evmos/evmos/v11/x/epochs/types"
+	"github.com/evmos/evmos/v11/x/incentives/types"
+)
+
+// Simulation parameter constants
+const (
+	EnableIncentives          = "enable_incentives"
+	AllocationLimit           = "allocation_limit"
+	IncentivesEpochIdentifier = "incentives_epoch_identifier"
+	RewardScaler              = "reward_scaler"
+)
+
+// GenEnableIncentives randomizes whether the incentives are enabled
+func GenEnableIncentives(r *rand.Rand) bool {
+	return r.Int63n(101) <= 90 // 90% chance of incentives being enabled
+}
+
+// GenAllocationLimit randomizes the maximum allocation of an incentive, between
+// 1% and 20%
+func GenAllocationLimit(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(20)+1), 2)
+}
+
+// GenIncentivesEpochIdentifier randomizes the epoch at which the incentives
+// are distributed
+func GenIncentivesEpochIdentifier(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return epochstypes.DayEpochID
+	}
+	return epochstypes.WeekEpochID
+}
+
+// GenRewardScaler randomizes the scaling factor of the participants rewards,
+// between 0 and 2
+func GenRewardScaler(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(201)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for the incentives
+// module. The incentives are registered during the simulation through
+// governance proposals, as they require the incentivized contracts to be
+// deployed.
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableIncentives bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableIncentives, &enableIncentives, simState.Rand,
+		func(r *rand.Rand) { enableIncentives = GenEnableIncentives(r) },
+	)
+
+	var allocationLimit sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AllocationLimit, &allocationLimit, simState.Rand,
+		func(r *rand.Rand) { allocationLimit = GenAllocationLimit(r) },
+	)
+
+	var epochIdentifier string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, IncentivesEpochIdentifier, &epochIdentifier, simState.Rand,
+		func(r *rand.Rand) { epochIdentifier = GenIncentivesEpochIdentifier(r) },
+	)
+
+	var rewardScaler sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RewardScaler, &rewardScaler, simState.Rand,
+		func(r *rand.Rand) { rewardScaler = GenRewardScaler(r) },
+	)
+
+	incentivesGenesis := types.NewGenesisState(
+		types.NewParams(enableIncentives, allocationLimit, epochIdentifier, rewardScaler),
+		[]types.Incentive{},
+		[]types.GasMeter{},
+	)
+
+	bz, err := json.MarshalIndent(&incentivesGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated incentives parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&incentivesGenesis)
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/evmos/evmos/v11/x/incentives/keeper"
+	"github.com/evmos/evmos/v11/x/incentives/types"
+)
+
+// Governance proposal weight constants
+const (
+	OpWeightRegisterIncentiveProposal = "op_weight_register_incentive_proposal" //nolint:gosec
+	OpWeightCancelIncentiveProposal   = "op_weight_cancel_incentive_proposal"   //nolint:gosec
+
+	DefaultWeightRegisterIncentiveProposal = 10
+	DefaultWeightCancelIncentiveProposal   = 5
+)
+
+// maxIncentiveEpochs is the maximum number of epochs of a random incentive
+const maxIncentiveEpochs = 10
+
+// ProposalContents defines the incentives module weighted proposals' contents
+func ProposalContents(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightRegisterIncentiveProposal,
+			DefaultWeightRegisterIncentiveProposal,
+			SimulateRegisterIncentiveProposalContent(k, ak, bk),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightCancelIncentiveProposal,
+			DefaultWeightCancelIncentiveProposal,
+			SimulateCancelIncentiveProposalContent(k),
+		),
+	}
+}
+
+// SimulateRegisterIncentiveProposalContent generates a random proposal to
+// incentivize a deployed contract with an allocation of the coins held by the
+// incentives module
+func SimulateRegisterIncentiveProposalContent(
+	k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		params := k.GetParams(ctx)
+		if !params.EnableIncentives {
+			return nil
+		}
+
+		var contracts []string
+		ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+			ethAcc, ok := acc.(ethermint.EthAccountI)
+			if ok && ethAcc.Type() == ethermint.AccountTypeContract &&
+				!k.IsIncentiveRegistered(ctx, ethAcc.EthAddress()) {
+				contracts = append(contracts, ethAcc.EthAddress().Hex())
+			}
+			return false
+		})
+
+		if len(contracts) == 0 {
+			return nil
+		}
+
+		balances := bk.GetAllBalances(ctx, ak.GetModuleAddress(types.ModuleName))
+		if balances.Empty() {
+			return nil
+		}
+
+		denom := balances[r.Intn(len(balances))].Denom
+
+		// the allocation cannot exceed the allocation limit nor the remaining
+		// allocation of the denomination
+		allocationMeter, _ := k.GetAllocationMeter(ctx, denom)
+		maxAllocation := sdk.MinDec(params.AllocationLimit, sdk.OneDec().Sub(allocationMeter.Amount))
+		if !maxAllocation.IsPositive() {
+			return nil
+		}
+
+		allocation := maxAllocation.MulInt64(int64(r.Intn(100) + 1)).QuoInt64(100)
+		if !allocation.IsPositive() {
+			return nil
+		}
+
+		return types.NewRegisterIncentiveProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			contracts[r.Intn(len(contracts))],
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, allocation)),
+			uint32(r.Intn(maxIncentiveEpochs)+1),
+		)
+	}
+}
+
+// SimulateCancelIncentiveProposalContent generates a random proposal to
+// cancel a registered incentive
+func SimulateCancelIncentiveProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		if !k.GetParams(ctx).EnableIncentives {
+			return nil
+		}
+
+		incentives := k.GetAllIncentives(ctx)
+		if len(incentives) == 0 {
+			return nil
+		}
+
+		incentive := incentives[r.Intn(len(incentives))]
+
+		return types.NewCancelIncentiveProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			incentive.Contract,
+		)
+	}
+}
@@ -19,9 +19,8 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/armon/go-metrics"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	epochstypes "github.com/evmos/evmos/v11/x/epochs/types"
 	"github.com/evmos/evmos/v11/x/inflation/types"
 )
@@ -44,6 +43,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		skippedEpochs++
 
 		k.SetSkippedEpochs(ctx, skippedEpochs)
+		evmostelemetry.IncrCounter(types.MetricKeySkippedEpochs)
 		k.Logger(ctx).Debug(
 			"skipping inflation mint and allocation",
 			"height", ctx.BlockHeight(),
@@ -105,38 +105,18 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	}
 
 	defer func() {
+		denomLabel := evmostelemetry.NewDenomLabel(mintedCoin.Denom)
 		stakingAmt := staking.AmountOfNoDenomValidation(mintedCoin.Denom)
 		incentivesAmt := incentives.AmountOfNoDenomValidation(mintedCoin.Denom)
 		cpAmt := communityPool.AmountOfNoDenomValidation(mintedCoin.Denom)
 
-		if mintedCoin.Amount.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "total"},
-				float32(mintedCoin.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if stakingAmt.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "staking", "total"},
-				float32(stakingAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if incentivesAmt.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "incentives", "total"},
-				float32(incentivesAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if cpAmt.IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "community_pool", "total"},
-				float32(cpAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
+		evmostelemetry.IncrAmount(types.MetricKeyMintAmount, mintedCoin.Amount, denomLabel)
+		evmostelemetry.IncrAmount(types.MetricKeyAllocateStakingAmount, stakingAmt, denomLabel)
+		evmostelemetry.IncrAmount(types.MetricKeyAllocateIncentivesAmount, incentivesAmt, denomLabel)
+		evmostelemetry.IncrAmount(types.MetricKeyAllocateCommunityPoolAmount, cpAmt, denomLabel)
+		evmostelemetry.SetGauge(types.MetricKeyPeriod, float32(period))
+
+		types.RecordInflationMinted(ctx.Context(), sdk.NewCoins(mintedCoin))
 	}()
 
 	ctx.EventManager().EmitEvent(
@@ -149,6 +129,24 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	)
 }
 
+// AfterEpochsSkipped increments the number of skipped epochs by the number of
+// epochs skipped by the epochs module, so that they are not accounted for when
+// checking if a period has passed
+func (k Keeper) AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, _, skippedEpochs int64) {
+	if epochIdentifier != k.GetEpochIdentifier(ctx) || skippedEpochs <= 0 {
+		return
+	}
+
+	skipped := k.GetSkippedEpochs(ctx) + uint64(skippedEpochs)
+	k.SetSkippedEpochs(ctx, skipped)
+	k.Logger(ctx).Debug(
+		"skipping inflation mint and allocation for missed epochs",
+		"height", ctx.BlockHeight(),
+		"epoch-id", epochIdentifier,
+		"skipped-epochs", skipped,
+	)
+}
+
 // ___________________________________________________________________________________________________
 
 // Hooks wrapper struct for incentives keeper
@@ -156,7 +154,12 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks              = Hooks{}
+	_ epochstypes.EpochIdentifierReferrer = Hooks{}
+	_ epochstypes.NamedEpochHooks         = Hooks{}
+	_ epochstypes.EpochsSkippedHooks      = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -171,3 +174,18 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+func (h Hooks) AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber, skippedEpochs int64) {
+	h.k.AfterEpochsSkipped(ctx, epochIdentifier, epochNumber, skippedEpochs)
+}
+
+// IsEpochIdentifierReferenced returns true if the given epoch identifier is
+// the one used for inflation
+func (h Hooks) IsEpochIdentifierReferenced(ctx sdk.Context, epochIdentifier string) bool {
+	return h.k.GetEpochIdentifier(ctx) == epochIdentifier
+}
+
+// GetModuleName implements NamedEpochHooks
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
+}
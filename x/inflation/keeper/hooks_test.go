@@ -235,3 +235,31 @@ func (suite *KeeperTestSuite) TestPeriodChangesSkippedEpochsAfterEpochEnd() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAfterEpochsSkipped() {
+	testCases := []struct {
+		name            string
+		epochIdentifier string
+		expSkipped      uint64
+	}{
+		{
+			"correct epoch identifier",
+			epochstypes.DayEpochID,
+			15,
+		},
+		{
+			"incorrect epoch identifier",
+			epochstypes.WeekEpochID,
+			10,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			suite.app.InflationKeeper.SetSkippedEpochs(suite.ctx, 10)
+			suite.app.InflationKeeper.Hooks().AfterEpochsSkipped(suite.ctx, tc.epochIdentifier, 20, 5)
+			suite.Require().Equal(tc.expSkipped, suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx))
+		})
+	}
+}
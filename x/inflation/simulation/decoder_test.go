@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/inflation/simulation"
+	"github.com/evmos/evmos/v11/x/inflation/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	params := types.DefaultParams()
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyPrefixPeriod, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.KeyPrefixEpochIdentifier, Value: []byte("day")},
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Period", "2\n2"},
+		{"EpochIdentifier", "day\nday"},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"other", ""},
+	}
+	for i, tc := range testCases {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			switch i {
+			case len(testCases) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tc.name)
+			default:
+				require.Equal(t, tc.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tc.name)
+			}
+		})
+	}
+}
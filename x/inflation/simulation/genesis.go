@@ -0,0 +1,131 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	epochstypes "github.com/evmos/evmos/v11/x/epochs/types"
+	"github.com/evmos/evmos/v11/x/inflation/types"
+)
+
+// Simulation parameter constants
+const (
+	ExponentialCalculation = "exponential_calculation"
+	InflationDistribution  = "inflation_distribution"
+	EnableInflation        = "enable_inflation"
+	EpochIdentifier        = "epoch_identifier"
+	EpochsPerPeriod        = "epochs_per_period"
+)
+
+// GenExponentialCalculation randomizes the factors of the inflation exponential
+// decay function
+func GenExponentialCalculation(r *rand.Rand) types.ExponentialCalculation {
+	return types.ExponentialCalculation{
+		A:             sdk.NewDec(r.Int63n(1_000_000_000)),
+		R:             sdk.NewDecWithPrec(r.Int63n(101), 2),
+		C:             sdk.NewDec(r.Int63n(100_000_000)),
+		BondingTarget: sdk.NewDecWithPrec(r.Int63n(100)+1, 2),
+		MaxVariance:   sdk.NewDecWithPrec(r.Int63n(101), 2),
+	}
+}
+
+// GenInflationDistribution randomizes the proportions of the minted tokens
+// allocated to the staking rewards, the usage incentives and the community
+// pool, which add up to 1
+func GenInflationDistribution(r *rand.Rand) types.InflationDistribution {
+	stakingRewards := sdk.NewDecWithPrec(r.Int63n(101), 2)
+	usageIncentives := sdk.NewDecWithPrec(r.Int63n(101), 2).Mul(sdk.OneDec().Sub(stakingRewards))
+
+	return types.InflationDistribution{
+		StakingRewards:  stakingRewards,
+		UsageIncentives: usageIncentives,
+		CommunityPool:   sdk.OneDec().Sub(stakingRewards).Sub(usageIncentives),
+	}
+}
+
+// GenEnableInflation randomizes whether the inflation is enabled
+func GenEnableInflation(r *rand.Rand) bool {
+	return r.Int63n(101) <= 90 // 90% chance of inflation being enabled
+}
+
+// GenEpochIdentifier randomizes the epoch at which the tokens are minted
+func GenEpochIdentifier(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return epochstypes.DayEpochID
+	}
+	return epochstypes.WeekEpochID
+}
+
+// GenEpochsPerPeriod randomizes the number of epochs of an inflation period
+func GenEpochsPerPeriod(r *rand.Rand) int64 {
+	return r.Int63n(365) + 1
+}
+
+// RandomizedGenState generates a random GenesisState for the inflation module
+func RandomizedGenState(simState *module.SimulationState) {
+	var exponentialCalculation types.ExponentialCalculation
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ExponentialCalculation, &exponentialCalculation, simState.Rand,
+		func(r *rand.Rand) { exponentialCalculation = GenExponentialCalculation(r) },
+	)
+
+	var inflationDistribution types.InflationDistribution
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationDistribution, &inflationDistribution, simState.Rand,
+		func(r *rand.Rand) { inflationDistribution = GenInflationDistribution(r) },
+	)
+
+	var enableInflation bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableInflation, &enableInflation, simState.Rand,
+		func(r *rand.Rand) { enableInflation = GenEnableInflation(r) },
+	)
+
+	var epochIdentifier string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochIdentifier, &epochIdentifier, simState.Rand,
+		func(r *rand.Rand) { epochIdentifier = GenEpochIdentifier(r) },
+	)
+
+	var epochsPerPeriod int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochsPerPeriod, &epochsPerPeriod, simState.Rand,
+		func(r *rand.Rand) { epochsPerPeriod = GenEpochsPerPeriod(r) },
+	)
+
+	params := types.NewParams(
+		types.DefaultInflationDenom,
+		exponentialCalculation,
+		inflationDistribution,
+		enableInflation,
+	)
+	inflationGenesis := types.NewGenesisState(params, 0, epochIdentifier, epochsPerPeriod, 0)
+
+	bz, err := json.MarshalIndent(&inflationGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated inflation parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&inflationGenesis)
+}
@@ -23,6 +23,16 @@ import (
 
 var _ sdk.Msg = &MsgUpdateParams{}
 
+const (
+	TypeMsgUpdateParams = "update_params"
+)
+
+// Route returns the message route for a MsgUpdateParams message.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams message.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Authority)
@@ -0,0 +1,176 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+const (
+	// flagVersion defines the channel version flag of the register command
+	flagVersion = "version"
+	// flagMemo defines the packet memo flag of the submit-tx command
+	flagMemo = "memo"
+	// flagTimeout defines the packet timeout flag of the submit-tx command
+	flagTimeout = "timeout"
+)
+
+// NewTxCmd returns a root CLI command handler for certain modules/intertx
+// transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "intertx subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterAccountCmd(),
+		NewSubmitTxCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterAccountCmd returns a CLI command handler for registering an
+// interchain account of the sender
+func NewRegisterAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONNECTION_ID",
+		Short: "Register an interchain account of the sender on the chain of a connection",
+		Long:  "Register an interchain account of the sender on the chain of a connection. The channel version is negotiated by the controller module if left empty.",
+		Example: fmt.Sprintf(
+			"%s tx %s register connection-0",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelVersion, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterAccount(cliCtx.GetFromAddress(), args[0], channelVersion)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Version of the interchain account channel")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSubmitTxCmd returns a CLI command handler for executing messages with the
+// interchain account of the sender
+func NewSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-tx CONNECTION_ID MSGS_JSON_FILE",
+		Short: "Execute messages with the interchain account of the sender on the chain of a connection",
+		Long:  "Execute messages with the interchain account of the sender on the chain of a connection. The file contains a JSON encoded message, or an array of JSON encoded messages.",
+		Example: fmt.Sprintf(
+			"%s tx %s submit-tx connection-0 msgs.json --%s 10m",
+			version.AppName, types.ModuleName, flagTimeout,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseMsgs(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := cmd.Flags().GetDuration(flagTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitTx(cliCtx.GetFromAddress(), args[0], msgs)
+			if err != nil {
+				return err
+			}
+
+			msg.Memo = memo
+			msg.Timeout = timeout
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMemo, "", "Memo of the interchain account packet")
+	cmd.Flags().Duration(flagTimeout, types.DefaultTimeout, "Relative timeout of the interchain account packet")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMsgs reads a JSON encoded message, or an array of JSON encoded
+// messages, from a file
+func parseMsgs(cliCtx client.Context, path string) ([]sdk.Msg, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(contents, &raws); err != nil {
+		raws = []json.RawMessage{contents}
+	}
+
+	msgs := make([]sdk.Msg, len(raws))
+	for i, raw := range raws {
+		if err := cliCtx.Codec.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for intertx keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
+// the module keeper
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return h.k.PostTxProcessing(ctx, msg, receipt)
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. The EVM hook allows
+// contracts to control interchain accounts by emitting the events of the
+// interchain accounts ABI. The emitting contract is the owner of the
+// interchain account, so that a contract can only control its own accounts.
+// The EVM transaction fails if the account cannot be registered or the
+// transaction cannot be sent.
+//
+// Note that the PostTxProcessing hook is only called by sending an EVM
+// transaction that triggers `ApplyTransaction`. The events emitted during
+// the contract callbacks are ignored.
+func (k Keeper) PostTxProcessing(
+	ctx sdk.Context,
+	_ core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	icaABI := types.InterchainAccountsABI
+
+	for _, log := range receipt.Logs {
+		// the events don't have indexed arguments
+		if len(log.Topics) != 1 {
+			continue
+		}
+
+		event, err := icaABI.EventByID(log.Topics[0])
+		if err != nil {
+			continue
+		}
+
+		owner := sdk.AccAddress(log.Address.Bytes())
+
+		switch event.Name {
+		case types.ContractEventRegisterAccount:
+			var registerEvent types.RegisterAccountEvent
+			if err := icaABI.UnpackIntoInterface(&registerEvent, event.Name, log.Data); err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidPacketData, "failed to unpack %s event: %s", event.Name, err)
+			}
+
+			if _, err := k.RegisterInterchainAccount(ctx, owner, registerEvent.ConnectionId, registerEvent.Version); err != nil {
+				return err
+			}
+		case types.ContractEventSubmitTx:
+			var submitEvent types.SubmitTxEvent
+			if err := icaABI.UnpackIntoInterface(&submitEvent, event.Name, log.Data); err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidPacketData, "failed to unpack %s event: %s", event.Name, err)
+			}
+
+			if err := submitEvent.Validate(); err != nil {
+				return err
+			}
+
+			if _, err := k.SendTx(ctx, owner, submitEvent.ConnectionId, submitEvent.Data, submitEvent.Memo, submitEvent.GetTimeout()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// callContract calls a callback method of the owner of an interchain account
+// if the owner is a contract. The state changes of failed callbacks are
+// discarded, so that they don't prevent the handling of the packet, and the
+// gas used by the callback is charged to the packet transaction.
+func (k Keeper) callContract(ctx sdk.Context, owner sdk.AccAddress, method string, args ...interface{}) {
+	contract := common.BytesToAddress(owner)
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasUsed, err := k.callEVM(cacheCtx, contract, method, args...)
+	if err != nil {
+		k.Logger(ctx).Error("contract callback failed", "contract", contract.Hex(), "method", method, "error", err.Error())
+	} else {
+		writeCache()
+	}
+
+	ctx.GasMeter().ConsumeGas(gasUsed, "interchain account contract callback")
+
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "failure"
+		}
+		evmostelemetry.IncrCounter(
+			types.MetricKeyContractCallback,
+			evmostelemetry.NewActionLabel(method),
+			evmostelemetry.NewStatusLabel(status),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractCallback,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAction, method),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		),
+	)
+}
+
+// callEVM calls a method of a contract from the module account with the
+// callback gas limit. It returns the gas used by the call.
+func (k Keeper) callEVM(ctx sdk.Context, contract common.Address, method string, args ...interface{}) (uint64, error) {
+	data, err := types.InterchainAccountsABI.Pack(method, args...)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrContractCallback, err.Error())
+	}
+
+	from := common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName))
+	gasUsed, err := k.erc20Keeper.CallEVMWithGasLimit(ctx, from, contract, data, k.GetParams(ctx).CallbackGasLimit)
+	if err != nil {
+		return gasUsed, errorsmod.Wrap(types.ErrContractCallback, err.Error())
+	}
+
+	return gasUsed, nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+// contractLog returns a log of the given interchain accounts event emitted by
+// the contract
+func (suite *KeeperTestSuite) contractLog(contract common.Address, name string, args ...interface{}) *ethtypes.Log {
+	event := types.InterchainAccountsABI.Events[name]
+	data, err := event.Inputs.Pack(args...)
+	suite.Require().NoError(err)
+
+	return &ethtypes.Log{
+		Address: contract,
+		Topics:  []common.Hash{event.ID},
+		Data:    data,
+	}
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		name    string
+		log     *ethtypes.Log
+		expPass bool
+	}{
+		{
+			"pass - unrelated log",
+			&ethtypes.Log{Address: contract, Topics: []common.Hash{common.HexToHash("0x01")}},
+			true,
+		},
+		{
+			"pass - indexed log",
+			&ethtypes.Log{
+				Address: contract,
+				Topics:  []common.Hash{types.InterchainAccountsABI.Events[types.ContractEventRegisterAccount].ID, {}},
+			},
+			true,
+		},
+		{
+			"fail - invalid register event data",
+			&ethtypes.Log{
+				Address: contract,
+				Topics:  []common.Hash{types.InterchainAccountsABI.Events[types.ContractEventRegisterAccount].ID},
+				Data:    []byte{1, 2, 3},
+			},
+			false,
+		},
+		{
+			"fail - register on unknown connection",
+			suite.contractLog(contract, types.ContractEventRegisterAccount, "connection-0", ""),
+			false,
+		},
+		{
+			"fail - invalid submit tx data",
+			suite.contractLog(contract, types.ContractEventSubmitTx, "connection-0", []byte{0xff}, "", uint64(0)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			receipt := &ethtypes.Receipt{Logs: []*ethtypes.Log{tc.log}}
+			err := suite.app.InterTxKeeper.PostTxProcessing(suite.ctx, nil, receipt)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InterchainAccount returns the address of the interchain account of an owner
+// on the host chain of a connection
+func (k Keeper) InterchainAccount(
+	c context.Context,
+	req *types.QueryInterchainAccountRequest,
+) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, found := k.GetInterchainAccountAddress(ctx, owner, req.ConnectionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no interchain account found for owner %s on connection %s", req.Owner, req.ConnectionId)
+	}
+
+	return &types.QueryInterchainAccountResponse{Address: address}, nil
+}
+
+// Params returns the intertx module params
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	_, err := suite.queryClient.InterchainAccount(ctx, &types.QueryInterchainAccountRequest{Owner: "evmos1", ConnectionId: "connection-0"})
+	suite.Require().Error(err)
+
+	// the interchain account is not registered
+	_, err = suite.queryClient.InterchainAccount(ctx, &types.QueryInterchainAccountRequest{Owner: owner, ConnectionId: "connection-0"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	expParams := types.DefaultParams()
+
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, res.Params)
+}
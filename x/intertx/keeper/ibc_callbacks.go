@@ -0,0 +1,129 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+// OnAcknowledgementPacket handles the acknowledgement of an interchain account
+// packet. If the owner of the interchain account is a contract, its
+// acknowledgement callback is called with the result of the executed
+// messages, or the error of a failed execution.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	owner, connectionID, err := k.getPacketOwner(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		status := "success"
+		if !ack.Success() {
+			status = "failure"
+		}
+		evmostelemetry.IncrCounter(
+			types.MetricKeyPacketResult,
+			evmostelemetry.NewChannelLabel(packet.SourceChannel),
+			evmostelemetry.NewStatusLabel(status),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketResult,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+		),
+	)
+
+	result := ack.GetResult()
+	if !ack.Success() {
+		result = []byte(ack.GetError())
+	}
+
+	k.callContract(ctx, owner, types.ContractMethodOnAcknowledgement, connectionID, packet.Sequence, ack.Success(), result)
+	return nil
+}
+
+// OnTimeoutPacket handles the timeout of an interchain account packet. If the
+// owner of the interchain account is a contract, its timeout callback is
+// called. The channel of the timed out packet is closed, and the interchain
+// account has to be registered again to reopen it.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	owner, connectionID, err := k.getPacketOwner(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyPacketResult,
+			evmostelemetry.NewChannelLabel(packet.SourceChannel),
+			evmostelemetry.NewStatusLabel("timeout"),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketResult,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimeout, "true"),
+		),
+	)
+
+	k.callContract(ctx, owner, types.ContractMethodOnTimeout, connectionID, packet.Sequence)
+	return nil
+}
+
+// getPacketOwner returns the owner of the interchain account that sent the
+// packet and the connection of its channel
+func (k Keeper) getPacketOwner(ctx sdk.Context, packet channeltypes.Packet) (sdk.AccAddress, string, error) {
+	owner, err := sdk.AccAddressFromBech32(strings.TrimPrefix(packet.SourcePort, icatypes.ControllerPortPrefix))
+	if err != nil {
+		return nil, "", errorsmod.Wrapf(types.ErrInvalidOwner, "invalid owner of port %s: %s", packet.SourcePort, err)
+	}
+
+	connectionID, err := k.controllerKeeper.GetConnectionID(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return owner, connectionID, nil
+}
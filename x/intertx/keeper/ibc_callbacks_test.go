@@ -0,0 +1,359 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibcgotesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/evmos/evmos/v11/app"
+	ibctesting "github.com/evmos/evmos/v11/ibc/testing"
+	evmostypes "github.com/evmos/evmos/v11/types"
+	inflationtypes "github.com/evmos/evmos/v11/x/inflation/types"
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+// callbackCode is the runtime bytecode of a contract that stores the size of
+// its calldata in the storage slot 0:
+//
+//	CALLDATASIZE PUSH1 0x00 SSTORE STOP
+var callbackCode = []byte{0x36, 0x60, 0x00, 0x55, 0x00}
+
+type IBCTestingSuite struct {
+	suite.Suite
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	EvmosChain     *ibcgotesting.TestChain
+	IBCCosmosChain *ibcgotesting.TestChain
+
+	app *app.Evmos
+
+	path *ibctesting.Path
+}
+
+func TestIBCTestingSuite(t *testing.T) {
+	suite.Run(t, new(IBCTestingSuite))
+}
+
+func (suite *IBCTestingSuite) SetupTest() {
+	// initializes 2 test chains
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 1)
+	suite.EvmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.IBCCosmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.coordinator.CommitNBlocks(suite.EvmosChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCCosmosChain, 2)
+
+	suite.app = suite.EvmosChain.App.(*app.Evmos)
+	evmParams := suite.app.EvmKeeper.GetParams(suite.EvmosChain.GetContext())
+	evmParams.EvmDenom = evmostypes.BaseDenom
+	err := suite.app.EvmKeeper.SetParams(suite.EvmosChain.GetContext(), evmParams)
+	suite.Require().NoError(err)
+
+	// Set block proposer once, so its carried over on the ibc-go-testing suite
+	validators := suite.app.StakingKeeper.GetValidators(suite.EvmosChain.GetContext(), 2)
+	cons, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.EvmosChain.CurrentHeader.ProposerAddress = cons.Bytes()
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.EvmosChain.GetContext(), validators[0])
+	suite.Require().NoError(err)
+
+	// Fund sender address to pay fees
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(evmostypes.BaseDenom, amt))
+	err = suite.app.BankKeeper.MintCoins(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, suite.EvmosChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	// the interchain account packets are relayed over a connection, the
+	// channels are opened by the registration of the accounts
+	suite.path = ibctesting.NewPath(suite.EvmosChain, suite.IBCCosmosChain)
+	ibctesting.SetupConnections(suite.coordinator, suite.path)
+}
+
+// openChannel completes the handshake of the interchain account channel
+// initialized on Evmos with the given events, and returns the address of the
+// interchain account on Cosmos
+func (suite *IBCTestingSuite) openChannel(owner sdk.AccAddress, events sdk.Events) string {
+	path := suite.path
+
+	channelID, err := ibcgotesting.ParseChannelIDFromEvents(events)
+	suite.Require().NoError(err)
+	portID, err := icatypes.NewControllerPortID(owner.String())
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID = channelID
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	address, found := suite.app.ICAControllerKeeper.GetInterchainAccountAddress(suite.EvmosChain.GetContext(), path.EndpointA.ConnectionID, portID)
+	suite.Require().True(found)
+
+	// fund the interchain account on Cosmos
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	cosmosApp := suite.IBCCosmosChain.GetSimApp()
+	err = cosmosApp.BankKeeper.MintCoins(suite.IBCCosmosChain.GetContext(), minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = cosmosApp.BankKeeper.SendCoinsFromModuleToAccount(suite.IBCCosmosChain.GetContext(), minttypes.ModuleName, sdk.MustAccAddressFromBech32(address), coins)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.IBCCosmosChain)
+
+	return address
+}
+
+// deployCallbackContract sets the callback contract code on a new address
+func (suite *IBCTestingSuite) deployCallbackContract() common.Address {
+	contract := tests.GenerateAddress()
+	codeHash := crypto.Keccak256(callbackCode)
+
+	ctx := suite.EvmosChain.GetContext()
+	suite.app.EvmKeeper.SetCode(ctx, codeHash, callbackCode)
+	err := suite.app.EvmKeeper.SetAccount(ctx, contract, statedb.Account{Nonce: 1, Balance: big.NewInt(0), CodeHash: codeHash})
+	suite.Require().NoError(err)
+
+	return contract
+}
+
+// emitContractEvent calls the EVM hook with the event emitted by the contract
+// and returns the events of the Evmos chain
+func (suite *IBCTestingSuite) emitContractEvent(contract common.Address, name string, args ...interface{}) sdk.Events {
+	event := types.InterchainAccountsABI.Events[name]
+	data, err := event.Inputs.Pack(args...)
+	suite.Require().NoError(err)
+
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{{Address: contract, Topics: []common.Hash{event.ID}, Data: data}},
+	}
+
+	ctx := suite.EvmosChain.GetContext()
+	err = suite.app.InterTxKeeper.PostTxProcessing(ctx, nil, receipt)
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(suite.EvmosChain)
+	return ctx.EventManager().Events()
+}
+
+// relayPacket relays the packet to Cosmos and its acknowledgement back to
+// Evmos, and returns the acknowledgement
+func (suite *IBCTestingSuite) relayPacket(packet channeltypes.Packet) channeltypes.Acknowledgement {
+	path := suite.path
+
+	err := path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ackBz, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.AcknowledgePacket(packet, ackBz)
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	err = icatypes.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+	suite.Require().NoError(err)
+	return ack
+}
+
+// serializeSend returns the CosmosTx data of a bank transfer from the
+// interchain account
+func (suite *IBCTestingSuite) serializeSend(from string, amount int64) []byte {
+	send := banktypes.NewMsgSend(
+		sdk.MustAccAddressFromBech32(from),
+		suite.IBCCosmosChain.SenderAccount.GetAddress(),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+	)
+
+	data, err := icatypes.SerializeCosmosTx(suite.app.AppCodec(), []proto.Message{send})
+	suite.Require().NoError(err)
+	return data
+}
+
+func (suite *IBCTestingSuite) cosmosBalance(address string) sdk.Coin {
+	return suite.IBCCosmosChain.GetSimApp().BankKeeper.GetBalance(suite.IBCCosmosChain.GetContext(), sdk.MustAccAddressFromBech32(address), sdk.DefaultBondDenom)
+}
+
+func (suite *IBCTestingSuite) TestRegisterAndSubmitTx() {
+	owner := suite.EvmosChain.SenderAccount.GetAddress()
+	connectionID := suite.path.EndpointA.ConnectionID
+
+	res, err := ibctesting.SendMsgs(suite.EvmosChain, ibctesting.DefaultFeeAmt, types.NewMsgRegisterAccount(owner, connectionID, ""))
+	suite.Require().NoError(err)
+	address := suite.openChannel(owner, res.GetEvents())
+
+	queryRes, err := suite.app.InterTxKeeper.InterchainAccount(
+		sdk.WrapSDKContext(suite.EvmosChain.GetContext()),
+		&types.QueryInterchainAccountRequest{Owner: owner.String(), ConnectionId: connectionID},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(address, queryRes.Address)
+
+	send := banktypes.NewMsgSend(
+		sdk.MustAccAddressFromBech32(address),
+		suite.IBCCosmosChain.SenderAccount.GetAddress(),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)),
+	)
+	msg, err := types.NewMsgSubmitTx(owner, connectionID, []sdk.Msg{send})
+	suite.Require().NoError(err)
+
+	res, err = ibctesting.SendMsgs(suite.EvmosChain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the packet times out after the default timeout
+	expTimeout := suite.EvmosChain.CurrentHeader.Time.Add(types.DefaultTimeout)
+	suite.Require().LessOrEqual(packet.TimeoutTimestamp, uint64(expTimeout.UnixNano()))
+
+	ack := suite.relayPacket(packet)
+	suite.Require().True(ack.Success())
+	suite.Require().Equal(int64(60), suite.cosmosBalance(address).Amount.Int64())
+}
+
+func (suite *IBCTestingSuite) TestContractAcknowledgement() {
+	contract := suite.deployCallbackContract()
+	owner := sdk.AccAddress(contract.Bytes())
+	connectionID := suite.path.EndpointA.ConnectionID
+
+	events := suite.emitContractEvent(contract, types.ContractEventRegisterAccount, connectionID, "")
+	address := suite.openChannel(owner, events)
+
+	testCases := []struct {
+		name   string
+		amount int64
+		expAck bool
+	}{
+		{"successful execution", 40, true},
+		{"failed execution", 1000, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.EvmosChain.GetContext()
+			suite.app.EvmKeeper.SetState(ctx, contract, common.Hash{}, []byte{})
+			suite.coordinator.CommitBlock(suite.EvmosChain)
+
+			events := suite.emitContractEvent(contract, types.ContractEventSubmitTx, connectionID, suite.serializeSend(address, tc.amount), "memo", uint64(60))
+			packet, err := ibcgotesting.ParsePacketFromEvents(events)
+			suite.Require().NoError(err)
+
+			ack := suite.relayPacket(packet)
+			suite.Require().Equal(tc.expAck, ack.Success())
+
+			// the acknowledgement callback is called with the result
+			result := ack.GetResult()
+			if !tc.expAck {
+				result = []byte(ack.GetError())
+			}
+			calldata, err := types.InterchainAccountsABI.Pack(types.ContractMethodOnAcknowledgement, connectionID, packet.Sequence, tc.expAck, result)
+			suite.Require().NoError(err)
+
+			slot := suite.app.EvmKeeper.GetState(suite.EvmosChain.GetContext(), contract, common.Hash{})
+			suite.Require().Equal(int64(len(calldata)), slot.Big().Int64())
+		})
+	}
+
+	suite.Require().Equal(int64(60), suite.cosmosBalance(address).Amount.Int64())
+}
+
+func (suite *IBCTestingSuite) TestContractCallbackOutOfGas() {
+	contract := suite.deployCallbackContract()
+	owner := sdk.AccAddress(contract.Bytes())
+	connectionID := suite.path.EndpointA.ConnectionID
+
+	events := suite.emitContractEvent(contract, types.ContractEventRegisterAccount, connectionID, "")
+	address := suite.openChannel(owner, events)
+
+	// the callback can't pay for the storage write
+	ctx := suite.EvmosChain.GetContext()
+	err := suite.app.InterTxKeeper.SetParams(ctx, types.NewParams(30_000))
+	suite.Require().NoError(err)
+	suite.app.EvmKeeper.SetState(ctx, contract, common.Hash{}, []byte{})
+	suite.coordinator.CommitBlock(suite.EvmosChain)
+
+	events = suite.emitContractEvent(contract, types.ContractEventSubmitTx, connectionID, suite.serializeSend(address, 40), "", uint64(60))
+	packet, err := ibcgotesting.ParsePacketFromEvents(events)
+	suite.Require().NoError(err)
+
+	// the failed callback doesn't prevent the acknowledgement of the packet
+	ack := suite.relayPacket(packet)
+	suite.Require().True(ack.Success())
+	slot := suite.app.EvmKeeper.GetState(suite.EvmosChain.GetContext(), contract, common.Hash{})
+	suite.Require().Equal(common.Hash{}, slot)
+	suite.Require().Equal(int64(60), suite.cosmosBalance(address).Amount.Int64())
+}
+
+func (suite *IBCTestingSuite) TestContractTimeout() {
+	contract := suite.deployCallbackContract()
+	owner := sdk.AccAddress(contract.Bytes())
+	path := suite.path
+	connectionID := path.EndpointA.ConnectionID
+
+	events := suite.emitContractEvent(contract, types.ContractEventRegisterAccount, connectionID, "")
+	address := suite.openChannel(owner, events)
+
+	events = suite.emitContractEvent(contract, types.ContractEventSubmitTx, connectionID, suite.serializeSend(address, 40), "", uint64(60))
+	packet, err := ibcgotesting.ParsePacketFromEvents(events)
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.IBCCosmosChain)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	// build the timeout message as the counterparty uses different port identifiers
+	packetKey := host.NextSequenceRecvKey(packet.DestinationPort, packet.DestinationChannel)
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+	nextSeqRecv, found := suite.IBCCosmosChain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.IBCCosmosChain.GetContext(), packet.DestinationPort, packet.DestinationChannel)
+	suite.Require().True(found)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, suite.EvmosChain.SenderAccount.GetAddress().String())
+	res, err := ibctesting.SendMsgs(suite.EvmosChain, ibctesting.DefaultFeeAmt, timeoutMsg)
+	suite.Require().NoError(err)
+
+	// the timeout callback is called and the ordered channel is closed
+	calldata, err := types.InterchainAccountsABI.Pack(types.ContractMethodOnTimeout, connectionID, packet.Sequence)
+	suite.Require().NoError(err)
+	slot := suite.app.EvmKeeper.GetState(suite.EvmosChain.GetContext(), contract, common.Hash{})
+	suite.Require().Equal(int64(len(calldata)), slot.Big().Int64())
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+	suite.Require().Equal(int64(100), suite.cosmosBalance(address).Amount.Int64())
+
+	callbackEvent := false
+	for _, event := range res.GetEvents() {
+		if event.Type != types.EventTypeContractCallback {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeySuccess {
+				callbackEvent = string(attr.Value) == strconv.FormatBool(true)
+			}
+		}
+	}
+	suite.Require().True(callbackEvent)
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+// RegisterInterchainAccount registers an interchain account controlled by the
+// owner on the host chain of a connection. It returns the controller port of
+// the interchain account. The account is created once the channel handshake
+// completes.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, owner sdk.AccAddress, connectionID, version string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner.String())
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.controllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner.String(), version); err != nil {
+		return "", err
+	}
+
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyRegisterAccount)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAccount,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		),
+	)
+
+	return portID, nil
+}
+
+// SendTx sends an interchain account packet to execute the protobuf encoded
+// CosmosTx with the interchain account of the owner on the host chain of a
+// connection. It returns the sequence of the packet.
+func (k Keeper) SendTx(ctx sdk.Context, owner sdk.AccAddress, connectionID string, data []byte, memo string, timeout time.Duration) (uint64, error) {
+	portID, err := icatypes.NewControllerPortID(owner.String())
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+
+	// the channel capability is retrieved by the controller keeper
+	sequence, err := k.controllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeySubmitTx)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitTx,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return sequence, nil
+}
+
+// GetInterchainAccountAddress returns the address of the interchain account of
+// the owner on the host chain of a connection
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, owner sdk.AccAddress, connectionID string) (string, bool) {
+	portID, err := icatypes.NewControllerPortID(owner.String())
+	if err != nil {
+		return "", false
+	}
+
+	return k.controllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+// Keeper of the intertx store
+type Keeper struct {
+	// Protobuf codec
+	cdc codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+	// Store key required for the intertx Prefix KVStore.
+	storeKey         storetypes.StoreKey
+	accountKeeper    types.AccountKeeper
+	controllerKeeper types.ControllerKeeper
+	evmKeeper        types.EVMKeeper
+	erc20Keeper      types.ERC20Keeper
+}
+
+// NewKeeper returns keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ak types.AccountKeeper,
+	controllerKeeper types.ControllerKeeper,
+	evmKeeper types.EVMKeeper,
+	erc20Keeper types.ERC20Keeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	// ensure intertx module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the intertx module account has not been set")
+	}
+
+	return Keeper{
+		storeKey:         storeKey,
+		cdc:              cdc,
+		authority:        authority,
+		accountKeeper:    ak,
+		controllerKeeper: controllerKeeper,
+		evmKeeper:        evmKeeper,
+		erc20Keeper:      erc20Keeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetModuleAccount returns the intertx module account, which is the sender of
+// the contract callbacks
+func (k Keeper) GetModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
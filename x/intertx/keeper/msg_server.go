@@ -0,0 +1,85 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterAccount registers an interchain account controlled by the sender on
+// the host chain of a connection.
+func (k *Keeper) RegisterAccount(goCtx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+
+	portID, err := k.RegisterInterchainAccount(ctx, owner, msg.ConnectionId, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAccountResponse{PortId: portID}, nil
+}
+
+// SubmitTx sends the messages to be executed by the interchain account of the
+// sender on the host chain of a connection.
+func (k *Keeper) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+
+	data, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: msg.Msgs})
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacketData, err.Error())
+	}
+
+	timeout := msg.Timeout
+	if timeout == 0 {
+		timeout = types.DefaultTimeout
+	}
+
+	sequence, err := k.SendTx(ctx, owner, msg.ConnectionId, data, msg.Memo, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTxResponse{Sequence: sequence}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
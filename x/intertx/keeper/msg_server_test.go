@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/x/intertx/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterAccount() {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	// the connection doesn't exist
+	msg := types.NewMsgRegisterAccount(owner, "connection-0", "")
+	_, err := suite.app.InterTxKeeper.RegisterAccount(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestSubmitTx() {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	send := banktypes.NewMsgSend(owner, owner, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+
+	msg, err := types.NewMsgSubmitTx(owner, "connection-0", []sdk.Msg{send})
+	suite.Require().NoError(err)
+	msg.Timeout = time.Minute
+
+	// the interchain account is not registered
+	_, err = suite.app.InterTxKeeper.SubmitTx(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name    string
+		request *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "foobar"},
+			false,
+		},
+		{
+			"pass - valid update params",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(100_000),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.app.InterTxKeeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params, suite.app.InterTxKeeper.GetParams(suite.ctx))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Events emitted by EVM contracts to control their interchain accounts and the
+// callback methods called on the contracts with the result of their packets
+const (
+	ContractEventRegisterAccount = "RegisterInterchainAccount"
+	ContractEventSubmitTx        = "SubmitInterchainAccountTx"
+
+	ContractMethodOnAcknowledgement = "onInterchainAccountAcknowledgement"
+	ContractMethodOnTimeout         = "onInterchainAccountTimeout"
+)
+
+// DefaultTimeout is the default timeout of the interchain account packets,
+// relative to the block time
+const DefaultTimeout = 10 * time.Minute
+
+// interchainAccountsJSON is the JSON ABI of the following Solidity interface:
+//
+//	interface IInterchainAccounts {
+//	    event RegisterInterchainAccount(string connectionId, string version);
+//	    event SubmitInterchainAccountTx(string connectionId, bytes data, string memo, uint64 timeout);
+//
+//	    function onInterchainAccountAcknowledgement(string calldata connectionId, uint64 sequence, bool success, bytes calldata result) external;
+//	    function onInterchainAccountTimeout(string calldata connectionId, uint64 sequence) external;
+//	}
+const interchainAccountsJSON = `[
+	{"type":"event","name":"RegisterInterchainAccount","anonymous":false,"inputs":[
+		{"name":"connectionId","type":"string","indexed":false},
+		{"name":"version","type":"string","indexed":false}
+	]},
+	{"type":"event","name":"SubmitInterchainAccountTx","anonymous":false,"inputs":[
+		{"name":"connectionId","type":"string","indexed":false},
+		{"name":"data","type":"bytes","indexed":false},
+		{"name":"memo","type":"string","indexed":false},
+		{"name":"timeout","type":"uint64","indexed":false}
+	]},
+	{"type":"function","name":"onInterchainAccountAcknowledgement","stateMutability":"nonpayable","outputs":[],"inputs":[
+		{"name":"connectionId","type":"string"},
+		{"name":"sequence","type":"uint64"},
+		{"name":"success","type":"bool"},
+		{"name":"result","type":"bytes"}
+	]},
+	{"type":"function","name":"onInterchainAccountTimeout","stateMutability":"nonpayable","outputs":[],"inputs":[
+		{"name":"connectionId","type":"string"},
+		{"name":"sequence","type":"uint64"}
+	]}
+]`
+
+// InterchainAccountsABI is the ABI of the interchain accounts events and
+// callbacks of the EVM contracts
+var InterchainAccountsABI abi.ABI
+
+func init() {
+	var err error
+	InterchainAccountsABI, err = abi.JSON(strings.NewReader(interchainAccountsJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RegisterAccountEvent defines the arguments of the RegisterInterchainAccount
+// event
+type RegisterAccountEvent struct {
+	ConnectionId string //nolint:revive,stylecheck // ABI argument name
+	Version      string
+}
+
+// SubmitTxEvent defines the arguments of the SubmitInterchainAccountTx event.
+// The data is the protobuf encoded ICS27 CosmosTx to execute and the timeout
+// is in seconds, relative to the block time.
+type SubmitTxEvent struct {
+	ConnectionId string //nolint:revive,stylecheck // ABI argument name
+	Data         []byte
+	Memo         string
+	Timeout      uint64
+}
+
+// Validate performs a stateless validation of the event arguments
+func (e SubmitTxEvent) Validate() error {
+	if err := host.ConnectionIdentifierValidator(e.ConnectionId); err != nil {
+		return err
+	}
+
+	var tx icatypes.CosmosTx
+	if err := tx.Unmarshal(e.Data); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "data is not a protobuf encoded CosmosTx: %s", err)
+	}
+
+	if len(tx.Messages) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "messages cannot be empty")
+	}
+
+	if e.Timeout > uint64(math.MaxInt64/int64(time.Second)) {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "timeout is too large, got %d seconds", e.Timeout)
+	}
+
+	return nil
+}
+
+// GetTimeout returns the timeout of the submitted tx, or the default timeout
+// if not set
+func (e SubmitTxEvent) GetTimeout() time.Duration {
+	if e.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(e.Timeout) * time.Second
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitTxEventValidate(t *testing.T) {
+	data, err := (&icatypes.CosmosTx{
+		Messages: []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{}}},
+	}).Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		event    SubmitTxEvent
+		expError bool
+	}{
+		{"valid event", SubmitTxEvent{ConnectionId: "connection-0", Data: data, Timeout: 60}, false},
+		{"default timeout", SubmitTxEvent{ConnectionId: "connection-0", Data: data}, false},
+		{"invalid connection", SubmitTxEvent{ConnectionId: "c-0", Data: data}, true},
+		{"invalid data", SubmitTxEvent{ConnectionId: "connection-0", Data: []byte{0xff}}, true},
+		{"no messages", SubmitTxEvent{ConnectionId: "connection-0"}, true},
+		{"timeout overflow", SubmitTxEvent{ConnectionId: "connection-0", Data: data, Timeout: math.MaxUint64}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.event.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestSubmitTxEventGetTimeout(t *testing.T) {
+	require.Equal(t, DefaultTimeout, SubmitTxEvent{}.GetTimeout())
+	require.Equal(t, time.Minute, SubmitTxEvent{Timeout: 60}.GetTimeout())
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRegisterAccount{}
+	_ sdk.Msg                            = &MsgSubmitTx{}
+	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitTx{}
+)
+
+const (
+	TypeMsgRegisterAccount = "register_account"
+	TypeMsgSubmitTx        = "submit_tx"
+	TypeMsgUpdateParams    = "update_params"
+)
+
+// NewMsgRegisterAccount creates a new instance of MsgRegisterAccount
+func NewMsgRegisterAccount(owner sdk.AccAddress, connectionID, version string) *MsgRegisterAccount {
+	return &MsgRegisterAccount{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+// Route returns the message route for a MsgRegisterAccount message.
+func (m MsgRegisterAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRegisterAccount message.
+func (m MsgRegisterAccount) Type() string { return TypeMsgRegisterAccount }
+
+// ValidateBasic does a sanity check of the provided data
+func (m MsgRegisterAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	return host.ConnectionIdentifierValidator(m.ConnectionId)
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgRegisterAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgRegisterAccount) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSubmitTx creates a new instance of MsgSubmitTx
+func NewMsgSubmitTx(owner sdk.AccAddress, connectionID string, msgs []sdk.Msg) (*MsgSubmitTx, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitTx{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+		Msgs:         anys,
+	}, nil
+}
+
+// Route returns the message route for a MsgSubmitTx message.
+func (m MsgSubmitTx) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgSubmitTx message.
+func (m MsgSubmitTx) Type() string { return TypeMsgSubmitTx }
+
+// ValidateBasic does a sanity check of the provided data
+func (m MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return err
+	}
+
+	if len(m.Msgs) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "timeout cannot be negative, got %s", m.Timeout)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Msgs)
+}
+
+// GetSignBytes encodes the message for signing. The authz Amino codec is used
+// as the executed messages can be of any SDK module.
+func (m *MsgSubmitTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// Route returns the message route for a MsgUpdateParams message.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams message.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	send := banktypes.NewMsgSend(owner, owner, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+
+	submitTx, err := NewMsgSubmitTx(owner, "connection-0", []sdk.Msg{send})
+	require.NoError(t, err)
+
+	noMsgs := *submitTx
+	noMsgs.Msgs = []*codectypes.Any{}
+
+	negativeTimeout := *submitTx
+	negativeTimeout.Timeout = -time.Second
+
+	invalidConnection := *submitTx
+	invalidConnection.ConnectionId = "c-0"
+
+	testCases := []struct {
+		name     string
+		msg      sdk.Msg
+		expError bool
+	}{
+		{"register account", NewMsgRegisterAccount(owner, "connection-0", ""), false},
+		{"register account - invalid owner", &MsgRegisterAccount{Owner: "evmos1", ConnectionId: "connection-0"}, true},
+		{"register account - invalid connection", NewMsgRegisterAccount(owner, "c-0", ""), true},
+		{"submit tx", submitTx, false},
+		{"submit tx - no messages", &noMsgs, true},
+		{"submit tx - negative timeout", &negativeTimeout, true},
+		{"submit tx - invalid connection", &invalidConnection, true},
+		{"update params", &MsgUpdateParams{Authority: owner.String(), Params: DefaultParams()}, false},
+		{"update params - invalid params", &MsgUpdateParams{Authority: owner.String(), Params: NewParams(0)}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import "fmt"
+
+// DefaultCallbackGasLimit is the default gas limit of the contract callbacks
+const DefaultCallbackGasLimit uint64 = 300_000
+
+// NewParams creates a new Params object
+func NewParams(callbackGasLimit uint64) Params {
+	return Params{
+		CallbackGasLimit: callbackGasLimit,
+	}
+}
+
+// DefaultParams returns default intertx module parameters
+func DefaultParams() Params {
+	return Params{
+		CallbackGasLimit: DefaultCallbackGasLimit,
+	}
+}
+
+// Validate performs a stateless validation of the params fields
+func (p Params) Validate() error {
+	if p.CallbackGasLimit == 0 {
+		return fmt.Errorf("callback gas limit cannot be zero")
+	}
+	return nil
+}
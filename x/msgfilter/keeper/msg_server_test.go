@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v11/x/msgfilter/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(
+		[]string{"/cosmos.bank.v1beta1.MsgSend"},
+		[]types.ContractCallFilter{{Selector: "0xa9059cbb"}},
+	)
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid Update msg",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    params,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, err := suite.app.MsgFilterKeeper.UpdateParams(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Empty(suite.app.MsgFilterKeeper.GetParams(suite.ctx).DisabledMsgTypes)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.MsgFilterKeeper.GetParams(suite.ctx))
+
+				res, err := suite.queryClient.Params(suite.ctx, &types.QueryParamsRequest{})
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, res.Params)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SelectorLength is the length of an EVM method selector
+const SelectorLength = 4
+
+// NewParams creates a new Params object
+func NewParams(disabledMsgTypes []string, disabledContractCalls []ContractCallFilter) Params {
+	return Params{
+		DisabledMsgTypes:      disabledMsgTypes,
+		DisabledContractCalls: disabledContractCalls,
+	}
+}
+
+// DefaultParams returns default msgfilter module parameters, which don't
+// disable any message or contract call
+func DefaultParams() Params {
+	return Params{
+		DisabledMsgTypes:      []string{},
+		DisabledContractCalls: []ContractCallFilter{},
+	}
+}
+
+// Validate performs a stateless validation of the params fields
+func (p Params) Validate() error {
+	seenTypes := make(map[string]bool, len(p.DisabledMsgTypes))
+	for _, typeURL := range p.DisabledMsgTypes {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid message type URL %q, expected format /package.Msg", typeURL)
+		}
+		if seenTypes[typeURL] {
+			return fmt.Errorf("duplicate disabled message type %s", typeURL)
+		}
+		seenTypes[typeURL] = true
+	}
+
+	seenCalls := make(map[ContractCallFilter]bool, len(p.DisabledContractCalls))
+	for _, filter := range p.DisabledContractCalls {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+		key := ContractCallFilter{
+			Contract: strings.ToLower(filter.Contract),
+			Selector: strings.ToLower(filter.Selector),
+		}
+		if seenCalls[key] {
+			return fmt.Errorf("duplicate disabled contract call %s", filter)
+		}
+		seenCalls[key] = true
+	}
+
+	return nil
+}
+
+// IsMsgTypeDisabled returns true if the message type URL is disabled
+func (p Params) IsMsgTypeDisabled(typeURL string) bool {
+	for _, disabled := range p.DisabledMsgTypes {
+		if disabled == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// DisabledContractCall returns the filter that disables the EVM call to the
+// given recipient with the given input data, if any
+func (p Params) DisabledContractCall(to common.Address, data []byte) (ContractCallFilter, bool) {
+	for _, filter := range p.DisabledContractCalls {
+		if filter.Matches(to, data) {
+			return filter, true
+		}
+	}
+	return ContractCallFilter{}, false
+}
+
+// Validate performs a stateless validation of the filter fields
+func (f ContractCallFilter) Validate() error {
+	if f.Contract == "" && f.Selector == "" {
+		return fmt.Errorf("contract call filter must set a contract or a selector")
+	}
+
+	if f.Contract != "" && !common.IsHexAddress(f.Contract) {
+		return fmt.Errorf("invalid contract address %s", f.Contract)
+	}
+
+	if f.Selector != "" {
+		selector, err := hexutil.Decode(f.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid selector %s: %w", f.Selector, err)
+		}
+		if len(selector) != SelectorLength {
+			return fmt.Errorf("invalid selector %s length, expected %d bytes, got %d", f.Selector, SelectorLength, len(selector))
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the EVM call to the given recipient with the given
+// input data matches the filter
+func (f ContractCallFilter) Matches(to common.Address, data []byte) bool {
+	if f.Contract != "" && common.HexToAddress(f.Contract) != to {
+		return false
+	}
+
+	if f.Selector == "" {
+		return true
+	}
+
+	selector, err := hexutil.Decode(f.Selector)
+	if err != nil || len(data) < SelectorLength {
+		return false
+	}
+	return bytes.Equal(selector, data[:SelectorLength])
+}
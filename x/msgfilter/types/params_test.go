@@ -0,0 +1,118 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	contract := "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{
+			"empty params",
+			Params{},
+			false,
+		},
+		{
+			"default params",
+			DefaultParams(),
+			false,
+		},
+		{
+			"custom params",
+			NewParams(
+				[]string{"/cosmos.bank.v1beta1.MsgSend"},
+				[]ContractCallFilter{{Contract: contract}, {Selector: "0xa9059cbb"}, {Contract: contract, Selector: "0xa9059cbb"}},
+			),
+			false,
+		},
+		{
+			"invalid message type URL",
+			NewParams([]string{"cosmos.bank.v1beta1.MsgSend"}, nil),
+			true,
+		},
+		{
+			"empty message type URL",
+			NewParams([]string{"/"}, nil),
+			true,
+		},
+		{
+			"duplicate message type URL",
+			NewParams([]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}, nil),
+			true,
+		},
+		{
+			"empty contract call filter",
+			NewParams(nil, []ContractCallFilter{{}}),
+			true,
+		},
+		{
+			"invalid contract address",
+			NewParams(nil, []ContractCallFilter{{Contract: "evmos1"}}),
+			true,
+		},
+		{
+			"invalid selector hex",
+			NewParams(nil, []ContractCallFilter{{Selector: "a9059cbb"}}),
+			true,
+		},
+		{
+			"invalid selector length",
+			NewParams(nil, []ContractCallFilter{{Selector: "0xa9059c"}}),
+			true,
+		},
+		{
+			"duplicate contract call filter",
+			NewParams(nil, []ContractCallFilter{{Contract: contract}, {Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7"}}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestContractCallFilterMatches(t *testing.T) {
+	contract := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	transfer := common.FromHex("0xa9059cbb0000")
+
+	testCases := []struct {
+		name     string
+		filter   ContractCallFilter
+		to       common.Address
+		data     []byte
+		expMatch bool
+	}{
+		{"contract - match", ContractCallFilter{Contract: contract.Hex()}, contract, nil, true},
+		{"contract - other contract", ContractCallFilter{Contract: contract.Hex()}, other, transfer, false},
+		{"selector - match any contract", ContractCallFilter{Selector: "0xa9059cbb"}, other, transfer, true},
+		{"selector - other selector", ContractCallFilter{Selector: "0x095ea7b3"}, contract, transfer, false},
+		{"selector - short input data", ContractCallFilter{Selector: "0xa9059cbb"}, contract, transfer[:3], false},
+		{"contract and selector - match", ContractCallFilter{Contract: contract.Hex(), Selector: "0xa9059cbb"}, contract, transfer, true},
+		{"contract and selector - other contract", ContractCallFilter{Contract: contract.Hex(), Selector: "0xa9059cbb"}, other, transfer, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, tc.filter.Matches(tc.to, tc.data), tc.name)
+	}
+}
+
+func TestParamsIsMsgTypeDisabled(t *testing.T) {
+	params := NewParams([]string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+	require.True(t, params.IsMsgTypeDisabled("/cosmos.bank.v1beta1.MsgSend"))
+	require.False(t, params.IsMsgTypeDisabled("/cosmos.bank.v1beta1.MsgMultiSend"))
+}
@@ -0,0 +1,182 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+// flagUserSpendLimit defines the per-user spend limit flag of the
+// set-paymaster command
+const flagUserSpendLimit = "user-spend-limit"
+
+// NewTxCmd returns a root CLI command handler for certain modules/paymaster
+// transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "paymaster subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSetPaymasterCmd(),
+		NewDepositCmd(),
+		NewWithdrawCmd(),
+	)
+	return txCmd
+}
+
+// NewSetPaymasterCmd returns a CLI command handler for creating or updating
+// the paymaster of the sender
+func NewSetPaymasterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-paymaster CONTRACT_HEX[:SELECTOR_HEX]...",
+		Short: "Create or update the paymaster of the sender",
+		Long:  "Create or update the paymaster of the sender, replacing its targets. A target sponsors all the calls to a contract, or only the calls to a method if a 4-byte selector is provided.\nThe user spend limit caps the total amount sponsored to each user.",
+		Example: fmt.Sprintf(
+			"%s tx %s set-paymaster 0xCc2D8f14dA1e2cC31ff6de8a8dC9Ab36f8b6A9E2:0xa9059cbb --%s 1000000000000000000",
+			version.AppName, types.ModuleName, flagUserSpendLimit,
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			targets := make([]types.Target, len(args))
+			for i, arg := range args {
+				contract, selector, _ := strings.Cut(arg, ":")
+				targets[i] = types.Target{Contract: contract, Selector: selector}
+			}
+
+			limitStr, err := cmd.Flags().GetString(flagUserSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			limit, ok := math.NewIntFromString(limitStr)
+			if !ok {
+				return fmt.Errorf("invalid user spend limit %s", limitStr)
+			}
+
+			msg := &types.MsgSetPaymaster{
+				Owner:          cliCtx.GetFromAddress().String(),
+				Targets:        targets,
+				UserSpendLimit: limit,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagUserSpendLimit, "", "Maximum amount sponsored to each user")
+	flags.AddTxFlagsToCmd(cmd)
+	if err := cmd.MarkFlagRequired(flagUserSpendLimit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewDepositCmd returns a CLI command handler for funding a paymaster
+func NewDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit OWNER_BECH32 AMOUNT",
+		Short: "Deposit funds to the balance of a paymaster",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeposit{
+				Depositor: cliCtx.GetFromAddress().String(),
+				Owner:     args[0],
+				Amount:    amount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawCmd returns a CLI command handler for withdrawing funds from the
+// paymaster of the sender
+func NewWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw AMOUNT",
+		Short: "Withdraw funds from the balance of the sender's paymaster",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdraw{
+				Owner:  cliCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
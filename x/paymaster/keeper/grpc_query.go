@@ -0,0 +1,133 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Paymasters returns all registered paymasters
+func (k Keeper) Paymasters(
+	c context.Context,
+	req *types.QueryPaymastersRequest,
+) (*types.QueryPaymastersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var paymasters []types.Paymaster
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPaymaster)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var paymaster types.Paymaster
+		if err := k.cdc.Unmarshal(value, &paymaster); err != nil {
+			return err
+		}
+		paymasters = append(paymasters, paymaster)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPaymastersResponse{
+		Paymasters: paymasters,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Paymaster returns the paymaster of an owner, including its balance
+func (k Keeper) Paymaster(
+	c context.Context,
+	req *types.QueryPaymasterRequest,
+) (*types.QueryPaymasterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", req.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	paymaster, found := k.GetPaymaster(ctx, owner)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "paymaster of owner %s", req.Owner)
+	}
+
+	return &types.QueryPaymasterResponse{Paymaster: paymaster}, nil
+}
+
+// UserSpend returns the amount a paymaster has sponsored to a user and the
+// amount it can still sponsor
+func (k Keeper) UserSpend(
+	c context.Context,
+	req *types.QueryUserSpendRequest,
+) (*types.QueryUserSpendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", req.Owner)
+	}
+
+	user, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user address %s", req.User)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	paymaster, found := k.GetPaymaster(ctx, owner)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "paymaster of owner %s", req.Owner)
+	}
+
+	spent := k.GetUserSpend(ctx, owner, user)
+	return &types.QueryUserSpendResponse{
+		Spent:     spent,
+		Remaining: paymaster.Remaining(spent),
+	}, nil
+}
+
+// Params returns the paymaster module parameters
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+func (suite *KeeperTestSuite) TestQueries() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	user := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	paymaster := types.NewPaymaster(owner, []types.Target{{Contract: tests.GenerateAddress().Hex()}}, sdk.NewInt(50))
+	paymaster.Balance = sdk.NewInt(100)
+	suite.setupPaymaster(paymaster)
+	suite.app.PaymasterKeeper.SetUserSpend(suite.ctx, owner, user, sdk.NewInt(20))
+
+	paymastersRes, err := suite.queryClient.Paymasters(ctx, &types.QueryPaymastersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Paymaster{paymaster}, paymastersRes.Paymasters)
+
+	paymasterRes, err := suite.queryClient.Paymaster(ctx, &types.QueryPaymasterRequest{Owner: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(paymaster, paymasterRes.Paymaster)
+
+	_, err = suite.queryClient.Paymaster(ctx, &types.QueryPaymasterRequest{Owner: user.String()})
+	suite.Require().Error(err)
+
+	spendRes, err := suite.queryClient.UserSpend(ctx, &types.QueryUserSpendRequest{Owner: owner.String(), User: user.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(20), spendRes.Spent)
+	suite.Require().Equal(sdk.NewInt(30), spendRes.Remaining)
+
+	_, err = suite.queryClient.UserSpend(ctx, &types.QueryUserSpendRequest{Owner: owner.String(), User: "evmos1"})
+	suite.Require().Error(err)
+
+	paramsRes, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+
+	// the exported user spends can be imported back
+	spends := suite.app.PaymasterKeeper.GetUserSpends(suite.ctx)
+	suite.Require().Equal([]types.UserSpend{{Paymaster: owner.String(), User: user.String(), Spent: sdk.NewInt(20)}}, spends)
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+// RegisterInvariants registers the paymaster module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "balances", k.BalancesInvariant())
+}
+
+// BalancesInvariant checks that the paymaster module account holds at least
+// the sum of all paymaster balances
+func (k Keeper) BalancesInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (msg string, broken bool) {
+		total := math.ZeroInt()
+		k.IteratePaymasters(ctx, func(paymaster types.Paymaster) (stop bool) {
+			total = total.Add(paymaster.Balance)
+			return false
+		})
+
+		denom := k.evmKeeper.GetParams(ctx).EvmDenom
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddr, denom)
+
+		broken = balance.Amount.LT(total)
+		msg = sdk.FormatInvariant(
+			types.ModuleName,
+			"balances",
+			fmt.Sprintf(
+				"\tsum of paymaster balances: %s\n"+
+					"\tmodule account balance: %s\n",
+				total, balance.Amount,
+			),
+		)
+
+		return msg, broken
+	}
+}
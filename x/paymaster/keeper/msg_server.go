@@ -0,0 +1,166 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// SetPaymaster creates the paymaster of the sender or updates its targets and
+// user spend limit, keeping the deposited balance.
+func (k *Keeper) SetPaymaster(goCtx context.Context, msg *types.MsgSetPaymaster) (*types.MsgSetPaymasterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetParams(ctx).EnablePaymaster {
+		return nil, types.ErrPaymasterDisabled
+	}
+
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	paymaster := types.NewPaymaster(owner, msg.Targets, msg.UserSpendLimit)
+	if previous, found := k.GetPaymaster(ctx, owner); found {
+		paymaster.Balance = previous.Balance
+	}
+
+	k.StorePaymaster(ctx, paymaster)
+
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeySetPaymaster)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPaymaster,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		),
+	)
+
+	return &types.MsgSetPaymasterResponse{}, nil
+}
+
+// Deposit funds the balance of a paymaster. Anyone can fund any paymaster.
+func (k *Keeper) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetParams(ctx).EnablePaymaster {
+		return nil, types.ErrPaymasterDisabled
+	}
+
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	paymaster, found := k.GetPaymaster(ctx, owner)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPaymasterNotFound, "owner %s", msg.Owner)
+	}
+
+	if err := k.validateDenom(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	depositor := sdk.MustAccAddressFromBech32(msg.Depositor)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.Coins{msg.Amount}); err != nil {
+		return nil, err
+	}
+
+	k.setBalance(ctx, paymaster, paymaster.Balance.Add(msg.Amount.Amount))
+
+	defer func() {
+		evmostelemetry.IncrAmount(types.MetricKeyDepositAmount, msg.Amount.Amount, evmostelemetry.NewDenomLabel(msg.Amount.Denom))
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeposit,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgDepositResponse{}, nil
+}
+
+// Withdraw returns funds of a paymaster balance to its owner. Withdrawals are
+// allowed while the module is disabled so that deposits can't be locked.
+func (k *Keeper) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	paymaster, found := k.GetPaymaster(ctx, owner)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPaymasterNotFound, "owner %s", msg.Owner)
+	}
+
+	if err := k.validateDenom(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if paymaster.Balance.LT(msg.Amount.Amount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientBalance,
+			"balance %s is smaller than %s", paymaster.Balance, msg.Amount.Amount,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.Coins{msg.Amount}); err != nil {
+		return nil, err
+	}
+
+	k.setBalance(ctx, paymaster, paymaster.Balance.Sub(msg.Amount.Amount))
+
+	defer func() {
+		evmostelemetry.IncrAmount(types.MetricKeyWithdrawAmount, msg.Amount.Amount, evmostelemetry.NewDenomLabel(msg.Amount.Denom))
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdraw,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawResponse{}, nil
+}
+
+// UpdateParams updates the paymaster module parameters
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateDenom checks that the coin is denominated in the EVM denom, which is
+// the only denom paymasters can pay gas with
+func (k Keeper) validateDenom(ctx sdk.Context, coin sdk.Coin) error {
+	if evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom; coin.Denom != evmDenom {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", evmDenom, coin.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/testutil"
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+func (suite *KeeperTestSuite) TestSetPaymaster() {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	contractA := tests.GenerateAddress()
+	contractB := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - module disabled",
+			func() {
+				err := suite.app.PaymasterKeeper.SetParams(suite.ctx, types.NewParams(false))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"pass - paymaster created",
+			func() {},
+			true,
+		},
+		{
+			"pass - paymaster updated, keeping its balance",
+			func() {
+				paymaster := types.NewPaymaster(owner, []types.Target{{Contract: contractA.Hex()}}, sdk.NewInt(100))
+				paymaster.Balance = sdk.NewInt(100)
+				suite.setupPaymaster(paymaster)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			msg := &types.MsgSetPaymaster{
+				Owner:          owner.String(),
+				Targets:        []types.Target{{Contract: contractB.Hex()}},
+				UserSpendLimit: sdk.NewInt(50),
+			}
+			_, err := suite.app.PaymasterKeeper.SetPaymaster(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			paymaster, found := suite.app.PaymasterKeeper.GetPaymaster(suite.ctx, owner)
+			suite.Require().True(found)
+			suite.Require().Equal(msg.Targets, paymaster.Targets)
+			suite.Require().Equal(msg.UserSpendLimit, paymaster.UserSpendLimit)
+
+			// the target index only holds the current targets
+			suite.Require().Empty(suite.app.PaymasterKeeper.GetContractPaymasters(suite.ctx, contractA))
+			suite.Require().Equal([]sdk.AccAddress{owner}, suite.app.PaymasterKeeper.GetContractPaymasters(suite.ctx, contractB))
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.app.AccountKeeper.GetModuleAddress(types.ModuleName), evmDenom)
+			suite.Require().Equal(balance.Amount, paymaster.Balance)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDepositWithdraw() {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	depositor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		msg        sdk.Msg
+		expPass    bool
+		expBalance int64
+	}{
+		{
+			"fail - deposit to unknown paymaster",
+			func() {},
+			&types.MsgDeposit{Depositor: depositor.String(), Owner: owner.String(), Amount: sdk.NewInt64Coin(evmDenom, 10)},
+			false,
+			0,
+		},
+		{
+			"fail - deposit of invalid denom",
+			func() {
+				suite.setupPaymaster(types.NewPaymaster(owner, nil, sdk.NewInt(100)))
+			},
+			&types.MsgDeposit{Depositor: depositor.String(), Owner: owner.String(), Amount: sdk.NewInt64Coin("stake", 10)},
+			false,
+			0,
+		},
+		{
+			"pass - deposit",
+			func() {
+				suite.setupPaymaster(types.NewPaymaster(owner, nil, sdk.NewInt(100)))
+			},
+			&types.MsgDeposit{Depositor: depositor.String(), Owner: owner.String(), Amount: sdk.NewInt64Coin(evmDenom, 10)},
+			true,
+			10,
+		},
+		{
+			"fail - withdraw more than the balance",
+			func() {
+				paymaster := types.NewPaymaster(owner, nil, sdk.NewInt(100))
+				paymaster.Balance = sdk.NewInt(10)
+				suite.setupPaymaster(paymaster)
+			},
+			&types.MsgWithdraw{Owner: owner.String(), Amount: sdk.NewInt64Coin(evmDenom, 11)},
+			false,
+			10,
+		},
+		{
+			"pass - withdraw while the module is disabled",
+			func() {
+				paymaster := types.NewPaymaster(owner, nil, sdk.NewInt(100))
+				paymaster.Balance = sdk.NewInt(10)
+				suite.setupPaymaster(paymaster)
+				err := suite.app.PaymasterKeeper.SetParams(suite.ctx, types.NewParams(false))
+				suite.Require().NoError(err)
+			},
+			&types.MsgWithdraw{Owner: owner.String(), Amount: sdk.NewInt64Coin(evmDenom, 4)},
+			true,
+			6,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, depositor, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 10), sdk.NewInt64Coin("stake", 10)))
+			suite.Require().NoError(err)
+			tc.malleate()
+
+			handler := suite.app.MsgServiceRouter().Handler(tc.msg)
+			_, err = handler(suite.ctx, tc.msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			paymaster, found := suite.app.PaymasterKeeper.GetPaymaster(suite.ctx, owner)
+			if found {
+				suite.Require().Equal(sdk.NewInt(tc.expBalance), paymaster.Balance)
+			}
+
+			_, broken := suite.app.PaymasterKeeper.BalancesInvariant()(suite.ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "pass - valid Update msg",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(false),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, err := suite.app.PaymasterKeeper.UpdateParams(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(types.DefaultParams(), suite.app.PaymasterKeeper.GetParams(suite.ctx))
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params, suite.app.PaymasterKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
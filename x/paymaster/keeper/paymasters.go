@@ -0,0 +1,182 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+// GetPaymasters returns all registered paymasters.
+func (k Keeper) GetPaymasters(ctx sdk.Context) []types.Paymaster {
+	paymasters := []types.Paymaster{}
+
+	k.IteratePaymasters(ctx, func(paymaster types.Paymaster) (stop bool) {
+		paymasters = append(paymasters, paymaster)
+		return false
+	})
+
+	return paymasters
+}
+
+// IteratePaymasters iterates over all registered paymasters and performs a
+// callback with the corresponding Paymaster.
+func (k Keeper) IteratePaymasters(
+	ctx sdk.Context,
+	handlerFn func(paymaster types.Paymaster) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPaymaster)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var paymaster types.Paymaster
+		k.cdc.MustUnmarshal(iterator.Value(), &paymaster)
+
+		if handlerFn(paymaster) {
+			break
+		}
+	}
+}
+
+// GetPaymaster returns the paymaster of an owner
+func (k Keeper) GetPaymaster(ctx sdk.Context, owner sdk.AccAddress) (types.Paymaster, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPaymaster)
+	bz := store.Get(owner.Bytes())
+	if len(bz) == 0 {
+		return types.Paymaster{}, false
+	}
+
+	var paymaster types.Paymaster
+	k.cdc.MustUnmarshal(bz, &paymaster)
+	return paymaster, true
+}
+
+// StorePaymaster stores a paymaster and keeps the index of the contracts it
+// sponsors in sync with its targets.
+func (k Keeper) StorePaymaster(ctx sdk.Context, paymaster types.Paymaster) {
+	owner := paymaster.GetOwnerAddress()
+	if previous, found := k.GetPaymaster(ctx, owner); found {
+		for _, target := range previous.Targets {
+			k.deleteTargetIndex(ctx, target.GetContractAddress(), owner)
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPaymaster)
+	store.Set(owner.Bytes(), k.cdc.MustMarshal(&paymaster))
+
+	for _, target := range paymaster.Targets {
+		k.setTargetIndex(ctx, target.GetContractAddress(), owner)
+	}
+}
+
+// setBalance updates the balance of a paymaster without touching its targets.
+func (k Keeper) setBalance(ctx sdk.Context, paymaster types.Paymaster, balance math.Int) {
+	paymaster.Balance = balance
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPaymaster)
+	store.Set(paymaster.GetOwnerAddress().Bytes(), k.cdc.MustMarshal(&paymaster))
+}
+
+// GetContractPaymasters returns the owners of the paymasters that sponsor calls
+// to the given contract, in the byte order of their addresses.
+func (k Keeper) GetContractPaymasters(ctx sdk.Context, contract common.Address) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTargetPrefix(contract))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var owners []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		owners = append(owners, sdk.AccAddress(iterator.Key()))
+	}
+
+	return owners
+}
+
+func (k Keeper) setTargetIndex(ctx sdk.Context, contract common.Address, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTargetPrefix(contract))
+	store.Set(owner.Bytes(), []byte{1})
+}
+
+func (k Keeper) deleteTargetIndex(ctx sdk.Context, contract common.Address, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTargetPrefix(contract))
+	store.Delete(owner.Bytes())
+}
+
+// GetUserSpend returns the amount a paymaster has sponsored to a user
+func (k Keeper) GetUserSpend(ctx sdk.Context, owner, user sdk.AccAddress) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUserSpendPrefix(owner))
+	bz := store.Get(user.Bytes())
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	var spent math.Int
+	if err := spent.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return spent
+}
+
+// SetUserSpend stores the amount a paymaster has sponsored to a user. A zero
+// amount is deleted.
+func (k Keeper) SetUserSpend(ctx sdk.Context, owner, user sdk.AccAddress, spent math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUserSpendPrefix(owner))
+	if spent.IsZero() {
+		store.Delete(user.Bytes())
+		return
+	}
+
+	bz, err := spent.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(user.Bytes(), bz)
+}
+
+// GetUserSpends returns the sponsored amounts of all paymasters and users.
+func (k Keeper) GetUserSpends(ctx sdk.Context) []types.UserSpend {
+	userSpends := []types.UserSpend{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixUserSpend)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is prefix | len(owner) | owner | user
+		key := iterator.Key()[len(types.KeyPrefixUserSpend):]
+		ownerLen := int(key[0])
+		owner := sdk.AccAddress(key[1 : 1+ownerLen])
+		user := sdk.AccAddress(key[1+ownerLen:])
+
+		var spent math.Int
+		if err := spent.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		userSpends = append(userSpends, types.UserSpend{
+			Paymaster: owner.String(),
+			User:      user.String(),
+			Spent:     spent,
+		})
+	}
+
+	return userSpends
+}
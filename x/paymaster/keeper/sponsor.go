@@ -0,0 +1,214 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+// Sponsor looks for a paymaster that sponsors the EVM call of the user to the
+// given contract and input data, and records it as the payer of the fee of the
+// transaction being processed. Paymasters are checked in the byte order of
+// their owner addresses and the first one whose targets match, with enough
+// balance and whose spend limit for the user covers the maximum fee of the
+// transaction, is selected. Any previous sponsorship of the user is discarded.
+// It returns false if no paymaster sponsors the call.
+func (k Keeper) Sponsor(
+	ctx sdk.Context,
+	user sdk.AccAddress,
+	to common.Address,
+	data []byte,
+	gasLimit uint64,
+	feeCap math.Int,
+) bool {
+	k.DeleteSponsorship(ctx, user)
+
+	if !feeCap.IsPositive() || !k.GetParams(ctx).EnablePaymaster {
+		return false
+	}
+
+	for _, owner := range k.GetContractPaymasters(ctx, to) {
+		paymaster, found := k.GetPaymaster(ctx, owner)
+		if !found || !paymaster.Matches(to, data) {
+			continue
+		}
+
+		spent := k.GetUserSpend(ctx, owner, user)
+		if paymaster.Remaining(spent).LT(feeCap) {
+			continue
+		}
+
+		k.SetSponsorship(ctx, user, types.Sponsorship{
+			Paymaster: paymaster.Owner,
+			Contract:  to.Hex(),
+			GasLimit:  gasLimit,
+			FeeCap:    feeCap,
+			Fee:       math.ZeroInt(),
+		})
+		return true
+	}
+
+	return false
+}
+
+// ChargeSponsoredFee deducts the fee of the transaction sponsored to the user
+// from the balance of the paymaster and sends it to the fee collector, in place
+// of the user. The fee is added to the amount sponsored to the user.
+func (k Keeper) ChargeSponsoredFee(ctx sdk.Context, user sdk.AccAddress, fees sdk.Coins) error {
+	sponsorship, found := k.GetSponsorship(ctx, user)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNotSponsored, "user %s", user)
+	}
+
+	denom := k.evmKeeper.GetParams(ctx).EvmDenom
+	fee := fees.AmountOf(denom)
+	if len(fees) > 1 || fee.GT(sponsorship.FeeCap) {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "fees %s don't match the sponsored fee cap %s%s", fees, sponsorship.FeeCap, denom)
+	}
+
+	owner := sdk.MustAccAddressFromBech32(sponsorship.Paymaster)
+	paymaster, found := k.GetPaymaster(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPaymasterNotFound, "owner %s", sponsorship.Paymaster)
+	}
+
+	spent := k.GetUserSpend(ctx, owner, user)
+	if paymaster.Remaining(spent).LT(fee) {
+		return errorsmod.Wrapf(types.ErrInsufficientBalance, "cannot sponsor %s%s", fee, denom)
+	}
+
+	coins := sdk.Coins{{Denom: denom, Amount: fee}}
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins); err != nil {
+			return err
+		}
+	}
+
+	k.setBalance(ctx, paymaster, paymaster.Balance.Sub(fee))
+	k.SetUserSpend(ctx, owner, user, spent.Add(fee))
+
+	sponsorship.Fee = fee
+	k.SetSponsorship(ctx, user, sponsorship)
+
+	defer func() {
+		labels := []metrics.Label{
+			evmostelemetry.NewDenomLabel(denom),
+		}
+		evmostelemetry.IncrCounter(types.MetricKeySponsor, labels...)
+		evmostelemetry.IncrAmount(types.MetricKeySponsorAmount, fee, labels...)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsorTx,
+			sdk.NewAttribute(types.AttributeKeyOwner, paymaster.Owner),
+			sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundSponsoredGas returns to the paymaster the refund of the gas left
+// unused by the transaction sponsored to the user, that the EVM module sent to
+// the user, and deletes the sponsorship. The refund is deducted from the
+// amount sponsored to the user.
+func (k Keeper) RefundSponsoredGas(ctx sdk.Context, user sdk.AccAddress, gasUsed uint64) error {
+	sponsorship, found := k.GetSponsorship(ctx, user)
+	if !found {
+		return nil
+	}
+
+	k.DeleteSponsorship(ctx, user)
+
+	if !sponsorship.Fee.IsPositive() || gasUsed >= sponsorship.GasLimit {
+		return nil
+	}
+
+	owner := sdk.MustAccAddressFromBech32(sponsorship.Paymaster)
+	paymaster, found := k.GetPaymaster(ctx, owner)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPaymasterNotFound, "owner %s", sponsorship.Paymaster)
+	}
+
+	// the fee was charged at the effective gas price, which is also used for
+	// the refund
+	refund := sponsorship.Fee.
+		Mul(math.NewIntFromUint64(sponsorship.GasLimit - gasUsed)).
+		Quo(math.NewIntFromUint64(sponsorship.GasLimit))
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	denom := k.evmKeeper.GetParams(ctx).EvmDenom
+	coins := sdk.Coins{{Denom: denom, Amount: refund}}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, user, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	k.setBalance(ctx, paymaster, paymaster.Balance.Add(refund))
+	k.SetUserSpend(ctx, owner, user, math.MaxInt(k.GetUserSpend(ctx, owner, user).Sub(refund), math.ZeroInt()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundGas,
+			sdk.NewAttribute(types.AttributeKeyOwner, paymaster.Owner),
+			sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetSponsorship returns the sponsorship of the transaction being processed
+// for the user, if any
+func (k Keeper) GetSponsorship(ctx sdk.Context, user sdk.AccAddress) (types.Sponsorship, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSponsorship)
+	bz := store.Get(user.Bytes())
+	if len(bz) == 0 {
+		return types.Sponsorship{}, false
+	}
+
+	var sponsorship types.Sponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// SetSponsorship stores the sponsorship of the transaction being processed
+// for the user
+func (k Keeper) SetSponsorship(ctx sdk.Context, user sdk.AccAddress, sponsorship types.Sponsorship) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSponsorship)
+	store.Set(user.Bytes(), k.cdc.MustMarshal(&sponsorship))
+}
+
+// DeleteSponsorship removes the sponsorship of the user
+func (k Keeper) DeleteSponsorship(ctx sdk.Context, user sdk.AccAddress) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSponsorship)
+	store.Delete(user.Bytes())
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/testutil"
+	"github.com/evmos/evmos/v11/x/paymaster/types"
+)
+
+func (suite *KeeperTestSuite) TestSponsor() {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	user := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	contract := tests.GenerateAddress()
+	transfer := common.FromHex("0xa9059cbb0000")
+	feeCap := sdk.NewInt(10)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		to         common.Address
+		expSponsor bool
+	}{
+		{
+			"no-op - module disabled",
+			func() {
+				err := suite.app.PaymasterKeeper.SetParams(suite.ctx, types.NewParams(false))
+				suite.Require().NoError(err)
+			},
+			contract,
+			false,
+		},
+		{
+			"no-op - other contract",
+			func() {},
+			tests.GenerateAddress(),
+			false,
+		},
+		{
+			"no-op - user spend limit exceeded",
+			func() {
+				suite.app.PaymasterKeeper.SetUserSpend(suite.ctx, owner, user, sdk.NewInt(45))
+			},
+			contract,
+			false,
+		},
+		{
+			"no-op - stale sponsorship discarded",
+			func() {
+				suite.app.PaymasterKeeper.SetSponsorship(suite.ctx, user, types.Sponsorship{Paymaster: owner.String(), FeeCap: feeCap, Fee: sdk.ZeroInt()})
+			},
+			tests.GenerateAddress(),
+			false,
+		},
+		{
+			"pass - sponsored",
+			func() {
+				suite.app.PaymasterKeeper.SetUserSpend(suite.ctx, owner, user, sdk.NewInt(40))
+			},
+			contract,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			paymaster := types.NewPaymaster(owner, []types.Target{{Contract: contract.Hex(), Selector: "0xa9059cbb"}}, sdk.NewInt(50))
+			paymaster.Balance = sdk.NewInt(100)
+			suite.setupPaymaster(paymaster)
+			tc.malleate()
+			spent := suite.app.PaymasterKeeper.GetUserSpend(suite.ctx, owner, user)
+
+			sponsored := suite.app.PaymasterKeeper.Sponsor(suite.ctx, user, tc.to, transfer, 10, feeCap)
+			suite.Require().Equal(tc.expSponsor, sponsored)
+
+			// the fee is only charged when deducted by the AnteHandler
+			paymaster, _ = suite.app.PaymasterKeeper.GetPaymaster(suite.ctx, owner)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, user, evmDenom).IsZero())
+			suite.Require().Equal(sdk.NewInt(100), paymaster.Balance)
+			suite.Require().Equal(spent, suite.app.PaymasterKeeper.GetUserSpend(suite.ctx, owner, user))
+
+			sponsorship, found := suite.app.PaymasterKeeper.GetSponsorship(suite.ctx, user)
+			suite.Require().Equal(tc.expSponsor, found)
+			if tc.expSponsor {
+				suite.Require().Equal(owner.String(), sponsorship.Paymaster)
+				suite.Require().Equal(contract.Hex(), sponsorship.Contract)
+				suite.Require().Equal(uint64(10), sponsorship.GasLimit)
+				suite.Require().Equal(feeCap, sponsorship.FeeCap)
+				suite.Require().True(sponsorship.Fee.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChargeSponsoredFee() {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	user := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		sponsor  bool
+		fees     sdk.Coins
+		expError bool
+	}{
+		{
+			"fail - not sponsored",
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 10)),
+			true,
+		},
+		{
+			"fail - fee exceeds the fee cap",
+			true,
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 21)),
+			true,
+		},
+		{
+			"fail - other denomination",
+			true,
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 10), sdk.NewInt64Coin("atoken", 10)),
+			true,
+		},
+		{
+			"pass - fee charged to the paymaster",
+			true,
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 10)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			paymaster := types.NewPaymaster(owner, []types.Target{{Contract: contract.Hex()}}, sdk.NewInt(50))
+			paymaster.Balance = sdk.NewInt(100)
+			suite.setupPaymaster(paymaster)
+			if tc.sponsor {
+				suite.Require().True(suite.app.PaymasterKeeper.Sponsor(suite.ctx, user, contract, nil, 10, sdk.NewInt(20)))
+			}
+
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			collected := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, evmDenom)
+
+			err := suite.app.PaymasterKeeper.ChargeSponsoredFee(suite.ctx, user, tc.fees)
+			paymaster, _ = suite.app.PaymasterKeeper.GetPaymaster(suite.ctx, owner)
+			if tc.expError {
+				suite.Require().Error(err)
+				suite.Require().Equal(sdk.NewInt(100), paymaster.Balance)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(90), paymaster.Balance)
+			suite.Require().Equal(sdk.NewInt(10), suite.app.PaymasterKeeper.GetUserSpend(suite.ctx, owner, user))
+			suite.Require().Equal(collected.AddAmount(sdk.NewInt(10)), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, evmDenom))
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, user, evmDenom).IsZero())
+
+			sponsorship, found := suite.app.PaymasterKeeper.GetSponsorship(suite.ctx, user)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewInt(10), sponsorship.Fee)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundSponsoredGas() {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	user := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		name       string
+		gasUsed    uint64
+		expRefund  int64
+		expBalance int64
+	}{
+		{"no refund - all gas used", 10, 0, 90},
+		{"pass - unused gas refunded", 6, 4, 94},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			paymaster := types.NewPaymaster(owner, []types.Target{{Contract: contract.Hex()}}, sdk.NewInt(50))
+			paymaster.Balance = sdk.NewInt(100)
+			suite.setupPaymaster(paymaster)
+			suite.Require().True(suite.app.PaymasterKeeper.Sponsor(suite.ctx, user, contract, nil, 10, sdk.NewInt(20)))
+			err := suite.app.PaymasterKeeper.ChargeSponsoredFee(suite.ctx, user, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 10)))
+			suite.Require().NoError(err)
+
+			// the EVM module refunds the unused gas to the user
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, user, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, tc.expRefund)))
+			suite.Require().NoError(err)
+
+			err = suite.app.PaymasterKeeper.RefundSponsoredGas(suite.ctx, user, tc.gasUsed)
+			suite.Require().NoError(err)
+
+			paymaster, _ = suite.app.PaymasterKeeper.GetPaymaster(suite.ctx, owner)
+			suite.Require().Equal(sdk.NewInt(tc.expBalance), paymaster.Balance)
+			suite.Require().Equal(sdk.NewInt(10-tc.expRefund), suite.app.PaymasterKeeper.GetUserSpend(suite.ctx, owner, user))
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, user, evmDenom).IsZero())
+
+			_, found := suite.app.PaymasterKeeper.GetSponsorship(suite.ctx, user)
+			suite.Require().False(found)
+		})
+	}
+}
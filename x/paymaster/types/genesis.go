@@ -0,0 +1,68 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, paymasters []Paymaster, userSpends []UserSpend) GenesisState {
+	return GenesisState{
+		Params:     params,
+		Paymasters: paymasters,
+		UserSpends: userSpends,
+	}
+}
+
+// DefaultGenesisState sets default paymaster genesis state with default params
+// and no paymasters
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenPaymasters := make(map[string]bool)
+	for _, paymaster := range gs.Paymasters {
+		if seenPaymasters[paymaster.Owner] {
+			return fmt.Errorf("duplicate paymaster %s", paymaster.Owner)
+		}
+		if err := paymaster.Validate(); err != nil {
+			return err
+		}
+		seenPaymasters[paymaster.Owner] = true
+	}
+
+	seenSpends := make(map[string]bool)
+	for _, spend := range gs.UserSpends {
+		if !seenPaymasters[spend.Paymaster] {
+			return fmt.Errorf("user spend of unknown paymaster %s", spend.Paymaster)
+		}
+		key := spend.Paymaster + "/" + spend.User
+		if seenSpends[key] {
+			return fmt.Errorf("duplicate user spend %s", key)
+		}
+		if err := spend.Validate(); err != nil {
+			return err
+		}
+		seenSpends[key] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	user := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	paymaster := NewPaymaster(owner, []Target{{Contract: tests.GenerateAddress().Hex()}}, math.NewInt(100))
+	spend := UserSpend{Paymaster: owner.String(), User: user.String(), Spent: math.NewInt(10)}
+
+	testCases := []struct {
+		name     string
+		genesis  GenesisState
+		expError bool
+	}{
+		{"default genesis", *DefaultGenesisState(), false},
+		{"valid genesis", NewGenesisState(DefaultParams(), []Paymaster{paymaster}, []UserSpend{spend}), false},
+		{"duplicate paymaster", NewGenesisState(DefaultParams(), []Paymaster{paymaster, paymaster}, nil), true},
+		{"unknown paymaster", NewGenesisState(DefaultParams(), nil, []UserSpend{spend}), true},
+		{"duplicate user spend", NewGenesisState(DefaultParams(), []Paymaster{paymaster}, []UserSpend{spend, spend}), true},
+		{
+			"zero user spend",
+			NewGenesisState(DefaultParams(), []Paymaster{paymaster}, []UserSpend{{Paymaster: owner.String(), User: user.String(), Spent: math.ZeroInt()}}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// ModuleName defines the paymaster module name
+	ModuleName = "paymaster"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+
+	// TransientKey is the key to access the paymaster transient store, that is
+	// reset during the Commit phase
+	TransientKey = "transient_" + ModuleName
+)
+
+// prefix bytes for the paymaster module's persistent store
+const (
+	prefixParams = iota + 1
+	prefixPaymaster
+	prefixTarget
+	prefixUserSpend
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey          = []byte{prefixParams}
+	KeyPrefixPaymaster = []byte{prefixPaymaster}
+	KeyPrefixTarget    = []byte{prefixTarget}
+	KeyPrefixUserSpend = []byte{prefixUserSpend}
+)
+
+// prefix bytes for the paymaster module's transient store
+const (
+	prefixTransientSponsorship = iota + 1
+)
+
+// TransientStore key prefixes
+var (
+	KeyPrefixTransientSponsorship = []byte{prefixTransientSponsorship}
+)
+
+// GetTargetPrefix returns the KVStore key prefix of the paymasters that
+// sponsor calls to a contract
+func GetTargetPrefix(contract common.Address) []byte {
+	return append(KeyPrefixTarget, contract.Bytes()...)
+}
+
+// GetUserSpendPrefix returns the KVStore key prefix of the amounts sponsored by
+// a paymaster
+func GetUserSpendPrefix(owner sdk.AccAddress) []byte {
+	return append(KeyPrefixUserSpend, address.MustLengthPrefix(owner)...)
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgSetPaymaster{}
+	_ sdk.Msg = &MsgDeposit{}
+	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+const (
+	TypeMsgSetPaymaster = "set_paymaster"
+	TypeMsgDeposit      = "deposit"
+	TypeMsgWithdraw     = "withdraw"
+	TypeMsgUpdateParams = "update_params"
+)
+
+// Route returns the name of the module
+func (msg MsgSetPaymaster) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSetPaymaster) Type() string { return TypeMsgSetPaymaster }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetPaymaster) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(err, "invalid owner address %s", msg.Owner)
+	}
+
+	if err := ValidateTargets(msg.Targets); err != nil {
+		return errorsmod.Wrap(ErrInvalidTarget, err.Error())
+	}
+
+	if msg.UserSpendLimit.IsNil() || !msg.UserSpendLimit.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "user spend limit must be positive: %s", msg.UserSpendLimit)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetPaymaster) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetPaymaster) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Owner)}
+}
+
+// Route returns the name of the module
+func (msg MsgDeposit) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgDeposit) Type() string { return TypeMsgDeposit }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(err, "invalid depositor address %s", msg.Depositor)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(err, "invalid owner address %s", msg.Owner)
+	}
+
+	if msg.Amount.Amount.IsNil() || !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Depositor)}
+}
+
+// Route returns the name of the module
+func (msg MsgWithdraw) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgWithdraw) Type() string { return TypeMsgWithdraw }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(err, "invalid owner address %s", msg.Owner)
+	}
+
+	if msg.Amount.Amount.IsNil() || !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Owner)}
+}
+
+// Route returns the message route for a MsgUpdateParams message.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams message.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
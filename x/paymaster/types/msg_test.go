@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	target := Target{Contract: tests.GenerateAddress().Hex()}
+
+	testCases := []struct {
+		name     string
+		msg      sdk.Msg
+		expError bool
+	}{
+		{"set paymaster", &MsgSetPaymaster{Owner: owner, Targets: []Target{target}, UserSpendLimit: math.NewInt(100)}, false},
+		{"set paymaster - invalid owner", &MsgSetPaymaster{Owner: "evmos1", UserSpendLimit: math.NewInt(100)}, true},
+		{"set paymaster - invalid target", &MsgSetPaymaster{Owner: owner, Targets: []Target{{}}, UserSpendLimit: math.NewInt(100)}, true},
+		{"set paymaster - nil limit", &MsgSetPaymaster{Owner: owner}, true},
+		{"set paymaster - zero limit", &MsgSetPaymaster{Owner: owner, Targets: []Target{target}, UserSpendLimit: math.ZeroInt()}, true},
+		{"deposit", &MsgDeposit{Depositor: owner, Owner: owner, Amount: sdk.NewInt64Coin("aevmos", 1)}, false},
+		{"deposit - zero amount", &MsgDeposit{Depositor: owner, Owner: owner, Amount: sdk.NewInt64Coin("aevmos", 0)}, true},
+		{"deposit - invalid owner", &MsgDeposit{Depositor: owner, Owner: "", Amount: sdk.NewInt64Coin("aevmos", 1)}, true},
+		{"withdraw", &MsgWithdraw{Owner: owner, Amount: sdk.NewInt64Coin("aevmos", 1)}, false},
+		{"withdraw - invalid amount", &MsgWithdraw{Owner: owner, Amount: sdk.Coin{Denom: "aevmos"}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
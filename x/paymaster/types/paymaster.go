@@ -0,0 +1,167 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// SelectorLength is the length of an EVM method selector
+const SelectorLength = 4
+
+// NewPaymaster creates a new Paymaster without balance
+func NewPaymaster(owner sdk.AccAddress, targets []Target, userSpendLimit math.Int) Paymaster {
+	return Paymaster{
+		Owner:          owner.String(),
+		Targets:        targets,
+		UserSpendLimit: userSpendLimit,
+		Balance:        math.ZeroInt(),
+	}
+}
+
+// GetOwnerAddress returns the owner address
+func (p Paymaster) GetOwnerAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(p.Owner)
+}
+
+// Validate performs a stateless validation of the paymaster fields
+func (p Paymaster) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", p.Owner, err)
+	}
+
+	if err := ValidateTargets(p.Targets); err != nil {
+		return err
+	}
+
+	if p.UserSpendLimit.IsNil() || !p.UserSpendLimit.IsPositive() {
+		return fmt.Errorf("user spend limit must be positive: %s", p.UserSpendLimit)
+	}
+
+	if p.Balance.IsNil() || p.Balance.IsNegative() {
+		return fmt.Errorf("balance cannot be nil or negative: %s", p.Balance)
+	}
+
+	return nil
+}
+
+// Matches returns true if one of the paymaster targets matches the EVM call
+// to the given recipient with the given input data
+func (p Paymaster) Matches(to common.Address, data []byte) bool {
+	for _, target := range p.Targets {
+		if target.Matches(to, data) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remaining returns the amount that can still be sponsored to a user that has
+// already spent the given amount, limited by the paymaster balance
+func (p Paymaster) Remaining(spent math.Int) math.Int {
+	remaining := p.UserSpendLimit.Sub(spent)
+	if remaining.IsNegative() {
+		return math.ZeroInt()
+	}
+	return math.MinInt(remaining, p.Balance)
+}
+
+// ValidateTargets validates each target and checks there are no duplicates
+func ValidateTargets(targets []Target) error {
+	seen := make(map[Target]bool, len(targets))
+	for _, target := range targets {
+		if err := target.Validate(); err != nil {
+			return err
+		}
+		key := Target{
+			Contract: strings.ToLower(target.Contract),
+			Selector: strings.ToLower(target.Selector),
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate target %s", target)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// GetContractAddress returns the target contract address
+func (t Target) GetContractAddress() common.Address {
+	return common.HexToAddress(t.Contract)
+}
+
+// Validate performs a stateless validation of the target fields
+func (t Target) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(t.Contract); err != nil {
+		return fmt.Errorf("invalid contract address %s: %w", t.Contract, err)
+	}
+
+	if t.Selector != "" {
+		selector, err := hexutil.Decode(t.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid selector %s: %w", t.Selector, err)
+		}
+		if len(selector) != SelectorLength {
+			return fmt.Errorf("invalid selector %s length, expected %d bytes, got %d", t.Selector, SelectorLength, len(selector))
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the EVM call to the given recipient with the given
+// input data matches the target
+func (t Target) Matches(to common.Address, data []byte) bool {
+	if t.GetContractAddress() != to {
+		return false
+	}
+
+	if t.Selector == "" {
+		return true
+	}
+
+	selector, err := hexutil.Decode(t.Selector)
+	if err != nil || len(data) < SelectorLength {
+		return false
+	}
+	return bytes.Equal(selector, data[:SelectorLength])
+}
+
+// Validate performs a stateless validation of the user spend fields
+func (us UserSpend) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(us.Paymaster); err != nil {
+		return fmt.Errorf("invalid paymaster address %s: %w", us.Paymaster, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(us.User); err != nil {
+		return fmt.Errorf("invalid user address %s: %w", us.User, err)
+	}
+
+	if us.Spent.IsNil() || !us.Spent.IsPositive() {
+		return fmt.Errorf("spent amount must be positive: %s", us.Spent)
+	}
+
+	return nil
+}
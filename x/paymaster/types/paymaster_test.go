@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetValidate(t *testing.T) {
+	contract := tests.GenerateAddress().Hex()
+
+	testCases := []struct {
+		name     string
+		target   Target
+		expError bool
+	}{
+		{"contract target", Target{Contract: contract}, false},
+		{"method target", Target{Contract: contract, Selector: "0xa9059cbb"}, false},
+		{"empty contract", Target{}, true},
+		{"zero contract", Target{Contract: common.Address{}.Hex()}, true},
+		{"invalid selector", Target{Contract: contract, Selector: "transfer"}, true},
+		{"invalid selector length", Target{Contract: contract, Selector: "0xa9059c"}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.target.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestTargetMatches(t *testing.T) {
+	contract := tests.GenerateAddress()
+	transfer := common.FromHex("0xa9059cbb000000000000000000000000")
+
+	testCases := []struct {
+		name     string
+		target   Target
+		to       common.Address
+		data     []byte
+		expMatch bool
+	}{
+		{"contract target", Target{Contract: contract.Hex()}, contract, nil, true},
+		{"other contract", Target{Contract: contract.Hex()}, tests.GenerateAddress(), nil, false},
+		{"method target", Target{Contract: contract.Hex(), Selector: "0xa9059cbb"}, contract, transfer, true},
+		{"other method", Target{Contract: contract.Hex(), Selector: "0x095ea7b3"}, contract, transfer, false},
+		{"short data", Target{Contract: contract.Hex(), Selector: "0xa9059cbb"}, contract, transfer[:3], false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, tc.target.Matches(tc.to, tc.data), tc.name)
+	}
+}
+
+func TestPaymasterValidate(t *testing.T) {
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	target := Target{Contract: tests.GenerateAddress().Hex()}
+
+	testCases := []struct {
+		name      string
+		paymaster Paymaster
+		expError  bool
+	}{
+		{"valid paymaster", NewPaymaster(owner, []Target{target}, math.NewInt(100)), false},
+		{"no targets", NewPaymaster(owner, nil, math.NewInt(100)), false},
+		{"invalid owner", Paymaster{Owner: "evmos1", UserSpendLimit: math.NewInt(100), Balance: math.ZeroInt()}, true},
+		{"duplicate target", NewPaymaster(owner, []Target{target, target}, math.NewInt(100)), true},
+		{"negative limit", NewPaymaster(owner, nil, math.NewInt(-1)), true},
+		{"zero limit", NewPaymaster(owner, nil, math.ZeroInt()), true},
+		{"nil balance", Paymaster{Owner: owner.String(), UserSpendLimit: math.NewInt(100)}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.paymaster.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestPaymasterRemaining(t *testing.T) {
+	testCases := []struct {
+		name         string
+		limit        int64
+		balance      int64
+		spent        int64
+		expRemaining int64
+	}{
+		{"limited by spend limit", 60, 100, 20, 40},
+		{"limited by balance", 60, 30, 20, 30},
+		{"limit exceeded", 60, 100, 70, 0},
+	}
+
+	for _, tc := range testCases {
+		paymaster := Paymaster{UserSpendLimit: math.NewInt(tc.limit), Balance: math.NewInt(tc.balance)}
+		require.Equal(t, math.NewInt(tc.expRemaining), paymaster.Remaining(math.NewInt(tc.spent)), tc.name)
+	}
+}
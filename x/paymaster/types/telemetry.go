@@ -0,0 +1,37 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+// Telemetry metric keys of the module
+var (
+	MetricKeySetPaymaster   = []string{ModuleName, "set_paymaster", "total"}
+	MetricKeyDepositAmount  = []string{ModuleName, "deposit", "amount", "total"}
+	MetricKeyWithdrawAmount = []string{ModuleName, "withdraw", "amount", "total"}
+	MetricKeySponsor        = []string{ModuleName, "sponsor", "total"}
+	MetricKeySponsorAmount  = []string{ModuleName, "sponsor", "amount", "total"}
+)
+
+// MetricKeys returns the telemetry metric keys of the module
+func MetricKeys() [][]string {
+	return [][]string{
+		MetricKeySetPaymaster,
+		MetricKeyDepositAmount,
+		MetricKeyWithdrawAmount,
+		MetricKeySponsor,
+		MetricKeySponsorAmount,
+	}
+}
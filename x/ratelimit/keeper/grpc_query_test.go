@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/ratelimit/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RateLimits)
+
+	rateLimit := suite.setupRateLimit(sdk.NewDec(10), sdk.ZeroInt())
+	other := types.NewRateLimit("channel-1", testDenom, sdk.ZeroDec(), sdk.NewInt(5), time.Hour)
+	suite.app.RateLimitKeeper.StoreRateLimit(suite.ctx, other)
+
+	res, err = suite.queryClient.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{rateLimit, other}, res.RateLimits)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	req := &types.QueryRateLimitRequest{ChannelId: channelID, Denom: testDenom}
+
+	_, err := suite.queryClient.RateLimit(ctx, req)
+	suite.Require().Error(err)
+
+	// quota of 10% of a supply of 1000
+	rateLimit := suite.setupRateLimit(sdk.NewDec(10), sdk.ZeroInt())
+	flow, _ := suite.app.RateLimitKeeper.GetFlow(suite.ctx, channelID, testDenom)
+	flow.Inflow = sdk.NewInt(40)
+	flow.Outflow = sdk.NewInt(150)
+	suite.app.RateLimitKeeper.SetFlow(suite.ctx, flow)
+
+	res, err := suite.queryClient.RateLimit(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rateLimit, res.RateLimit)
+	suite.Require().Equal(sdk.NewInt(60), res.RemainingInflow)
+	suite.Require().True(res.RemainingOutflow.IsZero())
+
+	// the flow of an expired window is reset
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	res, err = suite.app.RateLimitKeeper.RateLimit(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), res.RemainingInflow)
+	suite.Require().Equal(sdk.NewInt(100), res.RemainingOutflow)
+}
+
+func (suite *KeeperTestSuite) TestQueryFlows() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.setupRateLimit(sdk.NewDec(10), sdk.ZeroInt())
+
+	res, err := suite.queryClient.Flows(ctx, &types.QueryFlowsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Flows, 1)
+	suite.Require().Equal(sdk.NewInt(1000), res.Flows[0].Supply)
+	suite.Require().True(res.Flows[0].WindowStart.Equal(suite.ctx.BlockTime()))
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	expParams := types.DefaultParams()
+
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, res.Params)
+}
@@ -0,0 +1,250 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/evmos/evmos/v11/ibc"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/ratelimit/types"
+)
+
+// CheckRecvPacket adds the tokens of a received ICS20 packet to the inflow of
+// the rate limit of the destination channel and denomination. It returns an
+// error if the inflow exceeds the quota of the current window, in which case
+// the packet must be rejected with an error acknowledgement.
+func (k Keeper) CheckRecvPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	data, ok := parseTransferData(packet.GetData())
+	if !ok {
+		// not a valid ICS20 packet, the transfer application rejects it
+		return nil
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	_, err := k.updateFlow(ctx, packet.DestinationChannel, coin, true)
+	return err
+}
+
+// SendPacket implements the ICS4Wrapper interface from the transfer module.
+// It adds the tokens of an outbound ICS20 packet to the outflow of the rate
+// limit of the source channel and denomination before calling the underlying
+// SendPacket function. The send fails if the outflow exceeds the quota of the
+// current window, reverting the transfer so that the sender keeps the tokens.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	var flow *types.Flow
+	if transferData, ok := parseTransferData(data); ok {
+		coin := ibc.GetSentCoin(transferData.Denom, transferData.Amount)
+		if flow, err = k.updateFlow(ctx, sourceChannel, coin, false); err != nil {
+			return 0, err
+		}
+	}
+
+	sequence, err = k.ics4Wrapper.SendPacket(
+		ctx,
+		channelCap,
+		sourcePort,
+		sourceChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+		data,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// track the packet to undo its outflow if it's refunded during the window
+	if flow != nil {
+		k.setPendingSend(ctx, sourceChannel, sequence, flow.WindowStart)
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface from the transfer module.
+// It calls the underlying WriteAcknowledgement function directly to move down the middleware stack.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, packet, ack)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnAcknowledgementPacket removes the outflow of an outbound packet that was
+// refunded with an error acknowledgement during the window it was sent in.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	k.completePendingSend(ctx, packet, !ack.Success())
+	return nil
+}
+
+// OnTimeoutPacket removes the outflow of an outbound packet that was refunded
+// after timing out during the window it was sent in.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.completePendingSend(ctx, packet, true)
+	return nil
+}
+
+// updateFlow adds the coin to the inflow or outflow of the rate limit of the
+// channel and denomination, starting a new window if the current one has
+// expired. It returns the updated flow, or nil if the coin isn't rate limited.
+func (k Keeper) updateFlow(ctx sdk.Context, channelID string, coin sdk.Coin, inflow bool) (*types.Flow, error) {
+	if !k.GetParams(ctx).EnableRateLimits {
+		return nil, nil
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, channelID, coin.Denom)
+	if !found {
+		return nil, nil
+	}
+
+	flow := k.GetCurrentFlow(ctx, rateLimit)
+	quota := rateLimit.Quota(flow.Supply)
+
+	direction, amount := "outflow", flow.Outflow.Add(coin.Amount)
+	if inflow {
+		direction, amount = "inflow", flow.Inflow.Add(coin.Amount)
+	}
+
+	labels := []metrics.Label{
+		evmostelemetry.NewDenomLabel(coin.Denom),
+		evmostelemetry.NewChannelLabel(channelID),
+	}
+
+	if amount.GT(quota) {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyQuotaExceeded,
+			append(labels, telemetry.NewLabel("direction", direction))...,
+		)
+		return nil, errorsmod.Wrapf(
+			types.ErrQuotaExceeded,
+			"%s of %s on %s would be %s, quota is %s", direction, coin.Denom, channelID, amount, quota,
+		)
+	}
+
+	if inflow {
+		flow.Inflow = amount
+		evmostelemetry.IncrAmount(types.MetricKeyInflowAmount, coin.Amount, labels...)
+	} else {
+		flow.Outflow = amount
+		evmostelemetry.IncrAmount(types.MetricKeyOutflowAmount, coin.Amount, labels...)
+	}
+
+	k.SetFlow(ctx, flow)
+	return &flow, nil
+}
+
+// completePendingSend removes the tracking of an outbound packet, and its
+// outflow if it was refunded during the window it was sent in.
+func (k Keeper) completePendingSend(ctx sdk.Context, packet channeltypes.Packet, refunded bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSend)
+	key := types.GetPendingSendKey(packet.SourceChannel, packet.Sequence)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return
+	}
+
+	store.Delete(key)
+
+	if !refunded {
+		return
+	}
+
+	data, ok := parseTransferData(packet.GetData())
+	if !ok {
+		return
+	}
+
+	coin := ibc.GetSentCoin(data.Denom, data.Amount)
+	flow, found := k.GetFlow(ctx, packet.SourceChannel, coin.Denom)
+	if !found {
+		return
+	}
+
+	// the outflow of previous windows has already been reset
+	windowStart, err := sdk.ParseTimeBytes(bz)
+	if err != nil || !flow.WindowStart.Equal(windowStart) {
+		return
+	}
+
+	flow.Outflow = flow.Outflow.Sub(coin.Amount)
+	if flow.Outflow.IsNegative() {
+		flow.Outflow = sdk.ZeroInt()
+	}
+
+	k.SetFlow(ctx, flow)
+
+	evmostelemetry.IncrAmount(
+		types.MetricKeyRevertOutflowAmount,
+		coin.Amount,
+		evmostelemetry.NewDenomLabel(coin.Denom),
+		evmostelemetry.NewChannelLabel(packet.SourceChannel),
+	)
+}
+
+// setPendingSend tracks an outbound packet sent during the window started at
+// the given time
+func (k Keeper) setPendingSend(ctx sdk.Context, channelID string, sequence uint64, windowStart time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSend)
+	store.Set(types.GetPendingSendKey(channelID, sequence), sdk.FormatTimeBytes(windowStart))
+}
+
+// parseTransferData returns the ICS20 data of a packet and whether it is valid
+func parseTransferData(bz []byte) (transfertypes.FungibleTokenPacketData, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return data, false
+	}
+
+	return data, data.ValidateBasic() == nil
+}
@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/x/ratelimit/types"
+)
+
+// mockICS4Wrapper records the sent packets and assigns them increasing
+// sequences
+type mockICS4Wrapper struct {
+	sequence uint64
+}
+
+func (m *mockICS4Wrapper) SendPacket(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	_ string,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+	_ []byte,
+) (uint64, error) {
+	m.sequence++
+	return m.sequence, nil
+}
+
+func (m *mockICS4Wrapper) WriteAcknowledgement(sdk.Context, *capabilitytypes.Capability, exported.PacketI, exported.Acknowledgement) error {
+	return nil
+}
+
+func (m *mockICS4Wrapper) GetAppVersion(sdk.Context, string, string) (string, bool) {
+	return transfertypes.Version, true
+}
+
+// transferData returns the ICS20 packet data of a transfer of the given
+// denomination and amount
+func transferData(denom string, amount int64) []byte {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	data := transfertypes.NewFungibleTokenPacketData(denom, sdk.NewInt(amount).String(), sender, receiver, "")
+	return data.GetBytes()
+}
+
+// recvPacket returns a packet that transfers the test denomination back to
+// this chain through the test channel
+func recvPacket(amount int64) channeltypes.Packet {
+	denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-1", testDenom)
+	return channeltypes.NewPacket(
+		transferData(denom, amount), 1,
+		transfertypes.PortID, "channel-1",
+		transfertypes.PortID, channelID,
+		clienttypes.NewHeight(1, 100), 0,
+	)
+}
+
+// sendPacket sends the given amount of the test denomination through the
+// test channel and returns the sent packet
+func (suite *KeeperTestSuite) sendPacket(amount int64) (channeltypes.Packet, error) {
+	data := transferData(testDenom, amount)
+	sequence, err := suite.keeper.SendPacket(
+		suite.ctx, nil, transfertypes.PortID, channelID, clienttypes.NewHeight(1, 100), 0, data,
+	)
+	packet := channeltypes.NewPacket(
+		data, sequence,
+		transfertypes.PortID, channelID,
+		transfertypes.PortID, "channel-1",
+		clienttypes.NewHeight(1, 100), 0,
+	)
+	return packet, err
+}
+
+func (suite *KeeperTestSuite) TestCheckRecvPacket() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		amount    int64
+		expPass   bool
+		expInflow int64
+	}{
+		{
+			"pass - no rate limit",
+			func() {},
+			1000,
+			true,
+			0,
+		},
+		{
+			"pass - rate limits disabled",
+			func() {
+				suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(10))
+				err := suite.keeper.SetParams(suite.ctx, types.NewParams(false))
+				suite.Require().NoError(err)
+			},
+			1000,
+			true,
+			0,
+		},
+		{
+			"pass - within the absolute quota",
+			func() {
+				suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(100))
+			},
+			100,
+			true,
+			100,
+		},
+		{
+			"fail - over the absolute quota",
+			func() {
+				suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(100))
+			},
+			101,
+			false,
+			0,
+		},
+		{
+			"fail - over the percentage quota",
+			func() {
+				// 10% of a supply of 1000
+				suite.setupRateLimit(sdk.NewDec(10), sdk.ZeroInt())
+			},
+			101,
+			false,
+			0,
+		},
+		{
+			"fail - over the quota with the inflow of the window",
+			func() {
+				suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(100))
+				err := suite.keeper.CheckRecvPacket(suite.ctx, recvPacket(60))
+				suite.Require().NoError(err)
+			},
+			60,
+			false,
+			60,
+		},
+		{
+			"pass - new window after expiry",
+			func() {
+				suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(100))
+				err := suite.keeper.CheckRecvPacket(suite.ctx, recvPacket(60))
+				suite.Require().NoError(err)
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+			},
+			60,
+			true,
+			60,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			err := suite.keeper.CheckRecvPacket(suite.ctx, recvPacket(tc.amount))
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+			}
+
+			flow, found := suite.keeper.GetFlow(suite.ctx, channelID, testDenom)
+			if found {
+				suite.Require().Equal(sdk.NewInt(tc.expInflow), flow.Inflow)
+				suite.Require().True(flow.Outflow.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPacket() {
+	suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(100))
+
+	_, err := suite.sendPacket(60)
+	suite.Require().NoError(err)
+
+	// the outflow of the window is over the quota
+	_, err = suite.sendPacket(60)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+	suite.Require().Equal(uint64(1), suite.ics4Wrapper.sequence)
+
+	flow, _ := suite.keeper.GetFlow(suite.ctx, channelID, testDenom)
+	suite.Require().Equal(sdk.NewInt(60), flow.Outflow)
+	suite.Require().True(flow.Inflow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRefundedPackets() {
+	errAck := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+
+	testCases := []struct {
+		name       string
+		complete   func(packet channeltypes.Packet) error
+		newWindow  bool
+		expOutflow int64
+	}{
+		{
+			"successful acknowledgement keeps the outflow",
+			func(packet channeltypes.Packet) error {
+				return suite.keeper.OnAcknowledgementPacket(suite.ctx, packet, successAck)
+			},
+			false,
+			60,
+		},
+		{
+			"error acknowledgement removes the outflow",
+			func(packet channeltypes.Packet) error {
+				return suite.keeper.OnAcknowledgementPacket(suite.ctx, packet, errAck)
+			},
+			false,
+			0,
+		},
+		{
+			"timeout removes the outflow",
+			func(packet channeltypes.Packet) error {
+				return suite.keeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			false,
+			0,
+		},
+		{
+			"refund in a later window keeps the new outflow",
+			func(packet channeltypes.Packet) error {
+				return suite.keeper.OnTimeoutPacket(suite.ctx, packet)
+			},
+			true,
+			30,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(100))
+
+			packet, err := suite.sendPacket(60)
+			suite.Require().NoError(err)
+
+			if tc.newWindow {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+				_, err = suite.sendPacket(30)
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().NoError(tc.complete(packet))
+
+			flow, _ := suite.keeper.GetFlow(suite.ctx, channelID, testDenom)
+			suite.Require().Equal(sdk.NewInt(tc.expOutflow), flow.Outflow)
+
+			// the packet can only be refunded once
+			suite.Require().NoError(suite.keeper.OnTimeoutPacket(suite.ctx, packet))
+			flow, _ = suite.keeper.GetFlow(suite.ctx, channelID, testDenom)
+			suite.Require().Equal(sdk.NewInt(tc.expOutflow), flow.Outflow)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/tests"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v11/app"
+	"github.com/evmos/evmos/v11/testutil"
+	"github.com/evmos/evmos/v11/x/ratelimit/keeper"
+	"github.com/evmos/evmos/v11/x/ratelimit/types"
+)
+
+const (
+	channelID = "channel-0"
+	testDenom = "atest"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+
+	app         *app.Evmos
+	queryClient types.QueryClient
+
+	// keeper shares the store of the app rate limit keeper and sends packets
+	// through a mock ICS4 wrapper
+	keeper      *keeper.Keeper
+	ics4Wrapper *mockICS4Wrapper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// consensus key
+	consAddress := sdk.ConsAddress(tests.GenerateAddress().Bytes())
+
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState())
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:          1,
+		ChainID:         "evmos_9000-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.RateLimitKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	suite.ics4Wrapper = &mockICS4Wrapper{}
+	suite.keeper = keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey),
+		suite.app.AppCodec(),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		suite.app.BankKeeper,
+	)
+	suite.keeper.SetICS4Wrapper(suite.ics4Wrapper)
+
+	// mint the supply of the test denomination
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1000)))
+	suite.Require().NoError(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// setupRateLimit registers a rate limit on the test channel and denomination
+// and starts its window
+func (suite *KeeperTestSuite) setupRateLimit(maxPercent sdk.Dec, maxAmount sdk.Int) types.RateLimit {
+	rateLimit := types.NewRateLimit(channelID, testDenom, maxPercent, maxAmount, time.Hour)
+	suite.app.RateLimitKeeper.StoreRateLimit(suite.ctx, rateLimit)
+	suite.app.RateLimitKeeper.StartFlowWindow(suite.ctx, rateLimit)
+	return rateLimit
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/x/ratelimit/types"
+)
+
+func (suite *KeeperTestSuite) TestSetRateLimit() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	rateLimit := types.NewRateLimit(channelID, testDenom, sdk.NewDec(10), sdk.ZeroInt(), time.Hour)
+
+	testCases := []struct {
+		name      string
+		authority string
+		malleate  func()
+		expPass   bool
+	}{
+		{
+			"fail - invalid authority",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			func() {},
+			false,
+		},
+		{
+			"pass - rate limit created",
+			authority,
+			func() {},
+			true,
+		},
+		{
+			"pass - rate limit updated, resetting its flow",
+			authority,
+			func() {
+				suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(500))
+				flow, _ := suite.app.RateLimitKeeper.GetFlow(suite.ctx, channelID, testDenom)
+				flow.Inflow = sdk.NewInt(300)
+				suite.app.RateLimitKeeper.SetFlow(suite.ctx, flow)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			msg := &types.MsgSetRateLimit{Authority: tc.authority, RateLimit: rateLimit}
+			_, err := suite.app.RateLimitKeeper.SetRateLimit(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			stored, found := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, testDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(rateLimit, stored)
+
+			flow, found := suite.app.RateLimitKeeper.GetFlow(suite.ctx, channelID, testDenom)
+			suite.Require().True(found)
+			suite.Require().True(flow.Inflow.IsZero())
+			suite.Require().Equal(sdk.NewInt(1000), flow.Supply)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveRateLimit() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - rate limit not found",
+			func() {},
+			false,
+		},
+		{
+			"pass - rate limit and flow removed",
+			func() {
+				suite.setupRateLimit(sdk.NewDec(10), sdk.ZeroInt())
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			msg := &types.MsgRemoveRateLimit{Authority: authority, ChannelId: channelID, Denom: testDenom}
+			_, err := suite.app.RateLimitKeeper.RemoveRateLimit(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			_, found := suite.app.RateLimitKeeper.GetRateLimit(suite.ctx, channelID, testDenom)
+			suite.Require().False(found)
+			_, found = suite.app.RateLimitKeeper.GetFlow(suite.ctx, channelID, testDenom)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResetFlow() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msg := &types.MsgResetFlow{Authority: authority, ChannelId: channelID, Denom: testDenom}
+
+	_, err := suite.app.RateLimitKeeper.ResetFlow(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	suite.setupRateLimit(sdk.ZeroDec(), sdk.NewInt(500))
+	flow, _ := suite.app.RateLimitKeeper.GetFlow(suite.ctx, channelID, testDenom)
+	flow.Inflow = sdk.NewInt(100)
+	flow.Outflow = sdk.NewInt(200)
+	suite.app.RateLimitKeeper.SetFlow(suite.ctx, flow)
+
+	_, err = suite.app.RateLimitKeeper.ResetFlow(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	flow, found := suite.app.RateLimitKeeper.GetFlow(suite.ctx, channelID, testDenom)
+	suite.Require().True(found)
+	suite.Require().True(flow.Inflow.IsZero())
+	suite.Require().True(flow.Outflow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name    string
+		request *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "foobar"},
+			false,
+		},
+		{
+			"pass - valid update params",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(false),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.app.RateLimitKeeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params, suite.app.RateLimitKeeper.GetParams(suite.ctx))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/ratelimit/types"
+)
+
+// GetRateLimits returns all registered rate limits
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all registered rate limits and performs a
+// callback
+func (k Keeper) IterateRateLimits(
+	ctx sdk.Context,
+	handlerFn func(rateLimit types.RateLimit) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if handlerFn(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetRateLimit returns the rate limit of a channel and denomination
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// StoreRateLimit stores a rate limit
+func (k Keeper) StoreRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes a rate limit and its flow
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	key := types.GetRateLimitKey(channelID, denom)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit).Delete(key)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow).Delete(key)
+}
+
+// GetFlows returns the stored flows of all rate limits
+func (k Keeper) GetFlows(ctx sdk.Context) []types.Flow {
+	flows := []types.Flow{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
+
+// GetFlow returns the stored flow of the rate limit of a channel and
+// denomination. The flow might belong to an expired window, see GetCurrentFlow.
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom string) (types.Flow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.Flow{}, false
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// SetFlow stores the flow of a rate limit
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	bz := k.cdc.MustMarshal(&flow)
+	store.Set(types.GetRateLimitKey(flow.ChannelId, flow.Denom), bz)
+}
+
+// GetCurrentFlow returns the flow of the current window of a rate limit. A new
+// empty flow, with a snapshot of the current supply, is returned if the stored
+// window has expired or there is no stored flow.
+func (k Keeper) GetCurrentFlow(ctx sdk.Context, rateLimit types.RateLimit) types.Flow {
+	flow, found := k.GetFlow(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	if found && !flow.IsExpired(rateLimit.Window, ctx.BlockTime()) {
+		return flow
+	}
+
+	return k.newFlow(ctx, rateLimit)
+}
+
+// StartFlowWindow starts a new window for the flow of a rate limit
+func (k Keeper) StartFlowWindow(ctx sdk.Context, rateLimit types.RateLimit) {
+	k.SetFlow(ctx, k.newFlow(ctx, rateLimit))
+}
+
+// newFlow returns an empty flow for a window that starts at the current block
+// time
+func (k Keeper) newFlow(ctx sdk.Context, rateLimit types.RateLimit) types.Flow {
+	supply := k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount
+	return types.NewFlow(rateLimit.ChannelId, rateLimit.Denom, supply, ctx.BlockTime())
+}
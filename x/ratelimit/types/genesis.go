@@ -0,0 +1,69 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, rateLimits []RateLimit, flows []Flow) GenesisState {
+	return GenesisState{
+		Params:     params,
+		RateLimits: rateLimits,
+		Flows:      flows,
+	}
+}
+
+// DefaultGenesisState sets default ratelimit genesis state with default params
+// and no rate limits
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		key := rateLimit.ChannelId + "/" + rateLimit.Denom
+		if seenRateLimits[key] {
+			return fmt.Errorf("duplicate rate limit %s", key)
+		}
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		seenRateLimits[key] = true
+	}
+
+	seenFlows := make(map[string]bool)
+	for _, flow := range gs.Flows {
+		key := flow.ChannelId + "/" + flow.Denom
+		if !seenRateLimits[key] {
+			return fmt.Errorf("flow of unknown rate limit %s", key)
+		}
+		if seenFlows[key] {
+			return fmt.Errorf("duplicate flow %s", key)
+		}
+		if err := flow.Validate(); err != nil {
+			return err
+		}
+		seenFlows[key] = true
+	}
+
+	return gs.Params.Validate()
+}
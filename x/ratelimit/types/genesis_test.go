@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	rateLimit := NewRateLimit("channel-0", "aevmos", sdk.NewDec(10), sdk.ZeroInt(), time.Hour)
+	flow := NewFlow("channel-0", "aevmos", sdk.NewInt(1000), time.Unix(1_000_000, 0).UTC())
+	otherFlow := NewFlow("channel-1", "aevmos", sdk.NewInt(1000), time.Unix(1_000_000, 0).UTC())
+	negativeFlow := flow
+	negativeFlow.Inflow = sdk.NewInt(-1)
+
+	testCases := []struct {
+		name     string
+		genesis  GenesisState
+		expError bool
+	}{
+		{"default genesis", *DefaultGenesisState(), false},
+		{"valid genesis", NewGenesisState(DefaultParams(), []RateLimit{rateLimit}, []Flow{flow}), false},
+		{"duplicate rate limit", NewGenesisState(DefaultParams(), []RateLimit{rateLimit, rateLimit}, nil), true},
+		{"invalid rate limit", NewGenesisState(DefaultParams(), []RateLimit{{ChannelId: "channel-0", Denom: "aevmos"}}, nil), true},
+		{"flow of unknown rate limit", NewGenesisState(DefaultParams(), []RateLimit{rateLimit}, []Flow{otherFlow}), true},
+		{"duplicate flow", NewGenesisState(DefaultParams(), []RateLimit{rateLimit}, []Flow{flow, flow}), true},
+		{"negative flow", NewGenesisState(DefaultParams(), []RateLimit{rateLimit}, []Flow{negativeFlow}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
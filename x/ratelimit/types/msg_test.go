@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	rateLimit := NewRateLimit("channel-0", "aevmos", sdk.NewDec(10), sdk.ZeroInt(), time.Hour)
+
+	testCases := []struct {
+		name     string
+		msg      sdk.Msg
+		expError bool
+	}{
+		{"set rate limit", &MsgSetRateLimit{Authority: authority, RateLimit: rateLimit}, false},
+		{"set rate limit - invalid authority", &MsgSetRateLimit{Authority: "evmos1", RateLimit: rateLimit}, true},
+		{"set rate limit - invalid rate limit", &MsgSetRateLimit{Authority: authority, RateLimit: RateLimit{ChannelId: "channel-0"}}, true},
+		{"remove rate limit", &MsgRemoveRateLimit{Authority: authority, ChannelId: "channel-0", Denom: "aevmos"}, false},
+		{"remove rate limit - invalid channel", &MsgRemoveRateLimit{Authority: authority, ChannelId: "", Denom: "aevmos"}, true},
+		{"reset flow", &MsgResetFlow{Authority: authority, ChannelId: "channel-0", Denom: "aevmos"}, false},
+		{"reset flow - invalid denom", &MsgResetFlow{Authority: authority, ChannelId: "channel-0", Denom: "1"}, true},
+		{"update params", &MsgUpdateParams{Authority: authority, Params: DefaultParams()}, false},
+		{"update params - invalid authority", &MsgUpdateParams{Authority: "", Params: DefaultParams()}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// maxPercent is the upper bound of the percentage quota of a rate limit
+var maxPercent = sdk.NewDec(100)
+
+// NewRateLimit returns an instance of RateLimit
+func NewRateLimit(channelID, denom string, maxPercent sdk.Dec, maxAmount sdk.Int, window time.Duration) RateLimit {
+	return RateLimit{
+		ChannelId:  channelID,
+		Denom:      denom,
+		MaxPercent: maxPercent,
+		MaxAmount:  maxAmount,
+		Window:     window,
+	}
+}
+
+// Validate performs a stateless validation of a rate limit
+func (rl RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(rl.ChannelId); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if rl.MaxPercent.IsNil() || rl.MaxPercent.IsNegative() || rl.MaxPercent.GT(maxPercent) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max percent must be between 0 and 100, got %s", rl.MaxPercent)
+	}
+
+	if rl.MaxAmount.IsNil() || rl.MaxAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max amount cannot be negative, got %s", rl.MaxAmount)
+	}
+
+	if rl.MaxPercent.IsZero() && rl.MaxAmount.IsZero() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "either the max percent or the max amount must be set")
+	}
+
+	if rl.Window <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "window must be positive, got %s", rl.Window)
+	}
+
+	return nil
+}
+
+// Quota returns the amount that can be received or sent during a window,
+// given the supply of the denomination at the start of the window. The
+// smallest of the percentage and absolute quotas applies if both are set.
+func (rl RateLimit) Quota(supply sdk.Int) sdk.Int {
+	if rl.MaxPercent.IsZero() {
+		return rl.MaxAmount
+	}
+
+	quota := sdk.NewDecFromInt(supply).Mul(rl.MaxPercent).Quo(maxPercent).TruncateInt()
+	if rl.MaxAmount.IsPositive() && rl.MaxAmount.LT(quota) {
+		return rl.MaxAmount
+	}
+
+	return quota
+}
+
+// NewFlow returns an empty flow of a window started at the given time
+func NewFlow(channelID, denom string, supply sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		ChannelId:   channelID,
+		Denom:       denom,
+		Inflow:      sdk.ZeroInt(),
+		Outflow:     sdk.ZeroInt(),
+		Supply:      supply,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs a stateless validation of a flow
+func (f Flow) Validate() error {
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+
+	for name, amount := range map[string]sdk.Int{
+		"inflow":  f.Inflow,
+		"outflow": f.Outflow,
+		"supply":  f.Supply,
+	} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("%s cannot be negative, got %s", name, amount)
+		}
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the window of the flow has ended at the given
+// time
+func (f Flow) IsExpired(window time.Duration, now time.Time) bool {
+	return !now.Before(f.WindowStart.Add(window))
+}
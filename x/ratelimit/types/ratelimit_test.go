@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rateLimit RateLimit
+		expError  bool
+	}{
+		{"valid percent", NewRateLimit("channel-0", "aevmos", sdk.NewDec(10), sdk.ZeroInt(), time.Hour), false},
+		{"valid amount", NewRateLimit("channel-0", "aevmos", sdk.ZeroDec(), sdk.NewInt(100), time.Hour), false},
+		{"valid ibc denom", NewRateLimit("channel-0", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewDec(10), sdk.NewInt(100), time.Hour), false},
+		{"invalid channel", NewRateLimit("channel", "aevmos", sdk.NewDec(10), sdk.ZeroInt(), time.Hour), true},
+		{"invalid denom", NewRateLimit("channel-0", "", sdk.NewDec(10), sdk.ZeroInt(), time.Hour), true},
+		{"percent over 100", NewRateLimit("channel-0", "aevmos", sdk.NewDec(101), sdk.ZeroInt(), time.Hour), true},
+		{"negative percent", NewRateLimit("channel-0", "aevmos", sdk.NewDec(-1), sdk.ZeroInt(), time.Hour), true},
+		{"nil percent", RateLimit{ChannelId: "channel-0", Denom: "aevmos", MaxAmount: sdk.NewInt(1), Window: time.Hour}, true},
+		{"negative amount", NewRateLimit("channel-0", "aevmos", sdk.NewDec(10), sdk.NewInt(-1), time.Hour), true},
+		{"no quota", NewRateLimit("channel-0", "aevmos", sdk.ZeroDec(), sdk.ZeroInt(), time.Hour), true},
+		{"zero window", NewRateLimit("channel-0", "aevmos", sdk.NewDec(10), sdk.ZeroInt(), 0), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.rateLimit.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestRateLimitQuota(t *testing.T) {
+	supply := sdk.NewInt(1000)
+
+	testCases := []struct {
+		name       string
+		maxPercent sdk.Dec
+		maxAmount  sdk.Int
+		expQuota   sdk.Int
+	}{
+		{"amount only", sdk.ZeroDec(), sdk.NewInt(50), sdk.NewInt(50)},
+		{"percent only", sdk.NewDecWithPrec(105, 1), sdk.ZeroInt(), sdk.NewInt(105)},
+		{"amount lower than percent", sdk.NewDec(10), sdk.NewInt(50), sdk.NewInt(50)},
+		{"percent lower than amount", sdk.NewDec(10), sdk.NewInt(500), sdk.NewInt(100)},
+	}
+
+	for _, tc := range testCases {
+		rateLimit := NewRateLimit("channel-0", "aevmos", tc.maxPercent, tc.maxAmount, time.Hour)
+		require.Equal(t, tc.expQuota, rateLimit.Quota(supply), tc.name)
+	}
+
+	rateLimit := NewRateLimit("channel-0", "aevmos", sdk.NewDec(10), sdk.ZeroInt(), time.Hour)
+	require.True(t, rateLimit.Quota(sdk.ZeroInt()).IsZero(), "zero supply")
+}
+
+func TestFlowIsExpired(t *testing.T) {
+	start := time.Unix(1_000_000, 0).UTC()
+	flow := NewFlow("channel-0", "aevmos", sdk.NewInt(1000), start)
+
+	require.False(t, flow.IsExpired(time.Hour, start))
+	require.False(t, flow.IsExpired(time.Hour, start.Add(time.Hour-time.Second)))
+	require.True(t, flow.IsExpired(time.Hour, start.Add(time.Hour)))
+	require.True(t, flow.IsExpired(time.Hour, start.Add(2*time.Hour)))
+}
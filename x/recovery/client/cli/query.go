@@ -18,6 +18,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -38,6 +39,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetParamsCmd(),
+		GetRecoveryRecordsCmd(),
+		GetRecoveryRecordCmd(),
+		GetRecoveryNonceCmd(),
 	)
 	return cmd
 }
@@ -71,3 +75,107 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRecoveryRecordsCmd queries the recovery history of an address
+func GetRecoveryRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery-records ADDRESS",
+		Short: "Gets the recovery history of an address",
+		Long:  "Gets the recovery attempts of a stuck address with the status of their outbound packets",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRecoveryRecordsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RecoveryRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recovery records")
+	return cmd
+}
+
+// GetRecoveryRecordCmd queries a recovery record by its identifier
+func GetRecoveryRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery-record ID",
+		Short: "Gets a recovery record by its identifier",
+		Long:  "Gets a recovery record by its identifier",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRecoveryRecordRequest{Id: id}
+
+			res, err := queryClient.RecoveryRecord(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRecoveryNonceCmd queries the recovery nonce of an address
+func GetRecoveryNonceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery-nonce ADDRESS",
+		Short: "Gets the recovery nonce of an address",
+		Long:  "Gets the nonce that the next recovery of a stuck address must sign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRecoveryNonceRequest{Address: args[0]}
+
+			res, err := queryClient.RecoveryNonce(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/evmos/evmos/v11/x/recovery/types"
+)
+
+const (
+	FlagReceiver      = "receiver"
+	FlagChannel       = "channel"
+	FlagOriginAddress = "origin-address"
+	FlagNonce         = "nonce"
+)
+
+// NewTxCmd returns a root CLI command handler for recovery transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "recovery subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRecoverCmd(),
+	)
+	return txCmd
+}
+
+// NewRecoverCmd returns a CLI command handler for recovering the balances of a
+// stuck secp256k1 address
+func NewRecoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover ADDRESS PUBKEY_HEX SIGNATURE_HEX",
+		Short: "Recover the balances of a stuck secp256k1 address, either to a receiver on Evmos (--receiver) or back to the origin chain (--channel and --origin-address).",
+		Long: `Recover the balances of a stuck secp256k1 address.
+The signature must be produced by the secp256k1 key of the stuck address over the
+sorted JSON payload {"address","chain_id","nonce","origin_address","receiver","source_channel"},
+where the nonce is the current recovery nonce of the address (see the recovery-nonce query)
+encoded as a string.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid public key hex: %w", err)
+			}
+
+			signature, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid signature hex: %w", err)
+			}
+
+			receiver, err := cmd.Flags().GetString(FlagReceiver)
+			if err != nil {
+				return err
+			}
+
+			channel, err := cmd.Flags().GetString(FlagChannel)
+			if err != nil {
+				return err
+			}
+
+			originAddress, err := cmd.Flags().GetString(FlagOriginAddress)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := cmd.Flags().GetUint64(FlagNonce)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRecover{
+				Sender:        cliCtx.GetFromAddress().String(),
+				Address:       args[0],
+				PubKey:        pubKey,
+				Signature:     signature,
+				Receiver:      receiver,
+				SourceChannel: channel,
+				OriginAddress: originAddress,
+				Nonce:         nonce,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReceiver, "", "bech32 address on Evmos that receives the recovered balances")
+	cmd.Flags().String(FlagChannel, "", "authorized IBC channel used to send the balances back to the origin chain")
+	cmd.Flags().String(FlagOriginAddress, "", "bech32 address of the stuck key on the origin chain")
+	cmd.Flags().Uint64(FlagNonce, 0, "recovery nonce of the stuck address included in the signed payload")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -34,11 +34,33 @@ func InitGenesis(
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "cannot set parameters"))
 	}
+
+	nextID := uint64(1)
+	for _, record := range data.RecoveryRecords {
+		k.SetRecoveryRecord(ctx, record)
+
+		for _, packet := range record.Packets {
+			if packet.RetryQueued {
+				k.EnqueueRetry(ctx, record.ID, packet.Sequence)
+			}
+		}
+
+		if record.ID >= nextID {
+			nextID = record.ID + 1
+		}
+	}
+	k.SetNextRecoveryID(ctx, nextID)
+
+	for _, nonce := range data.RecoveryNonces {
+		k.SetRecoveryNonce(ctx, sdk.MustAccAddressFromBech32(nonce.Address), nonce.Nonce)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:          k.GetParams(ctx),
+		RecoveryRecords: k.GetAllRecoveryRecords(ctx),
+		RecoveryNonces:  k.GetAllRecoveryNonces(ctx),
 	}
 }
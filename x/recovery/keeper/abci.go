@@ -0,0 +1,132 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
+	"github.com/evmos/evmos/v11/x/recovery/types"
+)
+
+// EndBlocker sends again the refunded tokens of the recovery packets that
+// failed or timed out during the block
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	type retry struct{ id, sequence uint64 }
+	retries := []retry{}
+
+	k.IterateRetryQueue(ctx, func(id, sequence uint64) (stop bool) {
+		retries = append(retries, retry{id, sequence})
+		return false
+	})
+
+	for _, r := range retries {
+		k.DeleteRetry(ctx, r.id, r.sequence)
+		k.retryRecoveryPacket(ctx, r.id, r.sequence)
+	}
+}
+
+// retryRecoveryPacket sends the refunded tokens of a failed or timed out
+// recovery packet in a new packet. Only the balance of the packet denom that is
+// still held by the stuck address is sent, up to the original packet amount.
+func (k Keeper) retryRecoveryPacket(ctx sdk.Context, id, sequence uint64) {
+	record, found := k.GetRecoveryRecord(ctx, id)
+	if !found {
+		return
+	}
+
+	i, found := record.PacketIndex(sequence)
+	if !found || !record.Packets[i].RetryQueued {
+		return
+	}
+
+	failedPacket := &record.Packets[i]
+	failedPacket.RetryQueued = false
+
+	params := k.GetParams(ctx)
+	address := sdk.MustAccAddressFromBech32(record.Address)
+
+	coin := k.bankKeeper.GetBalance(ctx, address, failedPacket.Token.Denom)
+	if failedPacket.Token.IsLT(coin) {
+		coin = failedPacket.Token
+	}
+
+	var retryPacket *types.RecoveryPacket
+
+	switch {
+	case !params.EnableRecovery:
+		failedPacket.Error = "retry skipped: recovery is disabled"
+	case coin.IsZero():
+		failedPacket.Error = "retry skipped: no refunded balance"
+	default:
+		// NOTE: Don't use the consensus state because it may become unreliable if updates slow down
+		timeout := uint64(ctx.BlockTime().Add(params.PacketTimeoutDuration).UnixNano())
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), &transfertypes.MsgTransfer{
+			SourcePort:       record.SourcePort,
+			SourceChannel:    record.SourceChannel,
+			Token:            coin,
+			Sender:           record.Address,
+			Receiver:         record.Receiver,
+			TimeoutHeight:    clienttypes.ZeroHeight(),
+			TimeoutTimestamp: timeout,
+		})
+		if err != nil {
+			failedPacket.Error = "retry failed: " + err.Error()
+			k.Logger(ctx).Error(
+				"failed to retry recovery packet",
+				"recovery-id", id,
+				"sequence", sequence,
+				"error", err.Error(),
+			)
+			break
+		}
+
+		writeCache()
+
+		failedPacket.Status = types.PacketStatusRetried
+		packet := types.NewRecoveryPacket(res.Sequence, coin, failedPacket.Attempt+1)
+		retryPacket = &packet
+
+		channelLabel := evmostelemetry.NewChannelLabel(record.SourceChannel)
+		evmostelemetry.IncrCounter(types.MetricKeyRetry, channelLabel)
+		evmostelemetry.IncrAmount(types.MetricKeyRetryAmount, coin.Amount, channelLabel, evmostelemetry.NewDenomLabel(coin.Denom))
+	}
+
+	if retryPacket != nil {
+		record.Packets = append(record.Packets, *retryPacket)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRecoveryRetry,
+				sdk.NewAttribute(types.AttributeKeyRecoveryID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(retryPacket.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyAttempt, strconv.FormatUint(uint64(retryPacket.Attempt), 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			),
+		)
+	}
+
+	record.UpdateStatus()
+	k.SetRecoveryRecord(ctx, record)
+}
@@ -19,7 +19,12 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/evmos/evmos/v11/x/recovery/types"
 )
@@ -38,3 +43,91 @@ func (k Keeper) Params(
 		Params: params,
 	}, nil
 }
+
+// RecoveryRecords returns the recovery history of an address
+func (k Keeper) RecoveryRecords(
+	c context.Context,
+	req *types.QueryRecoveryRecordsRequest,
+) (*types.QueryRecoveryRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixRecoveryRecordByAddress, types.GetRecoveryRecordByAddressPrefix(addr)...),
+	)
+
+	records := []types.RecoveryRecord{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key, _ []byte) error {
+			record, found := k.GetRecoveryRecord(ctx, sdk.BigEndianToUint64(key))
+			if !found {
+				return status.Errorf(codes.Internal, "recovery record %d not found", sdk.BigEndianToUint64(key))
+			}
+
+			records = append(records, record)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecoveryRecordsResponse{
+		RecoveryRecords: records,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// RecoveryRecord returns the recovery record with the given identifier
+func (k Keeper) RecoveryRecord(
+	c context.Context,
+	req *types.QueryRecoveryRecordRequest,
+) (*types.QueryRecoveryRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetRecoveryRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "recovery record %d", req.Id)
+	}
+
+	return &types.QueryRecoveryRecordResponse{
+		RecoveryRecord: record,
+	}, nil
+}
+
+// RecoveryNonce returns the nonce that the next MsgRecover of an address must
+// sign
+func (k Keeper) RecoveryNonce(
+	c context.Context,
+	req *types.QueryRecoveryNonceRequest,
+) (*types.QueryRecoveryNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRecoveryNonceResponse{
+		Nonce: k.GetRecoveryNonce(ctx, addr),
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
 
 	"github.com/evmos/evmos/v11/x/recovery/types"
 )
@@ -14,3 +15,21 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryRecoveryNonce() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	address := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	_, err := suite.queryClient.RecoveryNonce(ctx, &types.QueryRecoveryNonceRequest{Address: "invalid"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.RecoveryNonce(ctx, &types.QueryRecoveryNonceRequest{Address: address.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.Nonce)
+
+	suite.app.RecoveryKeeper.SetRecoveryNonce(suite.ctx, address, 3)
+
+	res, err = suite.queryClient.RecoveryNonce(ctx, &types.QueryRecoveryNonceRequest{Address: address.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.Nonce)
+}
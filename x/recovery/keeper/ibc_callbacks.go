@@ -17,12 +17,12 @@
 package keeper
 
 import (
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
-	"github.com/armon/go-metrics"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 
@@ -33,6 +33,7 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 
 	"github.com/evmos/evmos/v11/ibc"
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	evmos "github.com/evmos/evmos/v11/types"
 	"github.com/evmos/evmos/v11/x/recovery/types"
 )
@@ -94,81 +95,18 @@ func (k Keeper) OnRecvPacket(
 	// get the recipient/sender account
 	account := k.accountKeeper.GetAccount(ctx, recipient)
 
-	// recovery is not supported for vesting or module accounts
-	if _, isVestingAcc := account.(vestexported.VestingAccount); isVestingAcc {
-		return ack
-	}
-
-	if _, isModuleAccount := account.(authtypes.ModuleAccountI); isModuleAccount {
-		return ack
-	}
-
-	// Check if recipient pubkey is a supported key (eth_secp256k1, amino multisig,
-	// ed25519). Continue and return success ACK as the funds are not stuck on
-	// chain for supported keys
-	if account != nil && evmos.IsSupportedKey(account.GetPubKey()) {
+	// Check if the account is recoverable. Continue and return success ACK for
+	// vesting or module accounts, as their recovery is not supported, and for
+	// supported keys (eth_secp256k1, amino multisig, ed25519), as the funds are
+	// not stuck on chain
+	if !isRecoverableAccount(account) {
 		return ack
 	}
 
 	// Perform recovery to transfer the balance back to the sender bech32 address.
 	// NOTE: Since destination channel is authorized and not from an EVM chain, we
 	// know that only secp256k1 keys are supported in the source chain.
-	var destPort, destChannel string
-	balances := sdk.Coins{}
-
-	// iterate over all tokens owned by the address (i.e recipient balance) and
-	// transfer them to the original sender address in the source chain (if
-	// applicable, see cases for IBC vouchers below).
-	k.bankKeeper.IterateAccountBalances(ctx, recipient, func(coin sdk.Coin) (stop bool) {
-		if coin.IsZero() {
-			// safety check: continue
-			return false
-		}
-
-		if strings.HasPrefix(coin.Denom, "ibc/") {
-			// IBC vouchers, obtain the destination port and channel from the denom path
-			destPort, destChannel, err = k.GetIBCDenomDestinationIdentifiers(ctx, coin.Denom, senderBech32)
-			if err != nil {
-				logger.Error(
-					"failed to get the IBC full denom path of source chain",
-					"error", err.Error(),
-				)
-				return true // stop iteration
-			}
-
-			// NOTE: only recover the IBC tokens from the source chain connected
-			// through our authorized destination channel
-			if packet.DestinationPort != destPort || packet.DestinationChannel != destChannel {
-				// continue
-				return false
-			}
-		}
-
-		// NOTE: Don't use the consensus state because it may become unreliable if updates slow down
-		timeout := uint64(ctx.BlockTime().Add(params.PacketTimeoutDuration).UnixNano())
-
-		// Recover the tokens to the bech32 prefixed address of the source chain
-
-		packetTransfer := &transfertypes.MsgTransfer{
-			SourcePort:       packet.DestinationPort,    // packet destination port is now the source
-			SourceChannel:    packet.DestinationChannel, // packet destination channel is now the source
-			Token:            coin,                      // balance of the coin
-			Sender:           recipient.String(),        // recipient is the address in the Evmos chain
-			Receiver:         senderBech32,              // transfer to your own account address on the source chain
-			TimeoutHeight:    clienttypes.ZeroHeight(),  // timeout height disabled
-			TimeoutTimestamp: timeout,                   // timeout timestamp is 4 hours from now
-			Memo:             "",
-		}
-
-		_, err = k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), packetTransfer)
-
-		if err != nil {
-			return true // stop iteration
-		}
-
-		balances = balances.Add(coin)
-		return false
-	})
+	balances, err := k.recoverBalances(ctx, recipient, packet.DestinationPort, packet.DestinationChannel, senderBech32)
 	// check error from the iteration above
 	if err != nil {
 		logger.Error(
@@ -206,19 +144,9 @@ func (k Keeper) OnRecvPacket(
 	)
 
 	defer func() {
-		telemetry.IncrCounter(1, types.ModuleName, "ibc", "on_recv", "total")
-
-		for _, b := range balances {
-			if b.Amount.IsInt64() {
-				telemetry.IncrCounterWithLabels(
-					[]string{types.ModuleName, "ibc", "on_recv", "token", "total"},
-					float32(b.Amount.Int64()),
-					[]metrics.Label{
-						telemetry.NewLabel("denom", b.Denom),
-					},
-				)
-			}
-		}
+		channelLabel := evmostelemetry.NewChannelLabel(packet.DestinationChannel)
+		evmostelemetry.IncrCounter(types.MetricKeyIBCOnRecv, channelLabel)
+		evmostelemetry.IncrCoins(types.MetricKeyIBCOnRecvAmount, balances, channelLabel)
 	}()
 
 	ctx.EventManager().EmitEvent(
@@ -238,6 +166,102 @@ func (k Keeper) OnRecvPacket(
 	return ack
 }
 
+// recoverBalances transfers the balances of the stuck address back to its
+// owner's address on the origin chain of the given channel. It sends all Evmos
+// native tokens and only the IBC vouchers that originated from the chain
+// connected through the given port and channel. The outbound packets are
+// persisted in a recovery record.
+func (k Keeper) recoverBalances(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	port, channel, originAddress string,
+) (balances sdk.Coins, err error) {
+	logger := k.Logger(ctx)
+	params := k.GetParams(ctx)
+
+	var destPort, destChannel string
+	balances = sdk.Coins{}
+	packets := []types.RecoveryPacket{}
+
+	// iterate over all tokens owned by the address (i.e stuck balance) and
+	// transfer them to the original sender address in the source chain (if
+	// applicable, see cases for IBC vouchers below).
+	k.bankKeeper.IterateAccountBalances(ctx, address, func(coin sdk.Coin) (stop bool) {
+		if coin.IsZero() {
+			// safety check: continue
+			return false
+		}
+
+		if strings.HasPrefix(coin.Denom, "ibc/") {
+			// IBC vouchers, obtain the destination port and channel from the denom path
+			destPort, destChannel, err = k.GetIBCDenomDestinationIdentifiers(ctx, coin.Denom, originAddress)
+			if err != nil {
+				logger.Error(
+					"failed to get the IBC full denom path of source chain",
+					"error", err.Error(),
+				)
+				return true // stop iteration
+			}
+
+			// NOTE: only recover the IBC tokens from the source chain connected
+			// through our authorized destination channel
+			if port != destPort || channel != destChannel {
+				// continue
+				return false
+			}
+		}
+
+		// NOTE: Don't use the consensus state because it may become unreliable if updates slow down
+		timeout := uint64(ctx.BlockTime().Add(params.PacketTimeoutDuration).UnixNano())
+
+		// Recover the tokens to the bech32 prefixed address of the source chain
+
+		packetTransfer := &transfertypes.MsgTransfer{
+			SourcePort:       port,                     // packet destination port is now the source
+			SourceChannel:    channel,                  // packet destination channel is now the source
+			Token:            coin,                     // balance of the coin
+			Sender:           address.String(),         // stuck address in the Evmos chain
+			Receiver:         originAddress,            // transfer to your own account address on the source chain
+			TimeoutHeight:    clienttypes.ZeroHeight(), // timeout height disabled
+			TimeoutTimestamp: timeout,                  // timeout timestamp is 4 hours from now
+			Memo:             "",
+		}
+
+		var res *transfertypes.MsgTransferResponse
+		res, err = k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), packetTransfer)
+		if err != nil {
+			return true // stop iteration
+		}
+
+		balances = balances.Add(coin)
+		packets = append(packets, types.NewRecoveryPacket(res.Sequence, coin, 0))
+		return false
+	})
+
+	if err != nil || len(packets) == 0 {
+		return balances, err
+	}
+
+	// track the outbound packets to process their acknowledgements and timeouts
+	k.createRecoveryRecord(ctx, address, originAddress, port, channel, packets)
+	return balances, nil
+}
+
+// isRecoverableAccount returns false for vesting and module accounts, as their
+// recovery is not supported, and for accounts with a supported key
+// (eth_secp256k1, amino multisig, ed25519), as their funds are not stuck.
+func isRecoverableAccount(account authtypes.AccountI) bool {
+	if _, isVestingAcc := account.(vestexported.VestingAccount); isVestingAcc {
+		return false
+	}
+
+	if _, isModuleAccount := account.(authtypes.ModuleAccountI); isModuleAccount {
+		return false
+	}
+
+	return account == nil || !evmos.IsSupportedKey(account.GetPubKey())
+}
+
 // GetIBCDenomDestinationIdentifiers returns the destination port and channel of
 // the IBC denomination, i.e port and channel on Evmos for the voucher. It
 // returns an error if:
@@ -306,3 +330,98 @@ func (k Keeper) GetIBCDenomDestinationIdentifiers(ctx sdk.Context, denom, sender
 
 	return destinationPort, destinationChannel, nil
 }
+
+// OnAcknowledgementPacket updates the status of an outbound recovery packet
+// once it is acknowledged. The refunded tokens of a packet with an error
+// acknowledgement are queued to be sent again if the packet has retries left.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) error {
+	id, found := k.GetRecoveryIDByPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		// not a recovery packet
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if ack.Success() {
+		k.updateRecoveryPacket(ctx, id, packet.Sequence, types.PacketStatusAcknowledged, "")
+	} else {
+		k.updateRecoveryPacket(ctx, id, packet.Sequence, types.PacketStatusFailed, ack.GetError())
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket updates the status of an outbound recovery packet that timed
+// out and queues its refunded tokens to be sent again if the packet has retries
+// left.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	id, found := k.GetRecoveryIDByPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		// not a recovery packet
+		return nil
+	}
+
+	k.updateRecoveryPacket(ctx, id, packet.Sequence, types.PacketStatusTimedOut, "packet timed out")
+	return nil
+}
+
+// updateRecoveryPacket sets the final status of a recovery packet and queues a
+// retry for failed and timed out packets if recovery is enabled and the packet
+// has retries left.
+func (k Keeper) updateRecoveryPacket(
+	ctx sdk.Context,
+	id, sequence uint64,
+	status types.PacketStatus,
+	errMsg string,
+) {
+	record, found := k.GetRecoveryRecord(ctx, id)
+	if !found {
+		return
+	}
+
+	i, found := record.PacketIndex(sequence)
+	if !found {
+		return
+	}
+
+	params := k.GetParams(ctx)
+
+	recoveryPacket := &record.Packets[i]
+	recoveryPacket.Status = status
+	recoveryPacket.Error = errMsg
+
+	if status != types.PacketStatusAcknowledged &&
+		params.EnableRecovery &&
+		recoveryPacket.Attempt < params.MaxRetries {
+		recoveryPacket.RetryQueued = true
+		k.EnqueueRetry(ctx, id, sequence)
+	}
+
+	record.UpdateStatus()
+	k.SetRecoveryRecord(ctx, record)
+
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyPacket,
+			evmostelemetry.NewChannelLabel(record.SourceChannel),
+			evmostelemetry.NewStatusLabel(status.String()),
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoveryPacket,
+			sdk.NewAttribute(types.AttributeKeyRecoveryID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+		),
+	)
+}
@@ -20,9 +20,14 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/recovery/types"
 )
 
@@ -42,3 +47,128 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// Recover implements the gRPC MsgServer interface. It recovers the balances
+// of a stuck secp256k1 address after verifying the offline signature of its
+// key. The balances are either sent back to the origin chain through an
+// authorized IBC channel or transferred to a receiver address on Evmos.
+func (k *Keeper) Recover(goCtx context.Context, msg *types.MsgRecover) (*types.MsgRecoverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableRecovery {
+		return nil, errorsmod.Wrap(types.ErrRecoveryDisabled, "recovery is disabled globally")
+	}
+
+	// the message is validated again as the validation is skipped when the
+	// message is nested in an authz MsgExec
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid stuck address")
+	}
+
+	nonce := k.GetRecoveryNonce(ctx, address)
+	if msg.Nonce != nonce {
+		return nil, errorsmod.Wrapf(types.ErrInvalidNonce, "expected nonce %d for address %s, got %d", nonce, msg.Address, msg.Nonce)
+	}
+
+	pubKey := &secp256k1.PubKey{Key: msg.PubKey}
+	if !address.Equals(sdk.AccAddress(pubKey.Address())) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "public key doesn't match the stuck address %s", msg.Address)
+	}
+
+	if !pubKey.VerifySignature(msg.GetRecoverSignBytes(ctx.ChainID()), msg.Signature) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignature, "signature verification failed for address %s", msg.Address)
+	}
+
+	// the signature is consumed by this recovery
+	k.SetRecoveryNonce(ctx, address, nonce+1)
+
+	if k.bankKeeper.BlockedAddr(address) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "stuck address %s is in the deny list", msg.Address)
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if !isRecoverableAccount(account) {
+		return nil, errorsmod.Wrapf(types.ErrNotRecoverable, "address %s is a vesting or module account or has a supported key", msg.Address)
+	}
+
+	// the account public key, if set, must match the provided key
+	if account != nil && account.GetPubKey() != nil && !account.GetPubKey().Equals(pubKey) {
+		return nil, errorsmod.Wrapf(types.ErrNotRecoverable, "public key doesn't match the account public key of %s", msg.Address)
+	}
+
+	var (
+		balances sdk.Coins
+		receiver string
+	)
+
+	if msg.Receiver != "" {
+		var receiverAddr sdk.AccAddress
+		receiverAddr, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid receiver address")
+		}
+
+		if k.bankKeeper.BlockedAddr(receiverAddr) {
+			return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "receiver address %s is in the deny list", msg.Receiver)
+		}
+
+		balances = k.bankKeeper.GetAllBalances(ctx, address)
+		if !balances.IsZero() {
+			err = k.bankKeeper.SendCoins(ctx, address, receiverAddr, balances)
+		}
+		receiver = msg.Receiver
+	} else {
+		claimsParams := k.claimsKeeper.GetParams(ctx)
+		if !claimsParams.IsAuthorizedChannel(msg.SourceChannel) || claimsParams.IsEVMChannel(msg.SourceChannel) {
+			return nil, errorsmod.Wrapf(
+				types.ErrUnauthorizedChannel,
+				"channel %s is not authorized or is an EVM channel", msg.SourceChannel,
+			)
+		}
+
+		balances, err = k.recoverBalances(ctx, address, transfertypes.PortID, msg.SourceChannel, msg.OriginAddress)
+		receiver = msg.OriginAddress
+	}
+
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to recover balances of %s", msg.Address)
+	}
+
+	if balances.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNoBalances, "address %s", msg.Address)
+	}
+
+	amtStr := balances.String()
+
+	k.Logger(ctx).Info(
+		"balances recovered by owner",
+		"address", msg.Address,
+		"receiver", receiver,
+		"amount", amtStr,
+		"source-channel", msg.SourceChannel,
+	)
+
+	defer func() {
+		channelLabel := evmostelemetry.NewChannelLabel(msg.SourceChannel)
+		evmostelemetry.IncrCounter(types.MetricKeyRecover, channelLabel)
+		evmostelemetry.IncrCoins(types.MetricKeyRecoverAmount, balances, channelLabel)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecovery,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+			sdk.NewAttribute(transfertypes.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amtStr),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, msg.SourceChannel),
+		),
+	)
+
+	return &types.MsgRecoverResponse{Recovered: balances}, nil
+}
@@ -1,8 +1,13 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v11/testutil"
 	"github.com/evmos/evmos/v11/x/recovery/types"
 )
 
@@ -39,3 +44,152 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRecover() {
+	priv := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(priv.PubKey().Address())
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)), sdk.NewCoin(ibcAtomDenom, sdk.NewInt(10)))
+
+	newMsg := func(signer *secp256k1.PrivKey) *types.MsgRecover {
+		msg := &types.MsgRecover{
+			Sender:   receiver.String(),
+			Address:  address.String(),
+			PubKey:   priv.PubKey().Bytes(),
+			Receiver: receiver.String(),
+		}
+		sig, err := signer.Sign(msg.GetRecoverSignBytes(suite.ctx.ChainID()))
+		suite.Require().NoError(err)
+		msg.Signature = sig
+		return msg
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgRecover
+		expPass  bool
+	}{
+		{
+			"fail - recovery disabled",
+			func() *types.MsgRecover {
+				params := suite.app.RecoveryKeeper.GetParams(suite.ctx)
+				params.EnableRecovery = false
+				suite.app.RecoveryKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+				return newMsg(priv)
+			},
+			false,
+		},
+		{
+			"fail - signature from a different key",
+			func() *types.MsgRecover {
+				return newMsg(secp256k1.GenPrivKey())
+			},
+			false,
+		},
+		{
+			"fail - public key and signature of a different key",
+			func() *types.MsgRecover {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, coins)
+				suite.Require().NoError(err)
+
+				attacker := secp256k1.GenPrivKey()
+				msg := newMsg(attacker)
+				msg.PubKey = attacker.PubKey().Bytes()
+				sig, err := attacker.Sign(msg.GetRecoverSignBytes(suite.ctx.ChainID()))
+				suite.Require().NoError(err)
+				msg.Signature = sig
+				return msg
+			},
+			false,
+		},
+		{
+			"fail - invalid receiver address",
+			func() *types.MsgRecover {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, coins)
+				suite.Require().NoError(err)
+
+				msg := newMsg(priv)
+				msg.Receiver = "evmos1invalid"
+				sig, err := priv.Sign(msg.GetRecoverSignBytes(suite.ctx.ChainID()))
+				suite.Require().NoError(err)
+				msg.Signature = sig
+				return msg
+			},
+			false,
+		},
+		{
+			"fail - signature for a different chain",
+			func() *types.MsgRecover {
+				msg := newMsg(priv)
+				sig, err := priv.Sign(msg.GetRecoverSignBytes("evmos_9001-2"))
+				suite.Require().NoError(err)
+				msg.Signature = sig
+				return msg
+			},
+			false,
+		},
+		{
+			"fail - nonce already used",
+			func() *types.MsgRecover {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, coins)
+				suite.Require().NoError(err)
+				suite.app.RecoveryKeeper.SetRecoveryNonce(suite.ctx, address, 1)
+				return newMsg(priv)
+			},
+			false,
+		},
+		{
+			"fail - signature for a different nonce",
+			func() *types.MsgRecover {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, coins)
+				suite.Require().NoError(err)
+				suite.app.RecoveryKeeper.SetRecoveryNonce(suite.ctx, address, 1)
+				msg := newMsg(priv)
+				msg.Nonce = 1
+				return msg
+			},
+			false,
+		},
+		{
+			"fail - no balances",
+			func() *types.MsgRecover {
+				return newMsg(priv)
+			},
+			false,
+		},
+		{
+			"pass - balances recovered to receiver",
+			func() *types.MsgRecover {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, coins)
+				suite.Require().NoError(err)
+				return newMsg(priv)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg := tc.malleate()
+			res, err := suite.app.RecoveryKeeper.Recover(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(coins, res.Recovered)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, address).IsZero())
+				suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, receiver))
+				suite.Require().Equal(uint64(1), suite.app.RecoveryKeeper.GetRecoveryNonce(suite.ctx, address))
+
+				// the same signature cannot be replayed
+				err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, coins)
+				suite.Require().NoError(err)
+				_, err = suite.app.RecoveryKeeper.Recover(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().ErrorIs(err, types.ErrInvalidNonce)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, receiver).IsZero())
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v11/x/recovery/types"
+)
+
+// GetNextRecoveryID returns the identifier of the next recovery record
+func (k Keeper) GetNextRecoveryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextRecoveryID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRecoveryID stores the identifier of the next recovery record
+func (k Keeper) SetNextRecoveryID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextRecoveryID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetRecoveryRecord returns the recovery record with the given identifier
+func (k Keeper) GetRecoveryRecord(ctx sdk.Context, id uint64) (types.RecoveryRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRecord)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if len(bz) == 0 {
+		return types.RecoveryRecord{}, false
+	}
+
+	var record types.RecoveryRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetRecoveryRecord stores a recovery record and indexes it by address and by
+// the sequences of its pending packets. The index of the packets that are no
+// longer pending is removed.
+func (k Keeper) SetRecoveryRecord(ctx sdk.Context, record types.RecoveryRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRecord)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(sdk.Uint64ToBigEndian(record.ID), bz)
+
+	addr := sdk.MustAccAddressFromBech32(record.Address)
+	addressStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryRecordByAddress)
+	addressStore.Set(append(types.GetRecoveryRecordByAddressPrefix(addr), sdk.Uint64ToBigEndian(record.ID)...), []byte{1})
+
+	packetStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryPacket)
+	for _, packet := range record.Packets {
+		key := types.GetRecoveryPacketKey(record.SourcePort, record.SourceChannel, packet.Sequence)
+		if packet.Status == types.PacketStatusPending {
+			packetStore.Set(key, sdk.Uint64ToBigEndian(record.ID))
+		} else {
+			packetStore.Delete(key)
+		}
+	}
+}
+
+// GetRecoveryIDByPacket returns the identifier of the recovery record that
+// sent the pending packet with the given port, channel and sequence
+func (k Keeper) GetRecoveryIDByPacket(ctx sdk.Context, port, channel string, sequence uint64) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryPacket)
+	bz := store.Get(types.GetRecoveryPacketKey(port, channel, sequence))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// IterateRecoveryRecords iterates over all the recovery records in ascending
+// identifier order and performs a callback function
+func (k Keeper) IterateRecoveryRecords(ctx sdk.Context, cb func(record types.RecoveryRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRecoveryRecord)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.RecoveryRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllRecoveryRecords returns all the recovery records
+func (k Keeper) GetAllRecoveryRecords(ctx sdk.Context) []types.RecoveryRecord {
+	records := []types.RecoveryRecord{}
+	k.IterateRecoveryRecords(ctx, func(record types.RecoveryRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// createRecoveryRecord stores a new pending recovery record for the given
+// outbound packets and returns it
+func (k Keeper) createRecoveryRecord(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	receiver, port, channel string,
+	packets []types.RecoveryPacket,
+) types.RecoveryRecord {
+	id := k.GetNextRecoveryID(ctx)
+	record := types.NewRecoveryRecord(id, address, receiver, port, channel, ctx.BlockHeight(), packets)
+
+	k.SetRecoveryRecord(ctx, record)
+	k.SetNextRecoveryID(ctx, id+1)
+	return record
+}
+
+// EnqueueRetry queues the retry of a failed or timed out recovery packet
+func (k Keeper) EnqueueRetry(ctx sdk.Context, id, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRetryQueue)
+	store.Set(types.GetRetryQueueKey(id, sequence), []byte{1})
+}
+
+// IterateRetryQueue iterates over the queued retries in ascending recovery
+// identifier and sequence order and performs a callback function
+func (k Keeper) IterateRetryQueue(ctx sdk.Context, cb func(id, sequence uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRetryQueue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixRetryQueue):]
+		if cb(sdk.BigEndianToUint64(key[:8]), sdk.BigEndianToUint64(key[8:])) {
+			break
+		}
+	}
+}
+
+// DeleteRetry removes a queued retry
+func (k Keeper) DeleteRetry(ctx sdk.Context, id, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRetryQueue)
+	store.Delete(types.GetRetryQueueKey(id, sequence))
+}
+
+// GetRecoveryNonce returns the nonce that the next MsgRecover of an address
+// must sign
+func (k Keeper) GetRecoveryNonce(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryNonce)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetRecoveryNonce stores the recovery nonce of an address
+func (k Keeper) SetRecoveryNonce(ctx sdk.Context, addr sdk.AccAddress, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryNonce)
+	store.Set(addr.Bytes(), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetAllRecoveryNonces returns the recovery nonces of all the addresses
+// recovered with MsgRecover
+func (k Keeper) GetAllRecoveryNonces(ctx sdk.Context) []types.RecoveryNonce {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRecoveryNonce)
+	defer iterator.Close()
+
+	nonces := []types.RecoveryNonce{}
+	for ; iterator.Valid(); iterator.Next() {
+		nonces = append(nonces, types.RecoveryNonce{
+			Address: sdk.AccAddress(iterator.Key()[len(types.KeyPrefixRecoveryNonce):]).String(),
+			Nonce:   sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return nonces
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v11/testutil"
+	"github.com/evmos/evmos/v11/x/recovery/keeper"
+	"github.com/evmos/evmos/v11/x/recovery/types"
+)
+
+func (suite *KeeperTestSuite) TestRecoveryPacketTracking() {
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coin := sdk.NewCoin("aevmos", sdk.NewInt(1000))
+	channel := "channel-0"
+	sequence := uint64(10)
+
+	packet := channeltypes.Packet{
+		Sequence:      sequence,
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: channel,
+	}
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed")).Acknowledgement()
+
+	testCases := []struct {
+		name       string
+		maxRetries uint32
+		malleate   func()
+		expStatus  types.RecoveryStatus
+		expPackets []types.PacketStatus
+	}{
+		{
+			"acknowledged packet",
+			3,
+			func() {
+				err := suite.app.RecoveryKeeper.OnAcknowledgementPacket(suite.ctx, packet, successAck)
+				suite.Require().NoError(err)
+			},
+			types.RecoveryStatusCompleted,
+			[]types.PacketStatus{types.PacketStatusAcknowledged},
+		},
+		{
+			"error ack without retries",
+			0,
+			func() {
+				err := suite.app.RecoveryKeeper.OnAcknowledgementPacket(suite.ctx, packet, errorAck)
+				suite.Require().NoError(err)
+				suite.app.RecoveryKeeper.EndBlocker(suite.ctx)
+			},
+			types.RecoveryStatusFailed,
+			[]types.PacketStatus{types.PacketStatusFailed},
+		},
+		{
+			"timed out packet queued for retry",
+			3,
+			func() {
+				err := suite.app.RecoveryKeeper.OnTimeoutPacket(suite.ctx, packet)
+				suite.Require().NoError(err)
+			},
+			types.RecoveryStatusPending,
+			[]types.PacketStatus{types.PacketStatusTimedOut},
+		},
+		{
+			"timed out packet without refunded balance",
+			3,
+			func() {
+				err := suite.app.RecoveryKeeper.OnTimeoutPacket(suite.ctx, packet)
+				suite.Require().NoError(err)
+				suite.app.RecoveryKeeper.EndBlocker(suite.ctx)
+			},
+			types.RecoveryStatusFailed,
+			[]types.PacketStatus{types.PacketStatusTimedOut},
+		},
+		{
+			"timed out packet retried and acknowledged",
+			3,
+			func() {
+				err := suite.app.RecoveryKeeper.OnTimeoutPacket(suite.ctx, packet)
+				suite.Require().NoError(err)
+
+				// refund of the timed out packet
+				err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, sdk.NewCoins(coin))
+				suite.Require().NoError(err)
+
+				suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+				suite.app.RecoveryKeeper.EndBlocker(suite.ctx)
+
+				// the events of the retried transfer are emitted once
+				events := suite.ctx.EventManager().Events()
+				expEvents := []string{
+					banktypes.EventTypeCoinSpent,
+					banktypes.EventTypeCoinReceived,
+					banktypes.EventTypeTransfer,
+					sdk.EventTypeMessage,
+					types.EventTypeRecoveryRetry,
+				}
+				suite.Require().Len(events, len(expEvents))
+				for i, eventType := range expEvents {
+					suite.Require().Equal(eventType, events[i].Type)
+				}
+
+				retryPacket := packet
+				retryPacket.Sequence = 1
+				err = suite.app.RecoveryKeeper.OnAcknowledgementPacket(suite.ctx, retryPacket, successAck)
+				suite.Require().NoError(err)
+			},
+			types.RecoveryStatusCompleted,
+			[]types.PacketStatus{types.PacketStatusRetried, types.PacketStatusAcknowledged},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			mockTransferKeeper := &MockTransferKeeper{
+				Keeper: suite.app.BankKeeper,
+			}
+			mockTransferKeeper.On("Transfer", mock.Anything, mock.Anything).Return(nil, nil)
+
+			suite.app.RecoveryKeeper = keeper.NewKeeper(
+				suite.app.GetKey(types.StoreKey),
+				suite.app.AppCodec(),
+				authtypes.NewModuleAddress(govtypes.ModuleName),
+				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.IBCKeeper.ChannelKeeper, mockTransferKeeper, suite.app.ClaimsKeeper)
+
+			params := types.DefaultParams()
+			params.MaxRetries = tc.maxRetries
+			err := suite.app.RecoveryKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			record := types.NewRecoveryRecord(
+				1, address, "osmo1receiver", transfertypes.PortID, channel, suite.ctx.BlockHeight(),
+				[]types.RecoveryPacket{types.NewRecoveryPacket(sequence, coin, 0)},
+			)
+			suite.app.RecoveryKeeper.SetRecoveryRecord(suite.ctx, record)
+
+			tc.malleate()
+
+			res, err := suite.app.RecoveryKeeper.RecoveryRecords(
+				sdk.WrapSDKContext(suite.ctx),
+				&types.QueryRecoveryRecordsRequest{Address: address.String()},
+			)
+			suite.Require().NoError(err)
+			suite.Require().Len(res.RecoveryRecords, 1)
+
+			record = res.RecoveryRecords[0]
+			suite.Require().Equal(tc.expStatus, record.Status)
+			suite.Require().Len(record.Packets, len(tc.expPackets))
+			for i, status := range tc.expPackets {
+				suite.Require().Equal(status, record.Packets[i].Status)
+				suite.Require().Equal(uint32(i), record.Packets[i].Attempt)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecoveryRecordQuery() {
+	suite.SetupTest()
+
+	_, err := suite.app.RecoveryKeeper.RecoveryRecord(sdk.WrapSDKContext(suite.ctx), &types.QueryRecoveryRecordRequest{Id: 1})
+	suite.Require().Error(err)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	record := types.NewRecoveryRecord(
+		1, address, "osmo1receiver", transfertypes.PortID, "channel-0", 1,
+		[]types.RecoveryPacket{types.NewRecoveryPacket(1, sdk.NewCoin("aevmos", sdk.NewInt(1)), 0)},
+	)
+	suite.app.RecoveryKeeper.SetRecoveryRecord(suite.ctx, record)
+
+	res, err := suite.app.RecoveryKeeper.RecoveryRecord(sdk.WrapSDKContext(suite.ctx), &types.QueryRecoveryRecordRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(record, res.RecoveryRecord)
+}
@@ -24,6 +24,9 @@ var _ types.TransferKeeper = &MockTransferKeeper{}
 type MockTransferKeeper struct {
 	mock.Mock
 	bankkeeper.Keeper
+
+	// sequence is the sequence of the last mocked transfer packet
+	sequence uint64
 }
 
 func (m *MockTransferKeeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (transfertypes.DenomTrace, bool) {
@@ -47,5 +50,10 @@ func (m *MockTransferKeeper) Transfer(goCtx context.Context, msg *transfertypes.
 		return nil, err
 	}
 
-	return nil, args.Error(1)
+	if err := args.Error(1); err != nil {
+		return nil, err
+	}
+
+	m.sequence++
+	return &transfertypes.MsgTransferResponse{Sequence: m.sequence}, nil
 }
@@ -0,0 +1,34 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/evmos/v11/app"
+	v3 "github.com/evmos/evmos/v11/x/recovery/migrations/v3"
+	"github.com/evmos/evmos/v11/x/recovery/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before MaxRetries was introduced
+	inputParams := types.NewParams(false, time.Hour, 0)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&inputParams))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+
+	require.Equal(t, types.NewParams(false, time.Hour, types.DefaultMaxRetries), params)
+}
@@ -22,5 +22,11 @@ import (
 
 // errors
 var (
-	ErrBlockedAddress = errorsmod.Register(ModuleName, 2, "blocked address")
+	ErrBlockedAddress      = errorsmod.Register(ModuleName, 2, "blocked address")
+	ErrRecoveryDisabled    = errorsmod.Register(ModuleName, 3, "recovery is disabled")
+	ErrInvalidSignature    = errorsmod.Register(ModuleName, 4, "invalid recovery signature")
+	ErrNotRecoverable      = errorsmod.Register(ModuleName, 5, "account is not recoverable")
+	ErrUnauthorizedChannel = errorsmod.Register(ModuleName, 6, "unauthorized recovery channel")
+	ErrNoBalances          = errorsmod.Register(ModuleName, 7, "no balances to recover")
+	ErrInvalidNonce        = errorsmod.Register(ModuleName, 8, "invalid recovery nonce")
 )
@@ -18,5 +18,11 @@ package types
 
 // recovery events
 const (
-	EventTypeRecovery = "recovery"
+	EventTypeRecovery       = "recovery"
+	EventTypeRecoveryPacket = "recovery_packet"
+	EventTypeRecoveryRetry  = "recovery_retry"
+
+	AttributeKeyRecoveryID = "recovery_id"
+	AttributeKeyStatus     = "status"
+	AttributeKeyAttempt    = "attempt"
 )
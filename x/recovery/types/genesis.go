@@ -16,10 +16,17 @@
 
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(params Params, records []RecoveryRecord) GenesisState {
 	return GenesisState{
-		Params: params,
+		Params:          params,
+		RecoveryRecords: records,
 	}
 }
 
@@ -33,5 +40,28 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seenIDs := make(map[uint64]bool)
+	for _, record := range gs.RecoveryRecords {
+		if seenIDs[record.ID] {
+			return fmt.Errorf("duplicated recovery record id %d", record.ID)
+		}
+		seenIDs[record.ID] = true
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, nonce := range gs.RecoveryNonces {
+		if _, err := sdk.AccAddressFromBech32(nonce.Address); err != nil {
+			return fmt.Errorf("invalid recovery nonce address %s: %w", nonce.Address, err)
+		}
+		if seenAddresses[nonce.Address] {
+			return fmt.Errorf("duplicated recovery nonce address %s", nonce.Address)
+		}
+		seenAddresses[nonce.Address] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -31,6 +31,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// recovery_records defines the recovery attempts with their outbound packets
+	RecoveryRecords []RecoveryRecord `protobuf:"bytes,2,rep,name=recovery_records,json=recoveryRecords,proto3" json:"recovery_records"`
+	// recovery_nonces defines the recovery nonces of the addresses that have
+	// been recovered with MsgRecover
+	RecoveryNonces []RecoveryNonce `protobuf:"bytes,3,rep,name=recovery_nonces,json=recoveryNonces,proto3" json:"recovery_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,19 +78,91 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRecoveryRecords() []RecoveryRecord {
+	if m != nil {
+		return m.RecoveryRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecoveryNonces() []RecoveryNonce {
+	if m != nil {
+		return m.RecoveryNonces
+	}
+	return nil
+}
+
+// RecoveryNonce defines the recovery nonce of a stuck address
+type RecoveryNonce struct {
+	// address is the bech32 address of the stuck address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce is the nonce that the next MsgRecover of the address must sign
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *RecoveryNonce) Reset()         { *m = RecoveryNonce{} }
+func (m *RecoveryNonce) String() string { return proto.CompactTextString(m) }
+func (*RecoveryNonce) ProtoMessage()    {}
+func (*RecoveryNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3e70cb61e26f25, []int{1}
+}
+func (m *RecoveryNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryNonce.Merge(m, src)
+}
+func (m *RecoveryNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryNonce proto.InternalMessageInfo
+
+func (m *RecoveryNonce) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RecoveryNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // Params holds parameters for the recovery module
 type Params struct {
 	// enable_recovery IBC middleware
 	EnableRecovery bool `protobuf:"varint,1,opt,name=enable_recovery,json=enableRecovery,proto3" json:"enable_recovery,omitempty"`
 	// packet_timeout_duration is the duration added to timeout timestamp for balances recovered via IBC packets
 	PacketTimeoutDuration time.Duration `protobuf:"bytes,2,opt,name=packet_timeout_duration,json=packetTimeoutDuration,proto3,stdduration" json:"packet_timeout_duration"`
+	// max_retries is the maximum number of times the refunded tokens of a failed
+	// or timed out recovery packet are sent again
+	MaxRetries uint32 `protobuf:"varint,3,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a3e70cb61e26f25, []int{1}
+	return fileDescriptor_8a3e70cb61e26f25, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,34 +205,49 @@ func (m *Params) GetPacketTimeoutDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.recovery.v1.GenesisState")
+	proto.RegisterType((*RecoveryNonce)(nil), "evmos.recovery.v1.RecoveryNonce")
 	proto.RegisterType((*Params)(nil), "evmos.recovery.v1.Params")
 }
 
 func init() { proto.RegisterFile("evmos/recovery/v1/genesis.proto", fileDescriptor_8a3e70cb61e26f25) }
 
 var fileDescriptor_8a3e70cb61e26f25 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4b, 0xc4, 0x30,
-	0x14, 0xc6, 0x1b, 0x91, 0xe3, 0x88, 0xa2, 0x58, 0x14, 0xcf, 0x1b, 0x72, 0x72, 0x8b, 0x82, 0x90,
-	0x58, 0x1d, 0xdc, 0x0f, 0xe5, 0x56, 0xa9, 0x4e, 0x3a, 0x94, 0xb4, 0x3e, 0x63, 0xf1, 0xda, 0x57,
-	0xda, 0xb4, 0x78, 0xff, 0x84, 0x38, 0xfa, 0x27, 0xdd, 0x78, 0xa3, 0x93, 0x4a, 0xfb, 0x8f, 0xc8,
-	0x25, 0xad, 0x0e, 0x2e, 0x21, 0x79, 0xdf, 0x2f, 0xdf, 0xf7, 0xf1, 0xe8, 0x08, 0xaa, 0x04, 0x0b,
-	0x91, 0x43, 0x84, 0x15, 0xe4, 0x73, 0x51, 0x79, 0x42, 0x41, 0x0a, 0x45, 0x5c, 0xf0, 0x2c, 0x47,
-	0x8d, 0xee, 0x8e, 0x01, 0x78, 0x07, 0xf0, 0xca, 0x1b, 0xee, 0x2a, 0x54, 0x68, 0x54, 0xb1, 0xba,
-	0x59, 0x70, 0xc8, 0x14, 0xa2, 0x9a, 0x81, 0x30, 0xaf, 0xb0, 0x7c, 0x14, 0x0f, 0x65, 0x2e, 0x75,
-	0x8c, 0xa9, 0xd5, 0xc7, 0x53, 0xba, 0x39, 0xb5, 0xce, 0x37, 0x5a, 0x6a, 0x70, 0x2f, 0x68, 0x2f,
-	0x93, 0xb9, 0x4c, 0x8a, 0x01, 0x39, 0x24, 0xc7, 0x1b, 0x67, 0x07, 0xfc, 0x5f, 0x12, 0xbf, 0x36,
-	0xc0, 0x64, 0x7d, 0xf1, 0x39, 0x72, 0xfc, 0x16, 0x1f, 0xbf, 0x12, 0xda, 0xb3, 0x82, 0x7b, 0x44,
-	0xb7, 0x21, 0x95, 0xe1, 0x0c, 0x82, 0xee, 0x97, 0x31, 0xeb, 0xfb, 0x5b, 0x76, 0xec, 0xb7, 0x53,
-	0xf7, 0x9e, 0xee, 0x67, 0x32, 0x7a, 0x06, 0x1d, 0xe8, 0x38, 0x01, 0x2c, 0x75, 0xd0, 0xb5, 0x1b,
-	0xac, 0xb5, 0xe9, 0xb6, 0x3e, 0xef, 0xea, 0xf3, 0xcb, 0x16, 0x98, 0xf4, 0x57, 0xe9, 0xef, 0x5f,
-	0x23, 0xe2, 0xef, 0x59, 0x8f, 0x5b, 0x6b, 0xf1, 0x0b, 0x5c, 0x2d, 0x6a, 0x46, 0x96, 0x35, 0x23,
-	0xdf, 0x35, 0x23, 0x6f, 0x0d, 0x73, 0x96, 0x0d, 0x73, 0x3e, 0x1a, 0xe6, 0xdc, 0x9d, 0xa8, 0x58,
-	0x3f, 0x95, 0x21, 0x8f, 0x30, 0x11, 0x76, 0xd1, 0xf6, 0xac, 0xbc, 0x53, 0xf1, 0xf2, 0xb7, 0x74,
-	0x3d, 0xcf, 0xa0, 0x08, 0x7b, 0x26, 0xfa, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x7f, 0x73,
-	0x69, 0x93, 0x01, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0xe3, 0x6b, 0x39, 0x8a, 0x8f, 0xb6, 0x60, 0x15, 0x11, 0x3a, 0x24, 0xe1, 0x16, 0x22,
+	0x21, 0xd9, 0x4a, 0x19, 0x18, 0x91, 0x4e, 0x20, 0x36, 0x40, 0x86, 0x09, 0x86, 0xc8, 0x49, 0x4c,
+	0x88, 0x68, 0xe2, 0xc8, 0x76, 0xa2, 0xeb, 0xb7, 0x60, 0xe4, 0x5b, 0xf0, 0x35, 0x3a, 0x76, 0x64,
+	0x02, 0x74, 0xb7, 0xf2, 0x21, 0x50, 0xec, 0xf8, 0xe0, 0x74, 0xa8, 0x8b, 0xf5, 0xde, 0xdf, 0x3f,
+	0xff, 0x9f, 0x9f, 0x9f, 0x61, 0xc8, 0xfb, 0x5a, 0x28, 0x22, 0x79, 0x2e, 0x7a, 0x2e, 0x2f, 0x48,
+	0x9f, 0x90, 0x92, 0x37, 0x5c, 0x55, 0x0a, 0xb7, 0x52, 0x68, 0x81, 0xee, 0x1a, 0x00, 0x3b, 0x00,
+	0xf7, 0xc9, 0x69, 0xb4, 0x7b, 0x66, 0xb3, 0x6d, 0x0e, 0x9d, 0x9e, 0x94, 0xa2, 0x14, 0x26, 0x24,
+	0x43, 0x34, 0xaa, 0x41, 0x29, 0x44, 0x79, 0xce, 0x89, 0xc9, 0xb2, 0xee, 0x23, 0x29, 0x3a, 0xc9,
+	0x74, 0x25, 0x1a, 0xbb, 0x3f, 0xff, 0x0d, 0xe0, 0xed, 0x97, 0xb6, 0xf8, 0x5b, 0xcd, 0x34, 0x47,
+	0x4f, 0xe1, 0xb4, 0x65, 0x92, 0xd5, 0xca, 0x07, 0x11, 0x88, 0x67, 0x67, 0x0f, 0xf0, 0xce, 0x65,
+	0xf0, 0x1b, 0x03, 0x2c, 0xf6, 0x2f, 0x7f, 0x84, 0x1e, 0x1d, 0x71, 0x44, 0xe1, 0x1d, 0xc7, 0xa4,
+	0x43, 0x20, 0x0b, 0xe5, 0x4f, 0xa2, 0xbd, 0x78, 0x76, 0xf6, 0xf0, 0x3f, 0x16, 0x74, 0x8c, 0xa9,
+	0x21, 0x47, 0xab, 0x63, 0xb9, 0xa5, 0x2a, 0xf4, 0x1a, 0x6e, 0xa4, 0xb4, 0x11, 0x4d, 0xce, 0x95,
+	0xbf, 0x67, 0x2c, 0xa3, 0x6b, 0x2c, 0x5f, 0x0d, 0xe0, 0xe8, 0x78, 0x24, 0xff, 0x15, 0xd5, 0xfc,
+	0x19, 0x3c, 0xdc, 0xc2, 0x90, 0x0f, 0x6f, 0xb2, 0xa2, 0x90, 0x5c, 0xd9, 0x7e, 0x6f, 0x51, 0x97,
+	0xa2, 0x13, 0x78, 0xc3, 0x94, 0xf4, 0x27, 0x11, 0x88, 0xf7, 0xa9, 0x4d, 0xe6, 0xdf, 0x00, 0x9c,
+	0xda, 0xf6, 0xd1, 0x23, 0x78, 0xcc, 0x1b, 0x96, 0x9d, 0xf3, 0xd4, 0x15, 0x31, 0x16, 0x07, 0xf4,
+	0xc8, 0xca, 0xae, 0x10, 0xfa, 0x00, 0xef, 0xb7, 0x2c, 0xff, 0xcc, 0x75, 0xaa, 0xab, 0x9a, 0x8b,
+	0x4e, 0xa7, 0x6e, 0x08, 0xfe, 0x64, 0x7c, 0x63, 0x3b, 0x25, 0xec, 0xa6, 0x84, 0x9f, 0x8f, 0xc0,
+	0xe2, 0x60, 0x68, 0xe3, 0xeb, 0xcf, 0x10, 0xd0, 0x7b, 0xd6, 0xe3, 0x9d, 0xb5, 0x70, 0x00, 0x0a,
+	0xe1, 0xac, 0x66, 0xcb, 0x54, 0x72, 0x2d, 0x2b, 0xf3, 0x3c, 0x20, 0x3e, 0xa4, 0xb0, 0x66, 0x4b,
+	0x6a, 0x95, 0xc5, 0x8b, 0xcb, 0x55, 0x00, 0xae, 0x56, 0x01, 0xf8, 0xb5, 0x0a, 0xc0, 0x97, 0x75,
+	0xe0, 0x5d, 0xad, 0x03, 0xef, 0xfb, 0x3a, 0xf0, 0xde, 0x3f, 0x2e, 0x2b, 0xfd, 0xa9, 0xcb, 0x70,
+	0x2e, 0x6a, 0x62, 0xbf, 0x97, 0x5d, 0xfb, 0x24, 0x21, 0xcb, 0xbf, 0x5f, 0x4d, 0x5f, 0xb4, 0x5c,
+	0x65, 0x53, 0x73, 0xb7, 0x27, 0x7f, 0x06, 0x00, 0x02, 0xe4, 0x38, 0x4d, 0xbd, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +270,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecoveryNonces) > 0 {
+		for iNdEx := len(m.RecoveryNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveryNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecoveryRecords) > 0 {
+		for iNdEx := len(m.RecoveryRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveryRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -191,6 +311,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecoveryNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -211,6 +366,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x18
+	}
 	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PacketTimeoutDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PacketTimeoutDuration):])
 	if err2 != nil {
 		return 0, err2
@@ -251,6 +411,34 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecoveryRecords) > 0 {
+		for _, e := range m.RecoveryRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecoveryNonces) > 0 {
+		for _, e := range m.RecoveryNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecoveryNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -265,6 +453,9 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PacketTimeoutDuration)
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRetries))
+	}
 	return n
 }
 
@@ -336,6 +527,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryRecords = append(m.RecoveryRecords, RecoveryRecord{})
+			if err := m.RecoveryRecords[len(m.RecoveryRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryNonces = append(m.RecoveryNonces, RecoveryNonce{})
+			if err := m.RecoveryNonces[len(m.RecoveryNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -439,6 +799,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,10 +4,17 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
 )
 
 func TestGenesisValidate(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	packets := []RecoveryPacket{NewRecoveryPacket(1, sdk.NewCoin("aevmos", sdk.NewInt(1)), 0)}
+	record := NewRecoveryRecord(1, addr, "osmo1receiver", "transfer", "channel-0", 1, packets)
+
 	testCases := []struct {
 		name     string
 		genesis  GenesisState
@@ -25,9 +32,57 @@ func TestGenesisValidate(t *testing.T) {
 		},
 		{
 			"custom genesis",
-			NewGenesisState(NewParams(true, time.Hour)),
+			NewGenesisState(NewParams(true, time.Hour, 3), nil),
+			false,
+		},
+		{
+			"genesis with recovery records",
+			NewGenesisState(DefaultParams(), []RecoveryRecord{record}),
+			false,
+		},
+		{
+			"duplicated recovery record id",
+			NewGenesisState(DefaultParams(), []RecoveryRecord{record, record}),
+			true,
+		},
+		{
+			"invalid recovery record - no packets",
+			NewGenesisState(DefaultParams(), []RecoveryRecord{
+				NewRecoveryRecord(1, addr, "osmo1receiver", "transfer", "channel-0", 1, nil),
+			}),
+			true,
+		},
+		{
+			"invalid recovery record - duplicated packet",
+			NewGenesisState(DefaultParams(), []RecoveryRecord{
+				NewRecoveryRecord(1, addr, "osmo1receiver", "transfer", "channel-0", 1, append(packets, packets...)),
+			}),
+			true,
+		},
+		{
+			"genesis with recovery nonces",
+			GenesisState{
+				Params:         DefaultParams(),
+				RecoveryNonces: []RecoveryNonce{{Address: addr.String(), Nonce: 2}},
+			},
 			false,
 		},
+		{
+			"invalid recovery nonce address",
+			GenesisState{
+				Params:         DefaultParams(),
+				RecoveryNonces: []RecoveryNonce{{Address: "evmos1invalid", Nonce: 2}},
+			},
+			true,
+		},
+		{
+			"duplicated recovery nonce address",
+			GenesisState{
+				Params:         DefaultParams(),
+				RecoveryNonces: []RecoveryNonce{{Address: addr.String(), Nonce: 2}, {Address: addr.String(), Nonce: 3}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
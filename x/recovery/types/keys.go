@@ -16,6 +16,13 @@
 
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 // constants
 const (
 	// ModuleName defines the recovery module name
@@ -27,3 +34,39 @@ const (
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
 )
+
+// prefix bytes for the recovery module's persistent store
+const (
+	prefixRecoveryRecord = iota + 1
+	prefixRecoveryRecordByAddress
+	prefixRecoveryPacket
+	prefixRetryQueue
+	prefixNextRecoveryID
+	prefixRecoveryNonce
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRecoveryRecord          = []byte{prefixRecoveryRecord}
+	KeyPrefixRecoveryRecordByAddress = []byte{prefixRecoveryRecordByAddress}
+	KeyPrefixRecoveryPacket          = []byte{prefixRecoveryPacket}
+	KeyPrefixRetryQueue              = []byte{prefixRetryQueue}
+	KeyNextRecoveryID                = []byte{prefixNextRecoveryID}
+	KeyPrefixRecoveryNonce           = []byte{prefixRecoveryNonce}
+)
+
+// GetRecoveryRecordByAddressPrefix returns the key prefix of the recovery
+// identifiers of an address
+func GetRecoveryRecordByAddressPrefix(addr sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(addr)
+}
+
+// GetRecoveryPacketKey returns the key of an outbound recovery packet
+func GetRecoveryPacketKey(port, channel string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", port, channel)), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetRetryQueueKey returns the key of a queued retry of a recovery packet
+func GetRetryQueueKey(id, sequence uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(id), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -17,11 +17,32 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRecover{}
+)
+
+const (
+	TypeMsgUpdateParams = "update_params"
+	TypeMsgRecover      = "recover"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+// Route returns the message route for a MsgUpdateParams message.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams message.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -46,3 +67,118 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// Route returns the message route for a MsgRecover message.
+func (m MsgRecover) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRecover message.
+func (m MsgRecover) Type() string { return TypeMsgRecover }
+
+// GetSigners returns the expected signers for a MsgRecover message.
+func (m *MsgRecover) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRecover) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	address, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid stuck address")
+	}
+
+	if len(m.PubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidPubKey,
+			"invalid secp256k1 public key length, expected %d, got %d", secp256k1.PubKeySize, len(m.PubKey),
+		)
+	}
+
+	pubKey := &secp256k1.PubKey{Key: m.PubKey}
+	if !address.Equals(sdk.AccAddress(pubKey.Address())) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidPubKey,
+			"public key address %s doesn't match the stuck address %s", sdk.AccAddress(pubKey.Address()), m.Address,
+		)
+	}
+
+	if len(m.Signature) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignature, "signature cannot be empty")
+	}
+
+	isLocal := m.Receiver != ""
+	isIBC := m.SourceChannel != "" || m.OriginAddress != ""
+
+	switch {
+	case isLocal && isIBC:
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "receiver cannot be set together with the source channel and origin address")
+	case isLocal:
+		receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid receiver address")
+		}
+
+		if receiver.Equals(address) {
+			return errorsmod.Wrap(errortypes.ErrInvalidAddress, "receiver cannot be the stuck address")
+		}
+	case isIBC:
+		if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+			return errorsmod.Wrap(err, "invalid source channel")
+		}
+
+		_, originBz, err := bech32.DecodeAndConvert(m.OriginAddress)
+		if err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid origin address: %s", err)
+		}
+
+		// the origin address must be derived from the same key as the stuck address
+		if !bytes.Equal(originBz, address) {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidAddress,
+				"origin address %s doesn't match the stuck address %s", m.OriginAddress, m.Address,
+			)
+		}
+	default:
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "either the receiver or the source channel and origin address must be set")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRecover) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// recoverPayload defines the payload signed by the stuck address key
+type recoverPayload struct {
+	ChainID       string `json:"chain_id"`
+	Address       string `json:"address"`
+	Receiver      string `json:"receiver"`
+	SourceChannel string `json:"source_channel"`
+	OriginAddress string `json:"origin_address"`
+	Nonce         uint64 `json:"nonce,string"`
+}
+
+// GetRecoverSignBytes returns the canonical bytes that must be signed with the
+// secp256k1 key of the stuck address to authorize the recovery. The chain ID
+// and the recovery nonce of the address are included to prevent replaying the
+// signature on other chains and on the same chain.
+func (m MsgRecover) GetRecoverSignBytes(chainID string) []byte {
+	bz, err := json.Marshal(recoverPayload{
+		ChainID:       chainID,
+		Address:       m.Address,
+		Receiver:      m.Receiver,
+		SourceChannel: m.SourceChannel,
+		OriginAddress: m.OriginAddress,
+		Nonce:         m.Nonce,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+func TestMsgRecoverValidateBasic(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(priv.PubKey().Address())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	originAddress, err := bech32.ConvertAndEncode("osmo", address)
+	require.NoError(t, err)
+	otherOrigin, err := bech32.ConvertAndEncode("osmo", other)
+	require.NoError(t, err)
+
+	newMsg := func(receiver, channel, origin string) MsgRecover {
+		return MsgRecover{
+			Sender:        other.String(),
+			Address:       address.String(),
+			PubKey:        priv.PubKey().Bytes(),
+			Signature:     []byte("signature"),
+			Receiver:      receiver,
+			SourceChannel: channel,
+			OriginAddress: origin,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *MsgRecover)
+		msg      MsgRecover
+		expPass  bool
+	}{
+		{"fail - invalid sender", func(msg *MsgRecover) { msg.Sender = "invalid" }, newMsg(other.String(), "", ""), false},
+		{"fail - invalid address", func(msg *MsgRecover) { msg.Address = "invalid" }, newMsg(other.String(), "", ""), false},
+		{"fail - invalid pubkey length", func(msg *MsgRecover) { msg.PubKey = []byte{1} }, newMsg(other.String(), "", ""), false},
+		{"fail - pubkey mismatch", func(msg *MsgRecover) { msg.PubKey = secp256k1.GenPrivKey().PubKey().Bytes() }, newMsg(other.String(), "", ""), false},
+		{"fail - empty signature", func(msg *MsgRecover) { msg.Signature = nil }, newMsg(other.String(), "", ""), false},
+		{"fail - no destination", func(*MsgRecover) {}, newMsg("", "", ""), false},
+		{"fail - both destinations", func(*MsgRecover) {}, newMsg(other.String(), "channel-0", originAddress), false},
+		{"fail - receiver is the stuck address", func(*MsgRecover) {}, newMsg(address.String(), "", ""), false},
+		{"fail - invalid channel", func(*MsgRecover) {}, newMsg("", "c", originAddress), false},
+		{"fail - missing origin address", func(*MsgRecover) {}, newMsg("", "channel-0", ""), false},
+		{"fail - origin address mismatch", func(*MsgRecover) {}, newMsg("", "channel-0", otherOrigin), false},
+		{"pass - local receiver", func(*MsgRecover) {}, newMsg(other.String(), "", ""), true},
+		{"pass - IBC recovery", func(*MsgRecover) {}, newMsg("", "channel-0", originAddress), true},
+	}
+
+	for _, tc := range testCases {
+		msg := tc.msg
+		tc.malleate(&msg)
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRecoverGetRecoverSignBytes(t *testing.T) {
+	msg := MsgRecover{Address: "evmos1address", Receiver: "evmos1receiver", Nonce: 1}
+	require.Equal(
+		t,
+		`{"address":"evmos1address","chain_id":"evmos_9001-2","nonce":"1","origin_address":"","receiver":"evmos1receiver","source_channel":""}`,
+		string(msg.GetRecoverSignBytes("evmos_9001-2")),
+	)
+	require.NotEqual(t, msg.GetRecoverSignBytes("evmos_9001-2"), msg.GetRecoverSignBytes("evmos_9000-4"))
+
+	nextMsg := msg
+	nextMsg.Nonce++
+	require.NotEqual(t, msg.GetRecoverSignBytes("evmos_9001-2"), nextMsg.GetRecoverSignBytes("evmos_9001-2"))
+}
@@ -29,15 +29,17 @@ var ParamsKey = []byte("Params")
 var (
 	DefaultEnableRecovery        = true
 	DefaultPacketTimeoutDuration = 4 * time.Hour
+	DefaultMaxRetries            = uint32(3)
 )
 
 // NewParams creates a new Params instance
 func NewParams(
-	enableRecovery bool, timeoutDuration time.Duration,
+	enableRecovery bool, timeoutDuration time.Duration, maxRetries uint32,
 ) Params {
 	return Params{
 		EnableRecovery:        enableRecovery,
 		PacketTimeoutDuration: timeoutDuration,
+		MaxRetries:            maxRetries,
 	}
 }
 
@@ -46,6 +48,7 @@ func DefaultParams() Params {
 	return Params{
 		EnableRecovery:        DefaultEnableRecovery,
 		PacketTimeoutDuration: DefaultPacketTimeoutDuration,
+		MaxRetries:            DefaultMaxRetries,
 	}
 }
 
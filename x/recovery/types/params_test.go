@@ -25,12 +25,12 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"custom params",
-			NewParams(true, time.Hour),
+			NewParams(true, time.Hour, 3),
 			false,
 		},
 		{
 			"invalid duration",
-			NewParams(true, -1),
+			NewParams(true, -1, 3),
 			true,
 		},
 	}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,33 +113,358 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRecoveryRecordsRequest is the request type for the Query/RecoveryRecords
+// RPC method.
+type QueryRecoveryRecordsRequest struct {
+	// address is the bech32 address of the stuck address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecoveryRecordsRequest) Reset()         { *m = QueryRecoveryRecordsRequest{} }
+func (m *QueryRecoveryRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryRecordsRequest) ProtoMessage()    {}
+func (*QueryRecoveryRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{2}
+}
+func (m *QueryRecoveryRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryRecordsRequest.Merge(m, src)
+}
+func (m *QueryRecoveryRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryRecoveryRecordsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryRecoveryRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRecoveryRecordsResponse is the response type for the
+// Query/RecoveryRecords RPC method.
+type QueryRecoveryRecordsResponse struct {
+	// recovery_records are the recovery attempts of the address
+	RecoveryRecords []RecoveryRecord `protobuf:"bytes,1,rep,name=recovery_records,json=recoveryRecords,proto3" json:"recovery_records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecoveryRecordsResponse) Reset()         { *m = QueryRecoveryRecordsResponse{} }
+func (m *QueryRecoveryRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryRecordsResponse) ProtoMessage()    {}
+func (*QueryRecoveryRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{3}
+}
+func (m *QueryRecoveryRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryRecordsResponse.Merge(m, src)
+}
+func (m *QueryRecoveryRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryRecoveryRecordsResponse) GetRecoveryRecords() []RecoveryRecord {
+	if m != nil {
+		return m.RecoveryRecords
+	}
+	return nil
+}
+
+func (m *QueryRecoveryRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRecoveryRecordRequest is the request type for the Query/RecoveryRecord
+// RPC method.
+type QueryRecoveryRecordRequest struct {
+	// id is the identifier of the recovery
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryRecoveryRecordRequest) Reset()         { *m = QueryRecoveryRecordRequest{} }
+func (m *QueryRecoveryRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryRecordRequest) ProtoMessage()    {}
+func (*QueryRecoveryRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{4}
+}
+func (m *QueryRecoveryRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryRecordRequest.Merge(m, src)
+}
+func (m *QueryRecoveryRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryRecordRequest proto.InternalMessageInfo
+
+func (m *QueryRecoveryRecordRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryRecoveryRecordResponse is the response type for the Query/RecoveryRecord
+// RPC method.
+type QueryRecoveryRecordResponse struct {
+	// recovery_record is the recovery attempt
+	RecoveryRecord RecoveryRecord `protobuf:"bytes,1,opt,name=recovery_record,json=recoveryRecord,proto3" json:"recovery_record"`
+}
+
+func (m *QueryRecoveryRecordResponse) Reset()         { *m = QueryRecoveryRecordResponse{} }
+func (m *QueryRecoveryRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryRecordResponse) ProtoMessage()    {}
+func (*QueryRecoveryRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{5}
+}
+func (m *QueryRecoveryRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryRecordResponse.Merge(m, src)
+}
+func (m *QueryRecoveryRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryRecordResponse proto.InternalMessageInfo
+
+func (m *QueryRecoveryRecordResponse) GetRecoveryRecord() RecoveryRecord {
+	if m != nil {
+		return m.RecoveryRecord
+	}
+	return RecoveryRecord{}
+}
+
+// QueryRecoveryNonceRequest is the request type for the Query/RecoveryNonce RPC
+// method.
+type QueryRecoveryNonceRequest struct {
+	// address is the bech32 address of the stuck address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRecoveryNonceRequest) Reset()         { *m = QueryRecoveryNonceRequest{} }
+func (m *QueryRecoveryNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryNonceRequest) ProtoMessage()    {}
+func (*QueryRecoveryNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{6}
+}
+func (m *QueryRecoveryNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryNonceRequest.Merge(m, src)
+}
+func (m *QueryRecoveryNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryNonceRequest proto.InternalMessageInfo
+
+func (m *QueryRecoveryNonceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryRecoveryNonceResponse is the response type for the Query/RecoveryNonce
+// RPC method.
+type QueryRecoveryNonceResponse struct {
+	// nonce is the nonce that the next MsgRecover of the address must sign
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryRecoveryNonceResponse) Reset()         { *m = QueryRecoveryNonceResponse{} }
+func (m *QueryRecoveryNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryNonceResponse) ProtoMessage()    {}
+func (*QueryRecoveryNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{7}
+}
+func (m *QueryRecoveryNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryNonceResponse.Merge(m, src)
+}
+func (m *QueryRecoveryNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryNonceResponse proto.InternalMessageInfo
+
+func (m *QueryRecoveryNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.recovery.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.recovery.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRecoveryRecordsRequest)(nil), "evmos.recovery.v1.QueryRecoveryRecordsRequest")
+	proto.RegisterType((*QueryRecoveryRecordsResponse)(nil), "evmos.recovery.v1.QueryRecoveryRecordsResponse")
+	proto.RegisterType((*QueryRecoveryRecordRequest)(nil), "evmos.recovery.v1.QueryRecoveryRecordRequest")
+	proto.RegisterType((*QueryRecoveryRecordResponse)(nil), "evmos.recovery.v1.QueryRecoveryRecordResponse")
+	proto.RegisterType((*QueryRecoveryNonceRequest)(nil), "evmos.recovery.v1.QueryRecoveryNonceRequest")
+	proto.RegisterType((*QueryRecoveryNonceResponse)(nil), "evmos.recovery.v1.QueryRecoveryNonceResponse")
 }
 
 func init() { proto.RegisterFile("evmos/recovery/v1/query.proto", fileDescriptor_2d6fffa62670b057) }
 
 var fileDescriptor_2d6fffa62670b057 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x2f, 0x4a, 0x4d, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x4b, 0xeb, 0xc1, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0xe4, 0x31, 0x75, 0xa4, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0xf4,
-	0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x54, 0x54, 0x26, 0x3d, 0x3f,
-	0x3f, 0x3d, 0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53, 0x3f, 0x31, 0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24,
-	0x33, 0x3f, 0x0f, 0xaa, 0x47, 0x49, 0x84, 0x4b, 0x28, 0x10, 0x64, 0x6d, 0x40, 0x62, 0x51, 0x62,
-	0x6e, 0x71, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x92, 0x1f, 0x97, 0x30, 0x8a, 0x68, 0x71,
-	0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x39, 0x17, 0x5b, 0x01, 0x58, 0x44, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xdb, 0x48, 0x52, 0x0f, 0xc3, 0x95, 0x7a, 0x10, 0x2d, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33,
-	0x04, 0x41, 0x95, 0x1b, 0x35, 0x33, 0x72, 0xb1, 0x82, 0x0d, 0x14, 0xaa, 0xe2, 0x62, 0x83, 0xa8,
-	0x10, 0x52, 0xc5, 0xa2, 0x19, 0xd3, 0x29, 0x52, 0x6a, 0x84, 0x94, 0x41, 0xdc, 0xa6, 0xa4, 0xd8,
-	0x74, 0xf9, 0xc9, 0x64, 0x26, 0x69, 0x21, 0x49, 0x7d, 0xcc, 0x60, 0x82, 0xb8, 0xc2, 0xc9, 0xf5,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0xda, 0x21, 0x64, 0x99, 0xa1, 0x81, 0x7e, 0x05, 0xc2, 0xa8,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xc8, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xd8, 0xba, 0x44, 0xfb, 0xc2, 0x01, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x43, 0x1b, 0xc4, 0xaf, 0x48, 0x60, 0xc8, 0x22, 0x71, 0x8b, 0x9b, 0x5a, 0xa2, 0x2d,
+	0xb4, 0x99, 0x91, 0x53, 0x55, 0xec, 0x2b, 0x01, 0xbb, 0x2a, 0x78, 0xc9, 0x06, 0x39, 0xf1, 0xc8,
+	0x58, 0x22, 0x1e, 0xd7, 0xe3, 0x58, 0x84, 0xaa, 0x42, 0xe2, 0x04, 0x48, 0x5c, 0x00, 0x96, 0x9c,
+	0x81, 0x0b, 0x74, 0x59, 0x89, 0x0d, 0x2b, 0x84, 0x12, 0xce, 0xc0, 0x1a, 0x79, 0x66, 0xdc, 0xd4,
+	0x8d, 0x4b, 0xbc, 0x49, 0xec, 0x99, 0xf7, 0xdf, 0x7f, 0xef, 0xcf, 0xf3, 0xc0, 0x43, 0x9a, 0x8c,
+	0x18, 0x27, 0x11, 0x1d, 0xb2, 0x84, 0x46, 0x13, 0x92, 0x58, 0xe4, 0x64, 0x4c, 0xa3, 0x09, 0x0e,
+	0x23, 0x16, 0x33, 0x74, 0x5f, 0x6c, 0xe3, 0x6c, 0x1b, 0x27, 0x96, 0xfe, 0x64, 0xc8, 0x78, 0x5a,
+	0x32, 0x70, 0x38, 0x95, 0x58, 0x92, 0x58, 0x03, 0x1a, 0x3b, 0x16, 0x09, 0x1d, 0xcf, 0x0f, 0x9c,
+	0xd8, 0x67, 0x81, 0x2c, 0xd7, 0x37, 0x17, 0xd9, 0x3d, 0x1a, 0x50, 0xee, 0x73, 0x05, 0xe8, 0x2c,
+	0x02, 0x2e, 0x7b, 0x49, 0x44, 0xd3, 0x63, 0x1e, 0x13, 0x8f, 0x24, 0x7d, 0x52, 0xab, 0x1b, 0x1e,
+	0x63, 0xde, 0x5b, 0x4a, 0x9c, 0xd0, 0x27, 0x4e, 0x10, 0xb0, 0x58, 0x74, 0x55, 0xac, 0x66, 0x13,
+	0xd0, 0xcb, 0x54, 0x58, 0xdf, 0x89, 0x9c, 0x11, 0xb7, 0xe9, 0xc9, 0x98, 0xf2, 0xd8, 0x3c, 0x86,
+	0x07, 0xb9, 0x55, 0x1e, 0xb2, 0x80, 0x53, 0xf4, 0x14, 0x6a, 0xa1, 0x58, 0x69, 0x69, 0x1d, 0x6d,
+	0x77, 0xad, 0xd7, 0xc6, 0x0b, 0x9e, 0xb1, 0x2c, 0x39, 0x5a, 0x39, 0xff, 0xb5, 0x59, 0xb1, 0x15,
+	0xdc, 0xfc, 0x00, 0xeb, 0x82, 0xcf, 0x56, 0xc0, 0xf4, 0x3f, 0x72, 0xb3, 0x76, 0xa8, 0x05, 0xb7,
+	0x1d, 0xd7, 0x8d, 0x28, 0x97, 0xc4, 0x77, 0xec, 0xec, 0x15, 0x3d, 0x07, 0x98, 0x4f, 0xaa, 0x55,
+	0x15, 0x5d, 0xb7, 0xb1, 0x1c, 0x2b, 0x4e, 0xc7, 0x8a, 0xe5, 0x11, 0xa8, 0xb1, 0xe2, 0xbe, 0xe3,
+	0x51, 0xc5, 0x6a, 0x5f, 0xa9, 0x34, 0xbf, 0x6b, 0xb0, 0x51, 0xac, 0x40, 0x59, 0xb3, 0xe1, 0x5e,
+	0xe6, 0xe2, 0x75, 0x24, 0xf7, 0x5a, 0x5a, 0xe7, 0xd6, 0xee, 0x5a, 0x6f, 0xab, 0xc0, 0x64, 0x9e,
+	0x45, 0x99, 0x6d, 0x44, 0x79, 0x6e, 0xf4, 0xa2, 0x40, 0xfc, 0xce, 0x52, 0xf1, 0x52, 0x50, 0x4e,
+	0xfd, 0x3e, 0xe8, 0x05, 0xe2, 0xb3, 0xe9, 0xd5, 0xa1, 0xea, 0xbb, 0x62, 0x70, 0x2b, 0x76, 0xd5,
+	0x77, 0x4d, 0x56, 0x38, 0xec, 0x4b, 0xa7, 0x7d, 0x68, 0x5c, 0x73, 0xaa, 0x4e, 0xb3, 0xb4, 0xd1,
+	0x7a, 0xde, 0xa8, 0x79, 0x08, 0xed, 0x5c, 0xc3, 0x63, 0x16, 0x0c, 0xe9, 0xd2, 0xb3, 0x35, 0x7b,
+	0xa0, 0x17, 0x95, 0x29, 0x99, 0x4d, 0x58, 0x0d, 0xd2, 0x05, 0x65, 0x4c, 0xbe, 0xf4, 0xfe, 0xae,
+	0xc0, 0xaa, 0x28, 0x42, 0xef, 0xa1, 0x26, 0xa3, 0x86, 0x1e, 0x15, 0xe8, 0x5e, 0xcc, 0xb4, 0xbe,
+	0xbd, 0x0c, 0x26, 0x1b, 0x9b, 0x5b, 0x1f, 0x7f, 0xfc, 0xf9, 0x5c, 0x5d, 0x47, 0x6d, 0xb2, 0xf8,
+	0xc1, 0xc9, 0x38, 0xa3, 0x6f, 0x1a, 0x34, 0xae, 0x05, 0x09, 0xe1, 0x9b, 0xe8, 0x8b, 0x33, 0xaf,
+	0x93, 0xd2, 0x78, 0xa5, 0xeb, 0x50, 0xe8, 0x22, 0xa8, 0x4b, 0x6e, 0xbe, 0x08, 0xb2, 0xe8, 0x92,
+	0x53, 0x35, 0xe4, 0x33, 0xf4, 0x45, 0x83, 0x7a, 0x9e, 0x12, 0x75, 0xcb, 0xb5, 0xce, 0x94, 0xe2,
+	0xb2, 0x70, 0x25, 0x94, 0x08, 0xa1, 0x8f, 0xd1, 0xce, 0x72, 0xa1, 0xe4, 0xd4, 0x77, 0xcf, 0xd0,
+	0x57, 0x0d, 0xee, 0xe6, 0x42, 0x80, 0xf6, 0x97, 0xb5, 0xbc, 0x1a, 0x31, 0xbd, 0x5b, 0x12, 0xad,
+	0xf4, 0x1d, 0x08, 0x7d, 0x5d, 0xb4, 0xf7, 0x3f, 0x7d, 0x22, 0x6e, 0xf3, 0x31, 0x1e, 0x3d, 0x3b,
+	0x9f, 0x1a, 0xda, 0xc5, 0xd4, 0xd0, 0x7e, 0x4f, 0x0d, 0xed, 0xd3, 0xcc, 0xa8, 0x5c, 0xcc, 0x8c,
+	0xca, 0xcf, 0x99, 0x51, 0x79, 0xb5, 0xe7, 0xf9, 0xf1, 0x9b, 0xf1, 0x00, 0x0f, 0xd9, 0x48, 0x11,
+	0xca, 0xdf, 0xc4, 0xb2, 0xc8, 0xbb, 0x39, 0x79, 0x3c, 0x09, 0x29, 0x1f, 0xd4, 0xc4, 0xad, 0x7b,
+	0xf0, 0x6f, 0x00, 0x72, 0xf8, 0xcd, 0xd5, 0x4c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +481,13 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params retrieves the total set of recovery parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RecoveryRecords retrieves the recovery history of an address
+	RecoveryRecords(ctx context.Context, in *QueryRecoveryRecordsRequest, opts ...grpc.CallOption) (*QueryRecoveryRecordsResponse, error)
+	// RecoveryRecord retrieves a recovery attempt by its identifier
+	RecoveryRecord(ctx context.Context, in *QueryRecoveryRecordRequest, opts ...grpc.CallOption) (*QueryRecoveryRecordResponse, error)
+	// RecoveryNonce retrieves the nonce that the next MsgRecover of an address
+	// must sign
+	RecoveryNonce(ctx context.Context, in *QueryRecoveryNonceRequest, opts ...grpc.CallOption) (*QueryRecoveryNonceResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +507,44 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RecoveryRecords(ctx context.Context, in *QueryRecoveryRecordsRequest, opts ...grpc.CallOption) (*QueryRecoveryRecordsResponse, error) {
+	out := new(QueryRecoveryRecordsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.recovery.v1.Query/RecoveryRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecoveryRecord(ctx context.Context, in *QueryRecoveryRecordRequest, opts ...grpc.CallOption) (*QueryRecoveryRecordResponse, error) {
+	out := new(QueryRecoveryRecordResponse)
+	err := c.cc.Invoke(ctx, "/evmos.recovery.v1.Query/RecoveryRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecoveryNonce(ctx context.Context, in *QueryRecoveryNonceRequest, opts ...grpc.CallOption) (*QueryRecoveryNonceResponse, error) {
+	out := new(QueryRecoveryNonceResponse)
+	err := c.cc.Invoke(ctx, "/evmos.recovery.v1.Query/RecoveryNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params retrieves the total set of recovery parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RecoveryRecords retrieves the recovery history of an address
+	RecoveryRecords(context.Context, *QueryRecoveryRecordsRequest) (*QueryRecoveryRecordsResponse, error)
+	// RecoveryRecord retrieves a recovery attempt by its identifier
+	RecoveryRecord(context.Context, *QueryRecoveryRecordRequest) (*QueryRecoveryRecordResponse, error)
+	// RecoveryNonce retrieves the nonce that the next MsgRecover of an address
+	// must sign
+	RecoveryNonce(context.Context, *QueryRecoveryNonceRequest) (*QueryRecoveryNonceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +554,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RecoveryRecords(ctx context.Context, req *QueryRecoveryRecordsRequest) (*QueryRecoveryRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryRecords not implemented")
+}
+func (*UnimplementedQueryServer) RecoveryRecord(ctx context.Context, req *QueryRecoveryRecordRequest) (*QueryRecoveryRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryRecord not implemented")
+}
+func (*UnimplementedQueryServer) RecoveryNonce(ctx context.Context, req *QueryRecoveryNonceRequest) (*QueryRecoveryNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryNonce not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +586,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecoveryRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.recovery.v1.Query/RecoveryRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryRecords(ctx, req.(*QueryRecoveryRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecoveryRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.recovery.v1.Query/RecoveryRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryRecord(ctx, req.(*QueryRecoveryRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecoveryNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.recovery.v1.Query/RecoveryNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryNonce(ctx, req.(*QueryRecoveryNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.recovery.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +648,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RecoveryRecords",
+			Handler:    _Query_RecoveryRecords_Handler,
+		},
+		{
+			MethodName: "RecoveryRecord",
+			Handler:    _Query_RecoveryRecord_Handler,
+		},
+		{
+			MethodName: "RecoveryNonce",
+			Handler:    _Query_RecoveryNonce_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/recovery/v1/query.proto",
@@ -279,30 +721,240 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRecoveryRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryRecoveryRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecoveryRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecoveryRecords) > 0 {
+		for iNdEx := len(m.RecoveryRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveryRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RecoveryRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Params.Size()
@@ -310,6 +962,90 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRecoveryRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoveryRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecoveryRecords) > 0 {
+		for _, e := range m.RecoveryRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoveryRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRecoveryRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecoveryRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecoveryNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoveryNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +1185,547 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRecoveryRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryRecords = append(m.RecoveryRecords, RecoveryRecord{})
+			if err := m.RecoveryRecords[len(m.RecoveryRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecoveryRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,150 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewRecoveryRecord returns a new pending recovery record
+func NewRecoveryRecord(
+	id uint64,
+	address sdk.AccAddress,
+	receiver, port, channel string,
+	height int64,
+	packets []RecoveryPacket,
+) RecoveryRecord {
+	return RecoveryRecord{
+		ID:            id,
+		Address:       address.String(),
+		Receiver:      receiver,
+		SourcePort:    port,
+		SourceChannel: channel,
+		Height:        height,
+		Status:        RecoveryStatusPending,
+		Packets:       packets,
+	}
+}
+
+// NewRecoveryPacket returns a new pending recovery packet
+func NewRecoveryPacket(sequence uint64, token sdk.Coin, attempt uint32) RecoveryPacket {
+	return RecoveryPacket{
+		Sequence: sequence,
+		Token:    token,
+		Status:   PacketStatusPending,
+		Attempt:  attempt,
+	}
+}
+
+// Validate performs a stateless validation of the recovery record fields
+func (r RecoveryRecord) Validate() error {
+	if r.ID == 0 {
+		return fmt.Errorf("recovery record id cannot be 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid recovery record address: %w", err)
+	}
+
+	if err := host.PortIdentifierValidator(r.SourcePort); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(r.SourceChannel); err != nil {
+		return err
+	}
+
+	if _, ok := RecoveryStatus_name[int32(r.Status)]; !ok {
+		return fmt.Errorf("invalid recovery status %d", r.Status)
+	}
+
+	if len(r.Packets) == 0 {
+		return fmt.Errorf("recovery record %d has no packets", r.ID)
+	}
+
+	seenSequences := make(map[uint64]bool)
+	for _, packet := range r.Packets {
+		if seenSequences[packet.Sequence] {
+			return fmt.Errorf("duplicated packet sequence %d in recovery record %d", packet.Sequence, r.ID)
+		}
+		seenSequences[packet.Sequence] = true
+
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the recovery packet fields
+func (p RecoveryPacket) Validate() error {
+	if p.Sequence == 0 {
+		return fmt.Errorf("recovery packet sequence cannot be 0")
+	}
+
+	if err := p.Token.Validate(); err != nil {
+		return err
+	}
+
+	if !p.Token.IsPositive() {
+		return fmt.Errorf("recovery packet token must be positive: %s", p.Token)
+	}
+
+	if _, ok := PacketStatus_name[int32(p.Status)]; !ok {
+		return fmt.Errorf("invalid packet status %d", p.Status)
+	}
+
+	if p.RetryQueued && p.Status != PacketStatusFailed && p.Status != PacketStatusTimedOut {
+		return fmt.Errorf("retry queued for packet %d with status %s", p.Sequence, p.Status)
+	}
+
+	return nil
+}
+
+// PacketIndex returns the index of the packet with the given sequence
+func (r RecoveryRecord) PacketIndex(sequence uint64) (int, bool) {
+	for i, packet := range r.Packets {
+		if packet.Sequence == sequence {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// UpdateStatus sets the recovery status from the status of its packets. The
+// recovery is pending while any packet is pending or has a retry queued. It is
+// completed once all the packets that have not been retried are acknowledged
+// and failed otherwise.
+func (r *RecoveryRecord) UpdateStatus() {
+	status := RecoveryStatusCompleted
+
+	for _, packet := range r.Packets {
+		switch {
+		case packet.Status == PacketStatusPending, packet.RetryQueued:
+			r.Status = RecoveryStatusPending
+			return
+		case packet.Status == PacketStatusFailed, packet.Status == PacketStatusTimedOut:
+			status = RecoveryStatusFailed
+		}
+	}
+
+	r.Status = status
+}
@@ -0,0 +1,1139 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/recovery/v1/recovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecoveryStatus defines the status of a recovery attempt
+type RecoveryStatus int32
+
+const (
+	// RECOVERY_STATUS_PENDING defines a recovery with outbound packets that are
+	// not acknowledged yet or with a retry queued
+	RecoveryStatusPending RecoveryStatus = 0
+	// RECOVERY_STATUS_COMPLETED defines a recovery for which all the outbound
+	// packets have been successfully acknowledged
+	RecoveryStatusCompleted RecoveryStatus = 1
+	// RECOVERY_STATUS_FAILED defines a recovery for which at least one outbound
+	// packet failed or timed out and no retries are left
+	RecoveryStatusFailed RecoveryStatus = 2
+)
+
+var RecoveryStatus_name = map[int32]string{
+	0: "RECOVERY_STATUS_PENDING",
+	1: "RECOVERY_STATUS_COMPLETED",
+	2: "RECOVERY_STATUS_FAILED",
+}
+
+var RecoveryStatus_value = map[string]int32{
+	"RECOVERY_STATUS_PENDING":   0,
+	"RECOVERY_STATUS_COMPLETED": 1,
+	"RECOVERY_STATUS_FAILED":    2,
+}
+
+func (x RecoveryStatus) String() string {
+	return proto.EnumName(RecoveryStatus_name, int32(x))
+}
+
+func (RecoveryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4d6690950db7332b, []int{0}
+}
+
+// PacketStatus defines the status of an outbound recovery packet
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_PENDING defines a packet that is waiting for its
+	// acknowledgement or timeout
+	PacketStatusPending PacketStatus = 0
+	// PACKET_STATUS_ACKNOWLEDGED defines a packet with a successful
+	// acknowledgement
+	PacketStatusAcknowledged PacketStatus = 1
+	// PACKET_STATUS_FAILED defines a packet with an error acknowledgement
+	PacketStatusFailed PacketStatus = 2
+	// PACKET_STATUS_TIMED_OUT defines a packet that timed out
+	PacketStatusTimedOut PacketStatus = 3
+	// PACKET_STATUS_RETRIED defines a failed or timed out packet whose refunded
+	// tokens have been sent again in a new packet
+	PacketStatusRetried PacketStatus = 4
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_PENDING",
+	1: "PACKET_STATUS_ACKNOWLEDGED",
+	2: "PACKET_STATUS_FAILED",
+	3: "PACKET_STATUS_TIMED_OUT",
+	4: "PACKET_STATUS_RETRIED",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_PENDING":      0,
+	"PACKET_STATUS_ACKNOWLEDGED": 1,
+	"PACKET_STATUS_FAILED":       2,
+	"PACKET_STATUS_TIMED_OUT":    3,
+	"PACKET_STATUS_RETRIED":      4,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4d6690950db7332b, []int{1}
+}
+
+// RecoveryRecord defines a recovery attempt of the balances of a stuck address
+// back to its origin chain
+type RecoveryRecord struct {
+	// id is the unique identifier of the recovery
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address is the bech32 address of the stuck address on Evmos
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// receiver is the bech32 address of the stuck address on the origin chain
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// source_port is the port on Evmos used to send the recovery packets
+	SourcePort string `protobuf:"bytes,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// source_channel is the channel on Evmos used to send the recovery packets
+	SourceChannel string `protobuf:"bytes,5,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// height is the block height at which the recovery was started
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// status is the status of the recovery
+	Status RecoveryStatus `protobuf:"varint,7,opt,name=status,proto3,enum=evmos.recovery.v1.RecoveryStatus" json:"status,omitempty"`
+	// packets are the outbound transfer packets sent for the recovery, including
+	// the retries
+	Packets []RecoveryPacket `protobuf:"bytes,8,rep,name=packets,proto3" json:"packets"`
+}
+
+func (m *RecoveryRecord) Reset()         { *m = RecoveryRecord{} }
+func (m *RecoveryRecord) String() string { return proto.CompactTextString(m) }
+func (*RecoveryRecord) ProtoMessage()    {}
+func (*RecoveryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d6690950db7332b, []int{0}
+}
+func (m *RecoveryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryRecord.Merge(m, src)
+}
+func (m *RecoveryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryRecord proto.InternalMessageInfo
+
+func (m *RecoveryRecord) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *RecoveryRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RecoveryRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *RecoveryRecord) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *RecoveryRecord) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *RecoveryRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecoveryRecord) GetStatus() RecoveryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RecoveryStatusPending
+}
+
+func (m *RecoveryRecord) GetPackets() []RecoveryPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+// RecoveryPacket defines an outbound transfer packet of a recovery
+type RecoveryPacket struct {
+	// sequence of the packet on the source port and channel
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// token transferred in the packet
+	Token types.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	// status of the packet
+	Status PacketStatus `protobuf:"varint,3,opt,name=status,proto3,enum=evmos.recovery.v1.PacketStatus" json:"status,omitempty"`
+	// attempt is the number of retries that preceded the packet, i.e. 0 for the
+	// first transfer of the token
+	Attempt uint32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// retry_queued is true if the packet failed or timed out and its refunded
+	// tokens are going to be sent again at the end of the block
+	RetryQueued bool `protobuf:"varint,5,opt,name=retry_queued,json=retryQueued,proto3" json:"retry_queued,omitempty"`
+	// error is the error of the acknowledgement or the retry of the packet
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RecoveryPacket) Reset()         { *m = RecoveryPacket{} }
+func (m *RecoveryPacket) String() string { return proto.CompactTextString(m) }
+func (*RecoveryPacket) ProtoMessage()    {}
+func (*RecoveryPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d6690950db7332b, []int{1}
+}
+func (m *RecoveryPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryPacket.Merge(m, src)
+}
+func (m *RecoveryPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryPacket proto.InternalMessageInfo
+
+func (m *RecoveryPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *RecoveryPacket) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func (m *RecoveryPacket) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PacketStatusPending
+}
+
+func (m *RecoveryPacket) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *RecoveryPacket) GetRetryQueued() bool {
+	if m != nil {
+		return m.RetryQueued
+	}
+	return false
+}
+
+func (m *RecoveryPacket) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("evmos.recovery.v1.RecoveryStatus", RecoveryStatus_name, RecoveryStatus_value)
+	proto.RegisterEnum("evmos.recovery.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*RecoveryRecord)(nil), "evmos.recovery.v1.RecoveryRecord")
+	proto.RegisterType((*RecoveryPacket)(nil), "evmos.recovery.v1.RecoveryPacket")
+}
+
+func init() { proto.RegisterFile("evmos/recovery/v1/recovery.proto", fileDescriptor_4d6690950db7332b) }
+
+var fileDescriptor_4d6690950db7332b = []byte{
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xda, 0x4a,
+	0x14, 0xc6, 0x31, 0x10, 0x92, 0x0c, 0x09, 0xe2, 0xce, 0x25, 0xe0, 0xf8, 0x5e, 0x19, 0x27, 0x52,
+	0x25, 0x94, 0x4a, 0xa6, 0xa4, 0x4d, 0xab, 0x56, 0xdd, 0x10, 0x70, 0x22, 0x94, 0x3f, 0x50, 0xc7,
+	0x69, 0xd5, 0x6e, 0x10, 0xd8, 0x47, 0x60, 0x05, 0x3c, 0x64, 0x3c, 0xd0, 0xe6, 0x0d, 0x2a, 0x56,
+	0x7d, 0x01, 0x56, 0x79, 0x8d, 0x2e, 0xba, 0xcc, 0x32, 0xcb, 0xae, 0xa2, 0x8a, 0x3c, 0x40, 0x5f,
+	0xa1, 0x62, 0x06, 0x28, 0x84, 0xaa, 0x1b, 0x98, 0x73, 0xe6, 0xfb, 0x79, 0xce, 0xf7, 0x8d, 0x34,
+	0x48, 0x83, 0x5e, 0x9b, 0xf8, 0x59, 0x0a, 0x36, 0xe9, 0x01, 0xbd, 0xca, 0xf6, 0x72, 0xd3, 0xb5,
+	0xde, 0xa1, 0x84, 0x11, 0xfc, 0x0f, 0x57, 0xe8, 0xd3, 0x6e, 0x2f, 0xa7, 0xa8, 0x36, 0xf1, 0x47,
+	0x54, 0xbd, 0xe6, 0x43, 0xb6, 0x97, 0xab, 0x03, 0xab, 0xe5, 0xb2, 0x36, 0x71, 0x3d, 0x81, 0x28,
+	0x89, 0x06, 0x69, 0x10, 0xbe, 0xcc, 0x8e, 0x56, 0xa2, 0xbb, 0xfd, 0x35, 0x88, 0x62, 0xe6, 0xf8,
+	0x2b, 0xa3, 0x7f, 0xea, 0xe0, 0x24, 0x0a, 0xba, 0x8e, 0x2c, 0x69, 0x52, 0x26, 0xbc, 0x1f, 0x19,
+	0xde, 0xa5, 0x83, 0xa5, 0xa2, 0x19, 0x74, 0x1d, 0x2c, 0xa3, 0xe5, 0x9a, 0xe3, 0x50, 0xf0, 0x7d,
+	0x39, 0xa8, 0x49, 0x99, 0x55, 0x73, 0x52, 0x62, 0x05, 0xad, 0x50, 0xb0, 0xc1, 0xed, 0x01, 0x95,
+	0x43, 0x7c, 0x6b, 0x5a, 0xe3, 0x34, 0x8a, 0xfa, 0xa4, 0x4b, 0x6d, 0xa8, 0x76, 0x08, 0x65, 0x72,
+	0x98, 0x6f, 0x23, 0xd1, 0xaa, 0x10, 0xca, 0xf0, 0x23, 0x14, 0x1b, 0x0b, 0xec, 0x66, 0xcd, 0xf3,
+	0xa0, 0x25, 0x2f, 0x71, 0xcd, 0xba, 0xe8, 0x16, 0x44, 0x13, 0x27, 0x51, 0xa4, 0x09, 0x6e, 0xa3,
+	0xc9, 0xe4, 0x88, 0x26, 0x65, 0x42, 0xe6, 0xb8, 0xc2, 0x2f, 0x51, 0xc4, 0x67, 0x35, 0xd6, 0xf5,
+	0xe5, 0x65, 0x4d, 0xca, 0xc4, 0x76, 0xb7, 0xf4, 0x85, 0x68, 0xf4, 0x89, 0xc1, 0x33, 0x2e, 0x34,
+	0xc7, 0x00, 0xce, 0xa3, 0xe5, 0x4e, 0xcd, 0xbe, 0x00, 0xe6, 0xcb, 0x2b, 0x5a, 0x28, 0x13, 0xfd,
+	0x2b, 0x5b, 0xe1, 0xca, 0xfd, 0xf0, 0xcd, 0x5d, 0x3a, 0x60, 0x4e, 0xb8, 0xed, 0x9f, 0x12, 0x8a,
+	0xcd, 0x2b, 0x46, 0x61, 0xf8, 0x70, 0xd9, 0x05, 0xcf, 0x06, 0x11, 0xa2, 0x39, 0xad, 0xf1, 0x1e,
+	0x5a, 0x62, 0xe4, 0x02, 0x3c, 0x1e, 0x60, 0x74, 0x77, 0x53, 0x17, 0x77, 0xa6, 0x8f, 0xee, 0x4c,
+	0x1f, 0xdf, 0x99, 0x5e, 0x20, 0xae, 0x37, 0x3e, 0x47, 0xa8, 0xf1, 0x8b, 0xa9, 0xc7, 0x10, 0xf7,
+	0x98, 0xfe, 0xc3, 0x9c, 0xe2, 0xf4, 0x07, 0x0e, 0x47, 0x57, 0xc6, 0x18, 0xb4, 0x3b, 0x22, 0xf8,
+	0x75, 0x73, 0x52, 0xe2, 0x2d, 0xb4, 0x46, 0x81, 0xd1, 0xab, 0xea, 0x65, 0x17, 0xba, 0xe0, 0xf0,
+	0xcc, 0x57, 0xcc, 0x28, 0xef, 0xbd, 0xe1, 0x2d, 0x9c, 0x40, 0x4b, 0x40, 0x29, 0xa1, 0x3c, 0xf0,
+	0x55, 0x53, 0x14, 0x3b, 0xdf, 0x66, 0x1c, 0x8b, 0xd3, 0xf0, 0x73, 0x94, 0x32, 0x8d, 0x42, 0xf9,
+	0xad, 0x61, 0xbe, 0xaf, 0x9e, 0x59, 0x79, 0xeb, 0xfc, 0xac, 0x5a, 0x31, 0x4e, 0x8b, 0xa5, 0xd3,
+	0xc3, 0x78, 0x40, 0xd9, 0xec, 0x0f, 0xb4, 0x8d, 0x79, 0xa0, 0x02, 0x9e, 0xe3, 0x7a, 0x0d, 0xfc,
+	0x0a, 0x6d, 0x3e, 0xe4, 0x0a, 0xe5, 0x93, 0xca, 0xb1, 0x61, 0x19, 0xc5, 0xb8, 0xa4, 0xfc, 0xd7,
+	0x1f, 0x68, 0xa9, 0x79, 0xb2, 0x40, 0xda, 0x9d, 0x16, 0x30, 0x70, 0xf0, 0x33, 0x94, 0x7c, 0xc8,
+	0x1e, 0xe4, 0x4b, 0xc7, 0x46, 0x31, 0x1e, 0x54, 0xe4, 0xfe, 0x40, 0x4b, 0xcc, 0x83, 0x07, 0x35,
+	0xb7, 0x05, 0x8e, 0x12, 0xfe, 0x7c, 0xad, 0x06, 0x76, 0xae, 0x83, 0x68, 0x6d, 0x36, 0x2e, 0xbc,
+	0x8b, 0x36, 0x2a, 0xf9, 0xc2, 0x91, 0x61, 0x2d, 0x8e, 0x9f, 0xea, 0x0f, 0xb4, 0x7f, 0x67, 0xc5,
+	0x93, 0xe1, 0x5f, 0x23, 0x65, 0x9e, 0xc9, 0x17, 0x8e, 0x4e, 0xcb, 0xef, 0x8e, 0x8d, 0xe2, 0x21,
+	0x9f, 0xfe, 0xff, 0xfe, 0x40, 0x93, 0x67, 0xc1, 0xbc, 0x7d, 0xe1, 0x91, 0x8f, 0x2d, 0x70, 0x1a,
+	0xe0, 0xe0, 0x27, 0x28, 0x31, 0x4f, 0x4f, 0x87, 0x4f, 0xf6, 0x07, 0x1a, 0x9e, 0xe5, 0xc4, 0xe8,
+	0x78, 0x0f, 0xa5, 0xe6, 0x09, 0xab, 0x74, 0x62, 0x14, 0xab, 0xe5, 0x73, 0x2b, 0x1e, 0x12, 0x8e,
+	0x67, 0x21, 0xcb, 0x6d, 0x83, 0x53, 0xee, 0xb2, 0x45, 0x6b, 0xa6, 0x61, 0x99, 0x25, 0xa3, 0x18,
+	0x0f, 0x2f, 0x5a, 0x33, 0x81, 0x51, 0x77, 0x92, 0xd2, 0xbe, 0x71, 0x33, 0x54, 0xa5, 0xdb, 0xa1,
+	0x2a, 0xfd, 0x18, 0xaa, 0xd2, 0x97, 0x7b, 0x35, 0x70, 0x7b, 0xaf, 0x06, 0xbe, 0xdf, 0xab, 0x81,
+	0x0f, 0x8f, 0x1b, 0x2e, 0x6b, 0x76, 0xeb, 0xba, 0x4d, 0xda, 0x59, 0xf1, 0x52, 0x89, 0xdf, 0x5e,
+	0x2e, 0x97, 0xfd, 0xf4, 0xfb, 0xd5, 0x62, 0x57, 0x1d, 0xf0, 0xeb, 0x11, 0xfe, 0xce, 0x3c, 0xfd,
+	0x35, 0x00, 0x03, 0x34, 0x57, 0x89, 0xd4, 0x04, 0x00, 0x00,
+}
+
+func (m *RecoveryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecovery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RetryQueued {
+		i--
+		if m.RetryQueued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRecovery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Sequence != 0 {
+		i = encodeVarintRecovery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecoveryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRecovery(uint64(m.ID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRecovery(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRecovery(uint64(m.Status))
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovRecovery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecoveryPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovRecovery(uint64(m.Sequence))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovRecovery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovRecovery(uint64(m.Status))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRecovery(uint64(m.Attempt))
+	}
+	if m.RetryQueued {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	return n
+}
+
+func sovRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecovery(x uint64) (n int) {
+	return sovRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecoveryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RecoveryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, RecoveryPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryQueued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryQueued = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecovery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoveryRecordUpdateStatus(t *testing.T) {
+	coin := sdk.NewCoin("aevmos", sdk.NewInt(1))
+
+	testCases := []struct {
+		name      string
+		packets   []RecoveryPacket
+		expStatus RecoveryStatus
+	}{
+		{
+			"pending packet",
+			[]RecoveryPacket{{Sequence: 1, Token: coin, Status: PacketStatusAcknowledged}, {Sequence: 2, Token: coin}},
+			RecoveryStatusPending,
+		},
+		{
+			"retry queued",
+			[]RecoveryPacket{{Sequence: 1, Token: coin, Status: PacketStatusTimedOut, RetryQueued: true}},
+			RecoveryStatusPending,
+		},
+		{
+			"all acknowledged",
+			[]RecoveryPacket{{Sequence: 1, Token: coin, Status: PacketStatusAcknowledged}, {Sequence: 2, Token: coin, Status: PacketStatusAcknowledged}},
+			RecoveryStatusCompleted,
+		},
+		{
+			"retried and acknowledged",
+			[]RecoveryPacket{{Sequence: 1, Token: coin, Status: PacketStatusRetried}, {Sequence: 2, Token: coin, Status: PacketStatusAcknowledged, Attempt: 1}},
+			RecoveryStatusCompleted,
+		},
+		{
+			"failed packet",
+			[]RecoveryPacket{{Sequence: 1, Token: coin, Status: PacketStatusAcknowledged}, {Sequence: 2, Token: coin, Status: PacketStatusFailed}},
+			RecoveryStatusFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		record := RecoveryRecord{Packets: tc.packets}
+		record.UpdateStatus()
+		require.Equal(t, tc.expStatus, record.Status, tc.name)
+	}
+}
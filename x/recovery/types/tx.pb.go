@@ -7,10 +7,13 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -124,35 +127,218 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRecover defines a Msg for recovering the balances of a stuck secp256k1
+// address. Exactly one of receiver or (source_channel, origin_address) must be
+// set.
+type MsgRecover struct {
+	// sender is the bech32 address of the account submitting the message
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// address is the bech32 address of the stuck secp256k1 account
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// pub_key is the compressed secp256k1 public key of the stuck address
+	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signature is the signature of the recovery payload (see
+	// MsgRecover.GetRecoverSignBytes) with the secp256k1 key of the stuck
+	// address
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// receiver is the optional bech32 address on Evmos that receives the
+	// recovered balances
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// source_channel is the authorized IBC channel on Evmos used to send the
+	// balances back to the origin chain
+	SourceChannel string `protobuf:"bytes,6,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// origin_address is the bech32 address of the stuck address key on the origin
+	// chain of the source_channel counterparty
+	OriginAddress string `protobuf:"bytes,7,opt,name=origin_address,json=originAddress,proto3" json:"origin_address,omitempty"`
+	// nonce is the current recovery nonce of the stuck address (see
+	// Query/RecoveryNonce). It is included in the signed payload and incremented
+	// on each recovery, so that a signature cannot be replayed.
+	Nonce uint64 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgRecover) Reset()         { *m = MsgRecover{} }
+func (m *MsgRecover) String() string { return proto.CompactTextString(m) }
+func (*MsgRecover) ProtoMessage()    {}
+func (*MsgRecover) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d25d0e60b916986f, []int{2}
+}
+func (m *MsgRecover) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecover.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecover) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecover.Merge(m, src)
+}
+func (m *MsgRecover) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecover) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecover.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecover proto.InternalMessageInfo
+
+func (m *MsgRecover) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRecover) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRecover) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *MsgRecover) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MsgRecover) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgRecover) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *MsgRecover) GetOriginAddress() string {
+	if m != nil {
+		return m.OriginAddress
+	}
+	return ""
+}
+
+func (m *MsgRecover) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// MsgRecoverResponse defines the MsgRecover response type
+type MsgRecoverResponse struct {
+	// recovered is the total amount of coins recovered
+	Recovered github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=recovered,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recovered"`
+}
+
+func (m *MsgRecoverResponse) Reset()         { *m = MsgRecoverResponse{} }
+func (m *MsgRecoverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverResponse) ProtoMessage()    {}
+func (*MsgRecoverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d25d0e60b916986f, []int{3}
+}
+func (m *MsgRecoverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverResponse.Merge(m, src)
+}
+func (m *MsgRecoverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverResponse) GetRecovered() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Recovered
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.recovery.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.recovery.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRecover)(nil), "evmos.recovery.v1.MsgRecover")
+	proto.RegisterType((*MsgRecoverResponse)(nil), "evmos.recovery.v1.MsgRecoverResponse")
 }
 
 func init() { proto.RegisterFile("evmos/recovery/v1/tx.proto", fileDescriptor_d25d0e60b916986f) }
 
 var fileDescriptor_d25d0e60b916986f = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x2f, 0x4a, 0x4d, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xcb, 0xe9, 0xc1, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x41, 0xea, 0x73, 0x8b, 0xd3, 0x41, 0x4a, 0x73, 0x8b, 0xd3, 0x21,
-	0x6a, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x92, 0xc7, 0xb4, 0x22,
-	0x3d, 0x35, 0x2f, 0xb5, 0x38, 0x13, 0xa6, 0x40, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xa2, 0x11, 0xc4,
-	0x82, 0x88, 0x2a, 0x4d, 0x62, 0xe4, 0xe2, 0xf7, 0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49,
-	0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f,
-	0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04,
-	0x6a, 0x9f, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10,
-	0x42, 0xa9, 0x90, 0x39, 0x17, 0x5b, 0x01, 0xd8, 0x04, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x49, 0x3d, 0x0c, 0xaf, 0xe9, 0x41, 0xac, 0x70, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa,
-	0xdc, 0x8a, 0xaf, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x41, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x6e, 0x0a,
-	0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x4a, 0xe5, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a,
-	0xe3, 0xe2, 0x41, 0x71, 0xb2, 0x12, 0x16, 0xab, 0xd0, 0x8c, 0x90, 0xd2, 0x22, 0xac, 0x06, 0x66,
-	0x8d, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x82, 0x1c, 0x42, 0x96, 0x19, 0x1a,
-	0xe8, 0x57, 0x20, 0x82, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xc8, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0x58, 0xb6, 0x02, 0x00, 0x02, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xdf, 0xf4, 0xcf, 0x6e, 0x77, 0x5a, 0x2b, 0x0e, 0xc5, 0xa6, 0x4b, 0x9b, 0x5d, 0x16, 0x0a,
+	0x4b, 0xa5, 0x89, 0x5b, 0x41, 0xa1, 0x37, 0xb7, 0x78, 0x92, 0x82, 0x44, 0xbc, 0x78, 0x70, 0x99,
+	0x24, 0x8f, 0xe9, 0xd0, 0xee, 0x4c, 0x98, 0x99, 0x84, 0xee, 0x49, 0xe8, 0x27, 0x10, 0xfd, 0x16,
+	0x9e, 0x3c, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0xe2, 0x1f, 0x5a, 0xc1, 0xaf, 0x21, 0xc9, 0x4c,
+	0x1a, 0x6d, 0x8b, 0x5e, 0x92, 0xbc, 0xf7, 0xfb, 0xbd, 0x5f, 0xde, 0xfc, 0xde, 0x1b, 0xd4, 0x81,
+	0x7c, 0x22, 0x54, 0x20, 0x21, 0x16, 0x39, 0xc8, 0x69, 0x90, 0x0f, 0x03, 0x7d, 0xec, 0xa7, 0x52,
+	0x68, 0x81, 0xef, 0x94, 0x98, 0x5f, 0x61, 0x7e, 0x3e, 0xec, 0x78, 0xb1, 0x50, 0x05, 0x3f, 0x22,
+	0x0a, 0x82, 0x7c, 0x18, 0x81, 0x26, 0xc3, 0x20, 0x16, 0x8c, 0x9b, 0x92, 0xce, 0xaa, 0xc5, 0x27,
+	0x8a, 0x16, 0x52, 0x13, 0x45, 0x2d, 0xb0, 0x66, 0x80, 0x71, 0x19, 0x05, 0x26, 0xb0, 0x50, 0xf7,
+	0x7a, 0x0b, 0x14, 0x38, 0x28, 0x56, 0x11, 0x56, 0xa8, 0xa0, 0xc2, 0x14, 0x16, 0x5f, 0x36, 0xbb,
+	0x4e, 0x85, 0xa0, 0x47, 0x10, 0x90, 0x94, 0x05, 0x84, 0x73, 0xa1, 0x89, 0x66, 0x82, 0xdb, 0x9a,
+	0xfe, 0x5b, 0x07, 0xdd, 0xde, 0x57, 0xf4, 0x45, 0x9a, 0x10, 0x0d, 0xcf, 0x88, 0x24, 0x13, 0x85,
+	0x1f, 0xa2, 0x36, 0xc9, 0xf4, 0x81, 0x90, 0x4c, 0x4f, 0x5d, 0xa7, 0xe7, 0x0c, 0xda, 0x23, 0xf7,
+	0xd3, 0xc7, 0xed, 0x15, 0xdb, 0xcd, 0xe3, 0x24, 0x91, 0xa0, 0xd4, 0x73, 0x2d, 0x19, 0xa7, 0x61,
+	0x4d, 0xc5, 0x8f, 0x50, 0x33, 0x2d, 0x15, 0xdc, 0x99, 0x9e, 0x33, 0x58, 0xdc, 0x59, 0xf3, 0xaf,
+	0x19, 0xe3, 0x9b, 0x5f, 0x8c, 0xe6, 0x4e, 0xbf, 0x75, 0x1b, 0xa1, 0xa5, 0xef, 0x2e, 0x9f, 0xfc,
+	0xfa, 0xb0, 0x55, 0x0b, 0xf5, 0xd7, 0xd0, 0xea, 0x95, 0x9e, 0x42, 0x50, 0xa9, 0xe0, 0x0a, 0xfa,
+	0x27, 0x33, 0x08, 0xed, 0x2b, 0x1a, 0x1a, 0x49, 0x7c, 0x17, 0x35, 0x15, 0xf0, 0x04, 0xa4, 0xe9,
+	0x33, 0xb4, 0x11, 0x76, 0x51, 0x8b, 0x98, 0x36, 0xcb, 0x5e, 0xda, 0x61, 0x15, 0xe2, 0x55, 0xd4,
+	0x4a, 0xb3, 0x68, 0x7c, 0x08, 0x53, 0x77, 0xb6, 0xe7, 0x0c, 0x96, 0xc2, 0x66, 0x9a, 0x45, 0x4f,
+	0x61, 0x8a, 0xd7, 0x51, 0x5b, 0x31, 0xca, 0x89, 0xce, 0x24, 0xb8, 0x73, 0x25, 0x54, 0x27, 0x70,
+	0x07, 0x2d, 0x48, 0x88, 0x81, 0xe5, 0x20, 0xdd, 0xf9, 0x52, 0xf1, 0x32, 0xc6, 0x9b, 0x68, 0x59,
+	0x89, 0x4c, 0xc6, 0x30, 0x8e, 0x0f, 0x08, 0xe7, 0x70, 0xe4, 0x36, 0x4b, 0xc6, 0x2d, 0x93, 0xdd,
+	0x33, 0xc9, 0x82, 0x26, 0x24, 0xa3, 0x8c, 0x8f, 0xab, 0xd6, 0x5a, 0x86, 0x66, 0xb2, 0xd6, 0x56,
+	0xbc, 0x82, 0xe6, 0xb9, 0xe0, 0x31, 0xb8, 0x0b, 0x3d, 0x67, 0x30, 0x17, 0x9a, 0x60, 0x77, 0xb1,
+	0xb0, 0xc8, 0x9e, 0xae, 0xff, 0x1a, 0xe1, 0xda, 0x83, 0xca, 0x1a, 0xcc, 0x50, 0xdb, 0x3a, 0x0d,
+	0x89, 0xeb, 0xf4, 0x66, 0xcb, 0x09, 0xd8, 0x99, 0x15, 0x7b, 0xe8, 0xdb, 0x3d, 0xf4, 0xf7, 0x04,
+	0xe3, 0xa3, 0xfb, 0xc5, 0x04, 0xde, 0x7f, 0xef, 0x0e, 0x28, 0xd3, 0x07, 0x59, 0xe4, 0xc7, 0x62,
+	0x62, 0xd7, 0xcd, 0xbe, 0xb6, 0x55, 0x72, 0x18, 0xe8, 0x69, 0x0a, 0xaa, 0x2c, 0x50, 0x61, 0xad,
+	0xbe, 0xf3, 0xd5, 0x41, 0xb3, 0xfb, 0x8a, 0xe2, 0x57, 0x68, 0xe9, 0xaf, 0xcd, 0xe9, 0xdf, 0x30,
+	0xf1, 0x2b, 0x93, 0xec, 0x6c, 0xfd, 0x9f, 0x73, 0x79, 0xa4, 0x1c, 0xb5, 0xaa, 0x49, 0x6f, 0xdc,
+	0x5c, 0x66, 0xe1, 0xce, 0xe6, 0x3f, 0xe1, 0xcb, 0xf5, 0xd9, 0x3c, 0xf9, 0xfc, 0xf3, 0xdd, 0x4c,
+	0xb7, 0xbf, 0x11, 0xdc, 0x74, 0x9f, 0xab, 0x70, 0xf4, 0xe4, 0xf4, 0xdc, 0x73, 0xce, 0xce, 0x3d,
+	0xe7, 0xc7, 0xb9, 0xe7, 0xbc, 0xb9, 0xf0, 0x1a, 0x67, 0x17, 0x5e, 0xe3, 0xcb, 0x85, 0xd7, 0x78,
+	0x79, 0xef, 0x0f, 0xbb, 0x8c, 0x84, 0x79, 0xe6, 0xc3, 0x61, 0x70, 0x5c, 0xcb, 0x95, 0xbe, 0x45,
+	0xcd, 0xf2, 0x8e, 0x3d, 0xf8, 0x3d, 0x00, 0x21, 0xbe, 0xc9, 0xf9, 0x3d, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +356,11 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/recovery module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Recover recovers the balances of a stuck secp256k1 address, either back to
+	// the origin chains via IBC or to an Evmos address chosen by its owner. The
+	// ownership of the address is proven with an offline signature of the
+	// secp256k1 key, so that the message can be submitted by any account.
+	Recover(ctx context.Context, in *MsgRecover, opts ...grpc.CallOption) (*MsgRecoverResponse, error)
 }
 
 type msgClient struct {
@@ -189,11 +380,25 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) Recover(ctx context.Context, in *MsgRecover, opts ...grpc.CallOption) (*MsgRecoverResponse, error) {
+	out := new(MsgRecoverResponse)
+	err := c.cc.Invoke(ctx, "/evmos.recovery.v1.Msg/Recover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/recovery module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Recover recovers the balances of a stuck secp256k1 address, either back to
+	// the origin chains via IBC or to an Evmos address chosen by its owner. The
+	// ownership of the address is proven with an offline signature of the
+	// secp256k1 key, so that the message can be submitted by any account.
+	Recover(context.Context, *MsgRecover) (*MsgRecoverResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +408,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) Recover(ctx context.Context, req *MsgRecover) (*MsgRecoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recover not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +434,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Recover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecover)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Recover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.recovery.v1.Msg/Recover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Recover(ctx, req.(*MsgRecover))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.recovery.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +460,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "Recover",
+			Handler:    _Msg_Recover_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/recovery/v1/tx.proto",
@@ -302,6 +532,120 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRecover) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecover) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecover) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.OriginAddress) > 0 {
+		i -= len(m.OriginAddress)
+		copy(dAtA[i:], m.OriginAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recovered) > 0 {
+		for iNdEx := len(m.Recovered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recovered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -337,6 +681,61 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRecover) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRecoverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recovered) > 0 {
+		for _, e := range m.Recovered {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +907,387 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRecover) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecover: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecover: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recovered = append(m.Recovered, types.Coin{})
+			if err := m.Recovered[len(m.Recovered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
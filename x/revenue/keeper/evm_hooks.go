@@ -25,6 +25,7 @@ import (
 
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/revenue/types"
 )
 
@@ -97,6 +98,13 @@ func (k Keeper) PostTxProcessing(
 		)
 	}
 
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyDistribute)
+		evmostelemetry.IncrCoins(types.MetricKeyDistributeAmount, fees)
+	}()
+
+	types.RecordRevenuePaid(ctx.Context(), fees)
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -27,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/revenue/types"
 )
 
@@ -142,6 +143,13 @@ func (k Keeper) RegisterRevenue(
 		},
 	)
 
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyRegisterRevenue,
+			evmostelemetry.NewContractLabel(msg.ContractAddress),
+		)
+	}()
+
 	return &types.MsgRegisterRevenueResponse{}, nil
 }
 
@@ -217,6 +225,13 @@ func (k Keeper) UpdateRevenue(
 		},
 	)
 
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyUpdateRevenue,
+			evmostelemetry.NewContractLabel(msg.ContractAddress),
+		)
+	}()
+
 	return &types.MsgUpdateRevenueResponse{}, nil
 }
 
@@ -275,6 +290,13 @@ func (k Keeper) CancelRevenue(
 		},
 	)
 
+	defer func() {
+		evmostelemetry.IncrCounter(
+			types.MetricKeyCancelRevenue,
+			evmostelemetry.NewContractLabel(msg.ContractAddress),
+		)
+	}()
+
 	return &types.MsgCancelRevenueResponse{}, nil
 }
 
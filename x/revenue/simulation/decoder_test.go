@@ -0,0 +1,56 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v11/x/revenue/simulation"
+	"github.com/evmos/evmos/v11/x/revenue/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	contract := tests.GenerateAddress()
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	revenue := types.NewRevenue(contract, deployer, nil)
+	params := types.DefaultParams()
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: append(types.KeyPrefixRevenue, contract.Bytes()...), Value: cdc.MustMarshal(&revenue)},
+			{Key: types.GetKeyPrefixDeployer(deployer), Value: []byte{0x01}},
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Revenue", fmt.Sprintf("%v\n%v", revenue, revenue)},
+		{"Deployer", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"other", ""},
+	}
+	for i, tc := range testCases {
+		i, tc := i, tc
+		t.Run(tc.name, func(t *testing.T) {
+			switch i {
+			case len(testCases) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tc.name)
+			default:
+				require.Equal(t, tc.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v11/x/revenue/types"
+)
+
+// Simulation parameter constants
+const (
+	EnableRevenue            = "enable_revenue"
+	DeveloperShares          = "developer_shares"
+	AddrDerivationCostCreate = "addr_derivation_cost_create"
+)
+
+// GenEnableRevenue randomizes whether the revenue module is enabled
+func GenEnableRevenue(r *rand.Rand) bool {
+	return r.Int63n(101) <= 90 // 90% chance of the revenue module being enabled
+}
+
+// GenDeveloperShares randomizes the share of the transaction fees sent to the
+// contract withdrawers
+func GenDeveloperShares(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenAddrDerivationCostCreate randomizes the gas cost of deriving a contract
+// address
+func GenAddrDerivationCostCreate(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 36, 101))
+}
+
+// GenRevenues randomizes the registered revenues, deployed by the simulation
+// accounts so that they can be updated and canceled during the simulation
+func GenRevenues(r *rand.Rand, accs []simtypes.Account) []types.Revenue {
+	revenues := make([]types.Revenue, simtypes.RandIntBetween(r, 0, len(accs)/10+1))
+	for i := range revenues {
+		deployer, _ := simtypes.RandomAcc(r, accs)
+
+		var withdrawer sdk.AccAddress
+		if r.Intn(2) == 0 {
+			withdrawerAcc, _ := simtypes.RandomAcc(r, accs)
+			withdrawer = withdrawerAcc.Address
+		}
+
+		contract := common.BytesToAddress(simtypes.RandomAccounts(r, 1)[0].Address)
+		revenues[i] = types.NewRevenue(contract, deployer.Address, withdrawer)
+	}
+
+	return revenues
+}
+
+// RandomizedGenState generates a random GenesisState for the revenue module
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableRevenue bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableRevenue, &enableRevenue, simState.Rand,
+		func(r *rand.Rand) { enableRevenue = GenEnableRevenue(r) },
+	)
+
+	var developerShares sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DeveloperShares, &developerShares, simState.Rand,
+		func(r *rand.Rand) { developerShares = GenDeveloperShares(r) },
+	)
+
+	var addrDerivationCostCreate uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AddrDerivationCostCreate, &addrDerivationCostCreate, simState.Rand,
+		func(r *rand.Rand) { addrDerivationCostCreate = GenAddrDerivationCostCreate(r) },
+	)
+
+	revenueGenesis := types.NewGenesisState(
+		types.NewParams(enableRevenue, developerShares, addrDerivationCostCreate),
+		GenRevenues(simState.Rand, simState.Accounts),
+	)
+
+	bz, err := json.MarshalIndent(&revenueGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated revenue parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&revenueGenesis)
+}
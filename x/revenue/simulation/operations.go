@@ -0,0 +1,166 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/evmos/evmos/v11/x/revenue/keeper"
+	"github.com/evmos/evmos/v11/x/revenue/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgUpdateRevenue = "op_weight_msg_update_revenue" //nolint:gosec
+	OpWeightMsgCancelRevenue = "op_weight_msg_cancel_revenue" //nolint:gosec
+
+	DefaultWeightMsgUpdateRevenue = 20
+	DefaultWeightMsgCancelRevenue = 5
+)
+
+// WeightedOperations returns all the revenue module operations with their
+// respective weights. Registering a revenue requires the deployer to sign the
+// contract deployment, so the revenues are registered on genesis instead.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgUpdateRevenue, weightMsgCancelRevenue int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateRevenue, &weightMsgUpdateRevenue, nil,
+		func(_ *rand.Rand) { weightMsgUpdateRevenue = DefaultWeightMsgUpdateRevenue },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelRevenue, &weightMsgCancelRevenue, nil,
+		func(_ *rand.Rand) { weightMsgCancelRevenue = DefaultWeightMsgCancelRevenue },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgUpdateRevenue,
+			SimulateMsgUpdateRevenue(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelRevenue,
+			SimulateMsgCancelRevenue(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgUpdateRevenue generates a MsgUpdateRevenue that sets a random
+// simulation account as the withdrawer of a registered revenue
+func SimulateMsgUpdateRevenue(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).EnableRevenue {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateRevenue, "revenue module is disabled"), nil, nil
+		}
+
+		revenue, deployer, found := randomRevenue(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateRevenue, "no revenues deployed by simulation accounts"), nil, nil
+		}
+
+		withdrawer, _ := simtypes.RandomAcc(r, accs)
+
+		// setting the deployer as withdrawer removes the withdrawer
+		newWithdrawer := withdrawer.Address.String()
+		if newWithdrawer == revenue.DeployerAddress {
+			newWithdrawer = ""
+		}
+
+		if newWithdrawer == revenue.WithdrawerAddress {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateRevenue, "withdrawer already set"), nil, nil
+		}
+
+		msg := types.NewMsgUpdateRevenue(revenue.GetContractAddr(), deployer.Address, withdrawer.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      deployer,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgCancelRevenue generates a MsgCancelRevenue for a random
+// registered revenue
+func SimulateMsgCancelRevenue(k keeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).EnableRevenue {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelRevenue, "revenue module is disabled"), nil, nil
+		}
+
+		revenue, deployer, found := randomRevenue(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelRevenue, "no revenues deployed by simulation accounts"), nil, nil
+		}
+
+		msg := types.NewMsgCancelRevenue(revenue.GetContractAddr(), deployer.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      deployer,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomRevenue returns a random registered revenue along with its deployer
+// simulation account
+func randomRevenue(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.Revenue, simtypes.Account, bool) {
+	revenues := k.GetRevenues(ctx)
+	if len(revenues) == 0 {
+		return types.Revenue{}, simtypes.Account{}, false
+	}
+
+	revenue := revenues[r.Intn(len(revenues))]
+	deployer, found := simtypes.FindAccount(accs, revenue.GetDeployerAddr())
+	return revenue, deployer, found
+}
@@ -35,6 +35,7 @@ const (
 	TypeMsgRegisterRevenue = "register_revenue"
 	TypeMsgCancelRevenue   = "cancel_revenue"
 	TypeMsgUpdateRevenue   = "update_revenue"
+	TypeMsgUpdateParams    = "update_params"
 )
 
 // NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
@@ -189,6 +190,12 @@ func (msg MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// Route returns the message route for a MsgUpdateParams message.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams message.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Authority)
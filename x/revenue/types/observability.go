@@ -0,0 +1,56 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	tagKeyDenom = tag.MustNewKey("denom")
+
+	mPaid = stats.Float64("revenue/paid", "the amount of developer revenue paid", "1")
+
+	viewPaid = &view.View{
+		Name:        "revenue_paid",
+		Measure:     mPaid,
+		Description: "The developer revenue paid to contract withdrawers",
+		TagKeys:     []tag.Key{tagKeyDenom},
+		Aggregation: view.Sum(),
+	}
+)
+
+// ObservabilityViews returns the OpenCensus views of the module
+func ObservabilityViews() []*view.View {
+	return []*view.View{viewPaid}
+}
+
+// RecordRevenuePaid records the developer revenue paid to a withdrawer
+func RecordRevenuePaid(ctx context.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(tagKeyDenom, coin.Denom)},
+			mPaid.M(sdk.NewDecFromInt(coin.Amount).MustFloat64()),
+		)
+	}
+}
@@ -21,15 +21,13 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/armon/go-metrics"
-
 	errorsmod "cosmossdk.io/errors"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
+	evmostelemetry "github.com/evmos/evmos/v11/telemetry"
 	"github.com/evmos/evmos/v11/x/vesting/types"
 )
 
@@ -123,27 +121,20 @@ func (k Keeper) CreateClawbackVestingAccount(
 		madeNewAcc = true
 	}
 
-	if madeNewAcc {
-		defer func() {
-			telemetry.IncrCounter(1, "new", "account")
-
-			for _, a := range vestingCoins {
-				if a.Amount.IsInt64() {
-					telemetry.SetGaugeWithLabels(
-						[]string{"tx", "msg", "create_clawback_vesting_account"},
-						float32(a.Amount.Int64()),
-						[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
-					)
-				}
-			}
-		}()
-	}
-
 	// Send coins from the funder to vesting account
 	if err := bk.SendCoins(ctx, from, to, vestingCoins); err != nil {
 		return nil, err
 	}
 
+	defer func() {
+		if madeNewAcc {
+			evmostelemetry.IncrCounter(types.MetricKeyCreateAccount)
+		} else {
+			evmostelemetry.IncrCounter(types.MetricKeyMergeGrant)
+		}
+		evmostelemetry.IncrCoins(types.MetricKeyGrantAmount, vestingCoins)
+	}()
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -210,10 +201,16 @@ func (k Keeper) Clawback(
 	}
 
 	// Perform clawback transfer
-	if err := k.transferClawback(ctx, *va, dest); err != nil {
+	clawedBack, err := k.transferClawback(ctx, *va, dest)
+	if err != nil {
 		return nil, err
 	}
 
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyClawback)
+		evmostelemetry.IncrCoins(types.MetricKeyClawbackAmount, clawedBack)
+	}()
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -271,6 +268,10 @@ func (k Keeper) UpdateVestingFunder(
 	// set the account with the updated funder
 	ak.SetAccount(ctx, va)
 
+	defer func() {
+		evmostelemetry.IncrCounter(types.MetricKeyUpdateVestingFunder)
+	}()
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -328,16 +329,17 @@ func (k Keeper) addGrant(
 
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
 // dest address, updates the lockup schedule and removes future vesting events.
+// It returns the transferred coins.
 func (k Keeper) transferClawback(
 	ctx sdk.Context,
 	va types.ClawbackVestingAccount,
 	dest sdk.AccAddress,
-) error {
+) (sdk.Coins, error) {
 	// Compute clawback amount, unlock unvested tokens and remove future vesting events
 	updatedAcc, toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
 	if toClawBack.IsZero() {
 		// no-op, nothing to transfer
-		return nil
+		return nil, nil
 	}
 
 	// set the account with the updated values of the vesting schedule
@@ -351,5 +353,9 @@ func (k Keeper) transferClawback(
 	// different denoms (because of store iteration).
 
 	// Transfer clawback to the destination (funder)
-	return k.bankKeeper.SendCoins(ctx, addr, dest, toClawBack)
+	if err := k.bankKeeper.SendCoins(ctx, addr, dest, toClawBack); err != nil {
+		return nil, err
+	}
+
+	return toClawBack, nil
 }
@@ -0,0 +1,275 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/evmos/evmos/v11/x/vesting/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateClawbackVestingAccount = "op_weight_msg_create_clawback_vesting_account" //nolint:gosec
+	OpWeightMsgClawback                     = "op_weight_msg_clawback"                        //nolint:gosec
+	OpWeightMsgUpdateVestingFunder          = "op_weight_msg_update_vesting_funder"           //nolint:gosec
+
+	DefaultWeightMsgCreateClawbackVestingAccount = 50
+	DefaultWeightMsgClawback                     = 20
+	DefaultWeightMsgUpdateVestingFunder          = 10
+)
+
+// maxPeriodLength is the maximum length in seconds of a random lockup or
+// vesting period, so that the schedules progress during a simulation
+const maxPeriodLength = 2 * 24 * 60 * 60
+
+// WeightedOperations returns all the vesting module operations with their
+// respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateClawbackVestingAccount int
+		weightMsgClawback                     int
+		weightMsgUpdateVestingFunder          int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClawbackVestingAccount, &weightMsgCreateClawbackVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClawbackVestingAccount = DefaultWeightMsgCreateClawbackVestingAccount
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) { weightMsgClawback = DefaultWeightMsgClawback },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateVestingFunder, &weightMsgUpdateVestingFunder, nil,
+		func(_ *rand.Rand) { weightMsgUpdateVestingFunder = DefaultWeightMsgUpdateVestingFunder },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateClawbackVestingAccount,
+			SimulateMsgCreateClawbackVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateVestingFunder,
+			SimulateMsgUpdateVestingFunder(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateClawbackVestingAccount generates a MsgCreateClawbackVestingAccount
+// that grants random vesting and lockup schedules either to a new account or,
+// merging the grant, to an existing clawback vesting account of the funder
+func SimulateMsgCreateClawbackVestingAccount(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		funder, _ := simtypes.RandomAcc(r, accs)
+
+		coins := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, funder.Address))
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "empty coins slice"), nil, nil
+		}
+
+		var (
+			to    sdk.AccAddress
+			merge bool
+		)
+
+		vestingAccs := funderClawbackAccounts(ctx, ak, funder.Address)
+		if len(vestingAccs) > 0 && r.Intn(2) == 0 {
+			to = vestingAccs[r.Intn(len(vestingAccs))].GetAddress()
+			merge = true
+		} else {
+			// the grantee is not a simulation account so that its locked
+			// tokens are never used by the operations of other modules
+			to = simtypes.RandomAccounts(r, 1)[0].Address
+			if ak.GetAccount(ctx, to) != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "account already exists"), nil, nil
+			}
+		}
+
+		startTime := ctx.BlockTime().Add(time.Duration(r.Int63n(maxPeriodLength)) * time.Second)
+		msg := types.NewMsgCreateClawbackVestingAccount(
+			funder.Address, to, startTime,
+			randomPeriods(r, coins), randomPeriods(r, coins),
+			merge,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      funder,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coins,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback that returns the unvested tokens
+// of a random clawback vesting account to its funder or to another account
+func SimulateMsgClawback(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		funder, _ := simtypes.RandomAcc(r, accs)
+
+		var vestingAccs []*types.ClawbackVestingAccount
+		for _, va := range funderClawbackAccounts(ctx, ak, funder.Address) {
+			// clawback can only be executed once the vesting has started
+			if !ctx.BlockTime().Before(va.StartTime) {
+				vestingAccs = append(vestingAccs, va)
+			}
+		}
+
+		if len(vestingAccs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "no started vesting accounts for funder"), nil, nil
+		}
+
+		va := vestingAccs[r.Intn(len(vestingAccs))]
+
+		var dest sdk.AccAddress
+		if r.Intn(2) == 0 {
+			destAcc, _ := simtypes.RandomAcc(r, accs)
+			dest = destAcc.Address
+		}
+
+		msg := types.NewMsgClawback(funder.Address, va.GetAddress(), dest)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      funder,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgUpdateVestingFunder generates a MsgUpdateVestingFunder that
+// transfers the funder role of a random clawback vesting account to another
+// simulation account
+func SimulateMsgUpdateVestingFunder(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		funder, _ := simtypes.RandomAcc(r, accs)
+
+		vestingAccs := funderClawbackAccounts(ctx, ak, funder.Address)
+		if len(vestingAccs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateVestingFunder, "no vesting accounts for funder"), nil, nil
+		}
+
+		va := vestingAccs[r.Intn(len(vestingAccs))]
+		newFunder, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgUpdateVestingFunder(funder.Address, newFunder.Address, va.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      funder,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// funderClawbackAccounts returns the clawback vesting accounts funded by the
+// given address
+func funderClawbackAccounts(ctx sdk.Context, ak authkeeper.AccountKeeper, funder sdk.AccAddress) []*types.ClawbackVestingAccount {
+	var vestingAccs []*types.ClawbackVestingAccount
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		va, ok := acc.(*types.ClawbackVestingAccount)
+		if ok && va.FunderAddress == funder.String() {
+			vestingAccs = append(vestingAccs, va)
+		}
+		return false
+	})
+
+	return vestingAccs
+}
+
+// randomPeriods splits the coins in a random number of periods of random
+// lengths
+func randomPeriods(r *rand.Rand, coins sdk.Coins) sdkvesting.Periods {
+	numPeriods := int64(simtypes.RandIntBetween(r, 1, 5))
+	periods := make(sdkvesting.Periods, 0, numPeriods)
+
+	remaining := coins
+	for i := int64(1); i < numPeriods; i++ {
+		amount := sdk.NewCoins()
+		for _, coin := range coins {
+			amount = amount.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(numPeriods)))
+		}
+
+		periods = append(periods, sdkvesting.Period{Length: randomPeriodLength(r), Amount: amount})
+		remaining = remaining.Sub(amount...)
+	}
+
+	return append(periods, sdkvesting.Period{Length: randomPeriodLength(r), Amount: remaining})
+}
+
+// randomPeriodLength returns a random period length in seconds
+func randomPeriodLength(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, maxPeriodLength))
+}